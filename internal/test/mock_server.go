@@ -12,7 +12,9 @@ import (
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/httpstream"
 	"k8s.io/apimachinery/pkg/util/httpstream/spdy"
@@ -216,3 +218,74 @@ func (h *InOpenShiftHandler) ServeHTTP(w http.ResponseWriter, req *http.Request)
 		return
 	}
 }
+
+// DiscoveryClientHandler serves the discovery endpoints (/api, /apis, and the APIResourceList for each GroupVersion)
+// for the provided APIResourceLists.
+type DiscoveryClientHandler struct {
+	APIResourceLists []metav1.APIResourceList
+}
+
+var _ http.Handler = (*DiscoveryClientHandler)(nil)
+
+func NewDiscoveryClientHandler(apiResourceLists ...metav1.APIResourceList) *DiscoveryClientHandler {
+	return &DiscoveryClientHandler{APIResourceLists: apiResourceLists}
+}
+
+// NewInOpenShiftDiscoveryClientHandler returns a DiscoveryClientHandler that makes the cluster seem to be running OpenShift
+// in addition to serving the provided APIResourceLists.
+func NewInOpenShiftDiscoveryClientHandler(apiResourceLists ...metav1.APIResourceList) *DiscoveryClientHandler {
+	return NewDiscoveryClientHandler(append([]metav1.APIResourceList{{
+		GroupVersion: "project.openshift.io/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "projects", Kind: "Project", Namespaced: false, Verbs: []string{"create", "delete", "get", "list", "patch", "update", "watch"}},
+		},
+	}}, apiResourceLists...)...)
+}
+
+func (h *DiscoveryClientHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Request Performed by DiscoveryClient to Kube API (Get API Groups legacy -core-)
+	if req.URL.Path == "/api" {
+		apiVersions := &metav1.APIVersions{
+			TypeMeta:                   metav1.TypeMeta{Kind: "APIVersions"},
+			Versions:                   []string{},
+			ServerAddressByClientCIDRs: []metav1.ServerAddressByClientCIDR{{ClientCIDR: "0.0.0.0/0"}},
+		}
+		for _, apiResourceList := range h.APIResourceLists {
+			if apiResourceList.GroupVersion == "v1" {
+				apiVersions.Versions = append(apiVersions.Versions, "v1")
+			}
+		}
+		WriteObject(w, apiVersions)
+		return
+	}
+	// Request Performed by DiscoveryClient to Kube API (Get API Groups)
+	if req.URL.Path == "/apis" {
+		apiGroupList := &metav1.APIGroupList{TypeMeta: metav1.TypeMeta{Kind: "APIGroupList", APIVersion: "v1"}, Groups: []metav1.APIGroup{}}
+		for _, apiResourceList := range h.APIResourceLists {
+			gv, err := schema.ParseGroupVersion(apiResourceList.GroupVersion)
+			if err != nil || gv.Group == "" {
+				continue
+			}
+			version := metav1.GroupVersionForDiscovery{GroupVersion: gv.String(), Version: gv.Version}
+			apiGroupList.Groups = append(apiGroupList.Groups, metav1.APIGroup{
+				Name:             gv.Group,
+				Versions:         []metav1.GroupVersionForDiscovery{version},
+				PreferredVersion: version,
+			})
+		}
+		WriteObject(w, apiGroupList)
+		return
+	}
+	// Request Performed by DiscoveryClient to Kube API (Get API Resources for GroupVersion)
+	for _, apiResourceList := range h.APIResourceLists {
+		path := "/apis/" + apiResourceList.GroupVersion
+		if apiResourceList.GroupVersion == "v1" {
+			path = "/api/v1"
+		}
+		if req.URL.Path == path {
+			apiResourceList.TypeMeta = metav1.TypeMeta{Kind: "APIResourceList", APIVersion: "v1"}
+			WriteObject(w, &apiResourceList)
+			return
+		}
+	}
+}
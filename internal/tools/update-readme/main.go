@@ -12,9 +12,13 @@ import (
 	internalk8s "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
 	"github.com/containers/kubernetes-mcp-server/pkg/toolsets"
 
+	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/builds"
 	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/config"
 	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/core"
+	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/deploymentconfigs"
 	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/helm"
+	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/mustgather"
+	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/routes"
 )
 
 type OpenShift struct{}
@@ -23,6 +27,10 @@ func (o *OpenShift) IsOpenShift(ctx context.Context) bool {
 	return true
 }
 
+func (o *OpenShift) OpenShiftMinorVersion(ctx context.Context) (int, bool) {
+	return 0, false
+}
+
 var _ internalk8s.Openshift = (*OpenShift)(nil)
 
 func main() {
@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	internalk8s "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
 	"github.com/containers/kubernetes-mcp-server/pkg/output"
@@ -48,11 +49,31 @@ type ToolCallRequest interface {
 	GetArguments() map[string]any
 }
 
+// IntArgument returns the value of the provided integer argument of a tool call (0 if not provided).
+// JSON numbers are decoded as float64, int and int64 values are accepted too.
+func IntArgument(arguments map[string]any, name string) (int64, error) {
+	switch v := arguments[name].(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		return int64(v), nil
+	case int:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	default:
+		return 0, fmt.Errorf("failed to parse %s parameter: expected integer, got %T", name, v)
+	}
+}
+
 type ToolCallResult struct {
 	// Raw content returned by the tool.
 	Content string
 	// Error (non-protocol) to send back to the LLM.
 	Error error
+	// Notices about the content (e.g. truncation, continue token) sent back apart from the content so that machine
+	// parseable content (e.g. csv) is not altered.
+	Notices []string
 }
 
 func NewToolCallResult(content string, err error) *ToolCallResult {
@@ -67,6 +88,22 @@ type ToolHandlerParams struct {
 	*internalk8s.Kubernetes
 	ToolCallRequest
 	ListOutput output.Output
+	// MaxOutputBytes is the maximum size of the list tool results (no limit if <= 0)
+	MaxOutputBytes int
+	// MustGatherDir is the directory the must-gather tools are allowed to read from
+	MustGatherDir string
+}
+
+// NewTruncatedToolCallResult creates a ToolCallResult for potentially large list content (YAML lists or tables)
+// truncating it to MaxOutputBytes with a notice of the number of items shown.
+func (p ToolHandlerParams) NewTruncatedToolCallResult(content string, err error) *ToolCallResult {
+	return NewToolCallResult(output.Truncate(content, p.MaxOutputBytes), err)
+}
+
+// NewTruncatedTextToolCallResult creates a ToolCallResult for potentially large free-form text (e.g. logs,
+// descriptions) truncating it to MaxOutputBytes keeping whole lines, the last ones if tail is true.
+func (p ToolHandlerParams) NewTruncatedTextToolCallResult(content string, tail bool, err error) *ToolCallResult {
+	return NewToolCallResult(output.TruncateLines(content, p.MaxOutputBytes, tail), err)
 }
 
 type ToolHandlerFunc func(params ToolHandlerParams) (*ToolCallResult, error)
@@ -42,6 +42,25 @@ func (s *ToolsetsSuite) TestServerTool() {
 	})
 }
 
+func (s *ToolsetsSuite) TestIntArgument() {
+	s.Run("returns 0 if not provided", func() {
+		v, err := IntArgument(map[string]any{}, "limit")
+		s.NoError(err)
+		s.Equal(int64(0), v)
+	})
+	s.Run("accepts JSON numbers and integers", func() {
+		for _, value := range []any{float64(10), 10, int64(10)} {
+			v, err := IntArgument(map[string]any{"limit": value}, "limit")
+			s.NoError(err)
+			s.Equalf(int64(10), v, "unexpected value for %T", value)
+		}
+	})
+	s.Run("returns error for non integer values", func() {
+		_, err := IntArgument(map[string]any{"limit": "10"}, "limit")
+		s.EqualError(err, "failed to parse limit parameter: expected integer, got string")
+	})
+}
+
 func TestToolsets(t *testing.T) {
 	suite.Run(t, new(ToolsetsSuite))
 }
@@ -26,6 +26,12 @@ type StaticConfig struct {
 	SSEBaseURL string `toml:"sse_base_url,omitempty"`
 	KubeConfig string `toml:"kubeconfig,omitempty"`
 	ListOutput string `toml:"list_output,omitempty"`
+	// MaxOutputBytes caps the size of the list and log tool results (e.g. events, resources) to avoid exceeding the
+	// client context limits. When 0, output.DefaultMaxBytes is used. When negative, the results are not truncated.
+	MaxOutputBytes int `toml:"max_output_bytes,omitzero"`
+	// MustGatherDir is the directory the must-gather tools are confined to, the unpacked must-gather directories must
+	// be within it. When empty, the must-gather tools fail.
+	MustGatherDir string `toml:"must_gather_dir,omitempty"`
 	// When true, expose only tools annotated with readOnlyHint=true
 	ReadOnly bool `toml:"read_only,omitempty"`
 	// When true, disable tools annotated with destructiveHint=true
@@ -33,6 +39,9 @@ type StaticConfig struct {
 	Toolsets           []string `toml:"toolsets,omitempty"`
 	EnabledTools       []string `toml:"enabled_tools,omitempty"`
 	DisabledTools      []string `toml:"disabled_tools,omitempty"`
+	// When true, expose the optional as_user and as_groups parameters on the cluster-aware tools to perform the tool
+	// calls impersonating the provided user and groups (the server identity requires the impersonate permission)
+	Impersonation bool `toml:"impersonation,omitempty"`
 
 	// Authorization-related fields
 	// RequireOAuth indicates whether the server requires OAuth for authentication.
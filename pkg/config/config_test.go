@@ -73,6 +73,8 @@ func (s *ConfigSuite) TestReadConfigValid() {
 		sse_base_url = "https://example.com"
 		kubeconfig = "./path/to/config"
 		list_output = "yaml"
+		max_output_bytes = 1024
+		must_gather_dir = "/tmp/must-gather"
 		read_only = true
 		disable_destructive = true
 
@@ -109,6 +111,12 @@ func (s *ConfigSuite) TestReadConfigValid() {
 	s.Run("list_output parsed correctly", func() {
 		s.Equalf("yaml", config.ListOutput, "Expected ListOutput to be yaml, got %s", config.ListOutput)
 	})
+	s.Run("max_output_bytes parsed correctly", func() {
+		s.Equalf(1024, config.MaxOutputBytes, "Expected MaxOutputBytes to be 1024, got %d", config.MaxOutputBytes)
+	})
+	s.Run("must_gather_dir parsed correctly", func() {
+		s.Equalf("/tmp/must-gather", config.MustGatherDir, "Expected MustGatherDir to be /tmp/must-gather, got %s", config.MustGatherDir)
+	})
 	s.Run("read_only parsed correctly", func() {
 		s.Truef(config.ReadOnly, "Expected ReadOnly to be true, got %v", config.ReadOnly)
 	})
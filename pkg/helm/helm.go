@@ -66,7 +66,11 @@ func (h *Helm) Install(ctx context.Context, chart string, values map[string]inte
 }
 
 // List lists all the releases for the specified namespace (or current namespace if). Or allNamespaces is true, it lists all releases across all namespaces.
-func (h *Helm) List(namespace string, allNamespaces bool) (string, error) {
+func (h *Helm) List(ctx context.Context, namespace string, allNamespaces bool) (string, error) {
+	// The list action doesn't support contexts, don't start it if the request was already cancelled
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
 	cfg, err := h.newAction(namespace, allNamespaces)
 	if err != nil {
 		return "", err
@@ -86,7 +90,11 @@ func (h *Helm) List(namespace string, allNamespaces bool) (string, error) {
 	return string(ret), nil
 }
 
-func (h *Helm) Uninstall(name string, namespace string) (string, error) {
+func (h *Helm) Uninstall(ctx context.Context, name string, namespace string) (string, error) {
+	// The uninstall action doesn't support contexts, don't start it if the request was already cancelled
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
 	cfg, err := h.newAction(h.kubernetes.NamespaceOrDefault(namespace), false)
 	if err != nil {
 		return "", err
@@ -95,6 +103,10 @@ func (h *Helm) Uninstall(name string, namespace string) (string, error) {
 	uninstall.IgnoreNotFound = true
 	uninstall.Wait = true
 	uninstall.Timeout = 5 * time.Minute
+	// Don't wait for the deleted resources beyond the deadline of the request
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < uninstall.Timeout {
+		uninstall.Timeout = time.Until(deadline)
+	}
 	uninstalledRelease, err := uninstall.Run(name)
 	if uninstalledRelease == nil && err == nil {
 		return fmt.Sprintf("Release %s not found", name), nil
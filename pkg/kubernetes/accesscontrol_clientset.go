@@ -6,6 +6,7 @@ import (
 
 	authenticationv1api "k8s.io/api/authentication/v1"
 	authorizationv1api "k8s.io/api/authorization/v1"
+	certificatesv1api "k8s.io/api/certificates/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -14,7 +15,9 @@ import (
 	"k8s.io/client-go/kubernetes"
 	authenticationv1 "k8s.io/client-go/kubernetes/typed/authentication/v1"
 	authorizationv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	certificatesv1 "k8s.io/client-go/kubernetes/typed/certificates/v1"
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	networkingv1 "k8s.io/client-go/kubernetes/typed/networking/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
 	"k8s.io/metrics/pkg/apis/metrics"
@@ -71,6 +74,74 @@ func (a *AccessControlClientset) NodesStatsSummary(ctx context.Context, name str
 		AbsPath(url...), nil
 }
 
+func (a *AccessControlClientset) BuildsLog(namespace, name string) (*rest.Request, error) {
+	gvk := &schema.GroupVersionKind{Group: "build.openshift.io", Version: "v1", Kind: "Build"}
+	if !isAllowed(a.staticConfig, gvk) {
+		return nil, isNotAllowedError(gvk)
+	}
+
+	url := []string{"apis", gvk.Group, gvk.Version, "namespaces", namespace, "builds", name, "log"}
+	return a.delegate.CoreV1().RESTClient().
+		Get().
+		AbsPath(url...), nil
+}
+
+func (a *AccessControlClientset) CertificateSigningRequests() (certificatesv1.CertificateSigningRequestInterface, error) {
+	gvk := &schema.GroupVersionKind{Group: certificatesv1api.GroupName, Version: certificatesv1api.SchemeGroupVersion.Version, Kind: "CertificateSigningRequest"}
+	if !isAllowed(a.staticConfig, gvk) {
+		return nil, isNotAllowedError(gvk)
+	}
+	return a.delegate.CertificatesV1().CertificateSigningRequests(), nil
+}
+
+func (a *AccessControlClientset) ConfigMaps(namespace string) (corev1.ConfigMapInterface, error) {
+	gvk := &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "ConfigMap"}
+	if !isAllowed(a.staticConfig, gvk) {
+		return nil, isNotAllowedError(gvk)
+	}
+	return a.delegate.CoreV1().ConfigMaps(namespace), nil
+}
+
+func (a *AccessControlClientset) Events(namespace string) (corev1.EventInterface, error) {
+	gvk := &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Event"}
+	if !isAllowed(a.staticConfig, gvk) {
+		return nil, isNotAllowedError(gvk)
+	}
+	return a.delegate.CoreV1().Events(namespace), nil
+}
+
+func (a *AccessControlClientset) LimitRanges(namespace string) (corev1.LimitRangeInterface, error) {
+	gvk := &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "LimitRange"}
+	if !isAllowed(a.staticConfig, gvk) {
+		return nil, isNotAllowedError(gvk)
+	}
+	return a.delegate.CoreV1().LimitRanges(namespace), nil
+}
+
+func (a *AccessControlClientset) Namespaces() (corev1.NamespaceInterface, error) {
+	gvk := &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Namespace"}
+	if !isAllowed(a.staticConfig, gvk) {
+		return nil, isNotAllowedError(gvk)
+	}
+	return a.delegate.CoreV1().Namespaces(), nil
+}
+
+func (a *AccessControlClientset) NetworkPolicies(namespace string) (networkingv1.NetworkPolicyInterface, error) {
+	gvk := &schema.GroupVersionKind{Group: "networking.k8s.io", Version: "v1", Kind: "NetworkPolicy"}
+	if !isAllowed(a.staticConfig, gvk) {
+		return nil, isNotAllowedError(gvk)
+	}
+	return a.delegate.NetworkingV1().NetworkPolicies(namespace), nil
+}
+
+func (a *AccessControlClientset) Nodes() (corev1.NodeInterface, error) {
+	gvk := &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Node"}
+	if !isAllowed(a.staticConfig, gvk) {
+		return nil, isNotAllowedError(gvk)
+	}
+	return a.delegate.CoreV1().Nodes(), nil
+}
+
 func (a *AccessControlClientset) Pods(namespace string) (corev1.PodInterface, error) {
 	gvk := &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Pod"}
 	if !isAllowed(a.staticConfig, gvk) {
@@ -129,6 +200,22 @@ func (a *AccessControlClientset) PodsMetricses(ctx context.Context, namespace, n
 	return convertedMetrics, metricsv1beta1api.Convert_v1beta1_PodMetricsList_To_metrics_PodMetricsList(versionedMetrics, convertedMetrics, nil)
 }
 
+func (a *AccessControlClientset) ResourceQuotas(namespace string) (corev1.ResourceQuotaInterface, error) {
+	gvk := &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "ResourceQuota"}
+	if !isAllowed(a.staticConfig, gvk) {
+		return nil, isNotAllowedError(gvk)
+	}
+	return a.delegate.CoreV1().ResourceQuotas(namespace), nil
+}
+
+func (a *AccessControlClientset) Secrets(namespace string) (corev1.SecretInterface, error) {
+	gvk := &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Secret"}
+	if !isAllowed(a.staticConfig, gvk) {
+		return nil, isNotAllowedError(gvk)
+	}
+	return a.delegate.CoreV1().Secrets(namespace), nil
+}
+
 func (a *AccessControlClientset) Services(namespace string) (corev1.ServiceInterface, error) {
 	gvk := &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Service"}
 	if !isAllowed(a.staticConfig, gvk) {
@@ -145,6 +232,22 @@ func (a *AccessControlClientset) SelfSubjectAccessReviews() (authorizationv1.Sel
 	return a.delegate.AuthorizationV1().SelfSubjectAccessReviews(), nil
 }
 
+func (a *AccessControlClientset) SelfSubjectReviews() (authenticationv1.SelfSubjectReviewInterface, error) {
+	gvk := &schema.GroupVersionKind{Group: authenticationv1api.GroupName, Version: authenticationv1api.SchemeGroupVersion.Version, Kind: "SelfSubjectReview"}
+	if !isAllowed(a.staticConfig, gvk) {
+		return nil, isNotAllowedError(gvk)
+	}
+	return a.delegate.AuthenticationV1().SelfSubjectReviews(), nil
+}
+
+func (a *AccessControlClientset) SubjectAccessReviews() (authorizationv1.SubjectAccessReviewInterface, error) {
+	gvk := &schema.GroupVersionKind{Group: authorizationv1api.GroupName, Version: authorizationv1api.SchemeGroupVersion.Version, Kind: "SubjectAccessReview"}
+	if !isAllowed(a.staticConfig, gvk) {
+		return nil, isNotAllowedError(gvk)
+	}
+	return a.delegate.AuthorizationV1().SubjectAccessReviews(), nil
+}
+
 // TokenReview returns TokenReviewInterface
 func (a *AccessControlClientset) TokenReview() (authenticationv1.TokenReviewInterface, error) {
 	gvk := &schema.GroupVersionKind{Group: authenticationv1api.GroupName, Version: authorizationv1api.SchemeGroupVersion.Version, Kind: "TokenReview"}
@@ -0,0 +1,59 @@
+package kubernetes
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/utils/ptr"
+)
+
+// APIResourcesList returns the preferred version of the API resources served by the cluster (like `kubectl api-resources`),
+// optionally filtered by API group ("" or "core" for the core group).
+// Discovery results are served from the manager's cached discovery client, denied resources are excluded.
+// If some API groups can't be discovered, the partial result is returned along with the failing groups.
+func (k *Kubernetes) APIResourcesList(_ context.Context, group *string) ([]metav1.APIResourceList, []string, error) {
+	apiResourceLists, err := k.manager.discoveryClient.ServerPreferredResources()
+	var failed []string
+	if err != nil {
+		groupDiscoveryFailed, ok := err.(*discovery.ErrGroupDiscoveryFailed)
+		if !ok || len(apiResourceLists) == 0 {
+			return nil, nil, err
+		}
+		for gv, gvErr := range groupDiscoveryFailed.Groups {
+			failed = append(failed, gv.String()+": "+gvErr.Error())
+		}
+		sort.Strings(failed)
+	}
+	if group != nil && *group == "core" {
+		group = ptr.To("")
+	}
+	var ret []metav1.APIResourceList
+	for _, apiResourceList := range apiResourceLists {
+		gv, gvErr := schema.ParseGroupVersion(apiResourceList.GroupVersion)
+		if gvErr != nil {
+			continue
+		}
+		if group != nil && gv.Group != *group {
+			continue
+		}
+		filtered := metav1.APIResourceList{GroupVersion: apiResourceList.GroupVersion}
+		for _, apiResource := range apiResourceList.APIResources {
+			// Skip subresources (e.g. pods/log)
+			if strings.Contains(apiResource.Name, "/") {
+				continue
+			}
+			if !isAllowed(k.manager.staticConfig, &schema.GroupVersionKind{Group: gv.Group, Version: gv.Version, Kind: apiResource.Kind}) {
+				continue
+			}
+			filtered.APIResources = append(filtered.APIResources, apiResource)
+		}
+		if len(filtered.APIResources) > 0 {
+			ret = append(ret, filtered)
+		}
+	}
+	return ret, failed, nil
+}
@@ -0,0 +1,156 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// HorizontalPodAutoscalersList summarizes the HorizontalPodAutoscalers in the provided namespace (or all namespaces):
+// their scale target, min/max replicas, current vs desired replicas, current metric values vs targets, and conditions
+// (AbleToScale, ScalingActive, ScalingLimited) explaining why the workload is or isn't being scaled.
+func (k *Kubernetes) HorizontalPodAutoscalersList(ctx context.Context, namespace string) ([]map[string]any, error) {
+	raw, err := k.ResourcesList(ctx, &schema.GroupVersionKind{
+		Group: "autoscaling", Version: "v2", Kind: "HorizontalPodAutoscaler",
+	}, namespace, ResourceListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var ret []map[string]any
+	for _, item := range raw.(*unstructured.UnstructuredList).Items {
+		hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, hpa); err != nil {
+			return nil, err
+		}
+		minReplicas := int32(1)
+		if hpa.Spec.MinReplicas != nil {
+			minReplicas = *hpa.Spec.MinReplicas
+		}
+		current := map[string]any{
+			"Namespace":       hpa.Namespace,
+			"Name":            hpa.Name,
+			"ScaleTargetRef":  hpa.Spec.ScaleTargetRef.Kind + "/" + hpa.Spec.ScaleTargetRef.Name,
+			"MinReplicas":     minReplicas,
+			"MaxReplicas":     hpa.Spec.MaxReplicas,
+			"CurrentReplicas": hpa.Status.CurrentReplicas,
+			"DesiredReplicas": hpa.Status.DesiredReplicas,
+		}
+		if hpa.Status.LastScaleTime != nil {
+			current["LastScaleTime"] = hpa.Status.LastScaleTime.UTC().Format(time.RFC3339)
+		}
+		metrics := make([]map[string]any, 0, len(hpa.Spec.Metrics))
+		for _, metric := range hpa.Spec.Metrics {
+			name, target := hpaMetricSpec(metric)
+			summary := map[string]any{"Type": string(metric.Type), "Name": name, "Target": target}
+			for _, status := range hpa.Status.CurrentMetrics {
+				if statusName, value := hpaMetricStatus(status, metric); status.Type == metric.Type && statusName == name {
+					summary["Current"] = value
+				}
+			}
+			metrics = append(metrics, summary)
+		}
+		current["Metrics"] = metrics
+		conditions := make([]map[string]any, 0, len(hpa.Status.Conditions))
+		for _, condition := range hpa.Status.Conditions {
+			conditions = append(conditions, map[string]any{
+				"Type":    string(condition.Type),
+				"Status":  string(condition.Status),
+				"Reason":  condition.Reason,
+				"Message": condition.Message,
+			})
+		}
+		if len(conditions) > 0 {
+			current["Conditions"] = conditions
+		}
+		ret = append(ret, current)
+	}
+	return ret, nil
+}
+
+// hpaMetricSpec returns the name and the target (e.g. 50% average utilization) of the provided HPA metric
+func hpaMetricSpec(metric autoscalingv2.MetricSpec) (string, string) {
+	switch metric.Type {
+	case autoscalingv2.ResourceMetricSourceType:
+		if metric.Resource != nil {
+			return string(metric.Resource.Name), hpaMetricTarget(metric.Resource.Target)
+		}
+	case autoscalingv2.ContainerResourceMetricSourceType:
+		if metric.ContainerResource != nil {
+			return fmt.Sprintf("%s (container %s)", metric.ContainerResource.Name, metric.ContainerResource.Container), hpaMetricTarget(metric.ContainerResource.Target)
+		}
+	case autoscalingv2.PodsMetricSourceType:
+		if metric.Pods != nil {
+			return metric.Pods.Metric.Name, hpaMetricTarget(metric.Pods.Target)
+		}
+	case autoscalingv2.ObjectMetricSourceType:
+		if metric.Object != nil {
+			return fmt.Sprintf("%s (%s/%s)", metric.Object.Metric.Name, metric.Object.DescribedObject.Kind, metric.Object.DescribedObject.Name), hpaMetricTarget(metric.Object.Target)
+		}
+	case autoscalingv2.ExternalMetricSourceType:
+		if metric.External != nil {
+			return metric.External.Metric.Name, hpaMetricTarget(metric.External.Target)
+		}
+	}
+	return "", ""
+}
+
+// hpaMetricStatus returns the name and the current value of the provided HPA metric status, formatted as the target
+// of the provided metric spec (e.g. utilization percentage for a utilization target)
+func hpaMetricStatus(status autoscalingv2.MetricStatus, metric autoscalingv2.MetricSpec) (string, string) {
+	switch status.Type {
+	case autoscalingv2.ResourceMetricSourceType:
+		if status.Resource != nil && metric.Resource != nil {
+			return string(status.Resource.Name), hpaMetricValue(status.Resource.Current, metric.Resource.Target)
+		}
+	case autoscalingv2.ContainerResourceMetricSourceType:
+		if status.ContainerResource != nil && metric.ContainerResource != nil {
+			return fmt.Sprintf("%s (container %s)", status.ContainerResource.Name, status.ContainerResource.Container), hpaMetricValue(status.ContainerResource.Current, metric.ContainerResource.Target)
+		}
+	case autoscalingv2.PodsMetricSourceType:
+		if status.Pods != nil && metric.Pods != nil {
+			return status.Pods.Metric.Name, hpaMetricValue(status.Pods.Current, metric.Pods.Target)
+		}
+	case autoscalingv2.ObjectMetricSourceType:
+		if status.Object != nil && metric.Object != nil {
+			return fmt.Sprintf("%s (%s/%s)", status.Object.Metric.Name, status.Object.DescribedObject.Kind, status.Object.DescribedObject.Name), hpaMetricValue(status.Object.Current, metric.Object.Target)
+		}
+	case autoscalingv2.ExternalMetricSourceType:
+		if status.External != nil && metric.External != nil {
+			return status.External.Metric.Name, hpaMetricValue(status.External.Current, metric.External.Target)
+		}
+	}
+	return "", ""
+}
+
+// hpaMetricTarget formats the provided HPA metric target (e.g. 50% average utilization, 100m average value, 10)
+func hpaMetricTarget(target autoscalingv2.MetricTarget) string {
+	switch {
+	case target.Type == autoscalingv2.UtilizationMetricType && target.AverageUtilization != nil:
+		return fmt.Sprintf("%d%% average utilization", *target.AverageUtilization)
+	case target.Type == autoscalingv2.AverageValueMetricType && target.AverageValue != nil:
+		return target.AverageValue.String() + " average value"
+	case target.Value != nil:
+		return target.Value.String()
+	}
+	return ""
+}
+
+// hpaMetricValue formats the provided HPA current metric value as the provided target
+func hpaMetricValue(current autoscalingv2.MetricValueStatus, target autoscalingv2.MetricTarget) string {
+	switch {
+	case target.Type == autoscalingv2.UtilizationMetricType && current.AverageUtilization != nil:
+		return fmt.Sprintf("%d%% average utilization", *current.AverageUtilization)
+	case target.Type == autoscalingv2.AverageValueMetricType && current.AverageValue != nil:
+		return current.AverageValue.String() + " average value"
+	case current.Value != nil:
+		return current.Value.String()
+	case current.AverageValue != nil:
+		return current.AverageValue.String() + " average value"
+	}
+	return "<unknown>"
+}
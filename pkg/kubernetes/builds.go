@@ -0,0 +1,110 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var buildConfigGVK = &schema.GroupVersionKind{Group: "build.openshift.io", Version: "v1", Kind: "BuildConfig"}
+
+type BuildsStartOptions struct {
+	// Commit overrides the source Git commit to build
+	Commit string
+	// Env adds or overrides environment variables for the build strategy
+	Env map[string]string
+}
+
+func (k *Kubernetes) BuildConfigsList(ctx context.Context, namespace string, options ResourceListOptions) (runtime.Unstructured, error) {
+	return k.ResourcesList(ctx, buildConfigGVK, namespace, options)
+}
+
+// BuildsStart instantiates a new Build from the provided BuildConfig using the build.openshift.io instantiate
+// subresource (the same approach taken by `oc start-build`).
+// Returns the created Build.
+func (k *Kubernetes) BuildsStart(ctx context.Context, namespace, name string, options BuildsStartOptions) (*unstructured.Unstructured, error) {
+	gvr, err := k.resourceFor(buildConfigGVK)
+	if err != nil {
+		return nil, err
+	}
+	namespace = k.NamespaceOrDefault(namespace)
+	buildRequest := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "build.openshift.io/v1",
+		"kind":       "BuildRequest",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": namespace,
+		},
+	}}
+	if options.Commit != "" {
+		if err = unstructured.SetNestedField(buildRequest.Object, options.Commit, "revision", "git", "commit"); err != nil {
+			return nil, err
+		}
+		// Same source revision type as `oc start-build --commit` (buildv1.BuildSourceGit)
+		_ = unstructured.SetNestedField(buildRequest.Object, "Git", "revision", "type")
+	}
+	if len(options.Env) > 0 {
+		keys := make([]string, 0, len(options.Env))
+		for key := range options.Env {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		env := make([]interface{}, 0, len(keys))
+		for _, key := range keys {
+			env = append(env, map[string]interface{}{"name": key, "value": options.Env[key]})
+		}
+		if err = unstructured.SetNestedSlice(buildRequest.Object, env, "env"); err != nil {
+			return nil, err
+		}
+	}
+	return k.manager.dynamicClient.Resource(*gvr).Namespace(namespace).
+		Create(ctx, buildRequest, metav1.CreateOptions{}, "instantiate")
+}
+
+// BuildsLog retrieves the logs of the provided Build using the build.openshift.io log subresource.
+// Logs are never followed, tail limits the number of lines retrieved from the end of the logs (all lines if not positive).
+func (k *Kubernetes) BuildsLog(ctx context.Context, namespace, name string, tail int64) (string, error) {
+	req, err := k.manager.accessControlClientSet.BuildsLog(k.NamespaceOrDefault(namespace), name)
+	if err != nil {
+		return "", err
+	}
+	req = req.Param("follow", "false")
+	if tail > 0 {
+		req = req.Param("tailLines", strconv.FormatInt(tail, 10))
+	}
+	res := req.Do(ctx)
+	if err = res.Error(); err != nil {
+		if isBuildPodNotFound(err) {
+			return "", fmt.Errorf("the pod for build %s no longer exists, its logs are no longer available: %w", name, err)
+		}
+		return "", err
+	}
+	rawData, err := res.Raw()
+	if err != nil {
+		return "", err
+	}
+	return string(rawData), nil
+}
+
+// isBuildPodNotFound returns true if the error reports that the Pod backing a Build was not found
+// (e.g. the build pod was pruned or deleted after the Build completed).
+func isBuildPodNotFound(err error) bool {
+	var status apierrors.APIStatus
+	if !errors.As(err, &status) {
+		return false
+	}
+	if details := status.Status().Details; apierrors.IsNotFound(err) && details != nil && details.Kind == "pods" {
+		return true
+	}
+	message := status.Status().Message
+	return strings.Contains(message, "pods \"") && strings.Contains(message, "not found")
+}
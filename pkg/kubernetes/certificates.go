@@ -0,0 +1,134 @@
+package kubernetes
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// certificateNamespaces are the well-known OpenShift namespaces holding the serving, client, and CA certificates of
+// the cluster (in Secrets and ConfigMaps)
+var certificateNamespaces = []string{
+	"openshift-config",
+	"openshift-config-managed",
+	"openshift-etcd",
+	"openshift-ingress",
+	"openshift-ingress-operator",
+	"openshift-kube-apiserver",
+	"openshift-kube-apiserver-operator",
+	"openshift-kube-controller-manager",
+	"openshift-kube-controller-manager-operator",
+	"openshift-kube-scheduler",
+	"openshift-service-ca",
+}
+
+// trustedCABundleLabel is set on the ConfigMaps injected with the cluster-wide trusted CA bundle (public CAs)
+const trustedCABundleLabel = "config.openshift.io/inject-trusted-cabundle"
+
+// CertificatesExpiring inspects the certificates (keys ending in .crt, e.g. tls.crt, ca.crt, ca-bundle.crt) of the
+// Secrets and ConfigMaps in the well-known OpenShift certificate namespaces and reports those expiring within warnDays
+// (or already expired), sorted by expiry date.
+// The cluster-wide trusted CA bundles (public CAs) are ignored.
+// Namespaces that can't be inspected (Forbidden or transient errors) are skipped and reported as warnings.
+func (k *Kubernetes) CertificatesExpiring(ctx context.Context, warnDays int) ([]map[string]any, []ScanWarning, error) {
+	type expiringCertificate struct {
+		notAfter time.Time
+		source   string
+		details  map[string]any
+	}
+	var expiring []expiringCertificate
+	now := time.Now()
+	warnAfter := now.AddDate(0, 0, warnDays)
+	collect := func(namespace, kind, name, key string, data []byte) {
+		if !strings.HasSuffix(key, ".crt") {
+			return
+		}
+		for _, cert := range parseCertificates(data) {
+			if !cert.NotAfter.Before(warnAfter) {
+				continue
+			}
+			expiring = append(expiring, expiringCertificate{notAfter: cert.NotAfter, source: strings.Join([]string{namespace, kind, name, key}, "/"), details: map[string]any{
+				"Namespace":     namespace,
+				"Kind":          kind,
+				"Name":          name,
+				"Key":           key,
+				"Subject":       cert.Subject.String(),
+				"Issuer":        cert.Issuer.String(),
+				"NotAfter":      cert.NotAfter.UTC().Format(time.RFC3339),
+				"DaysRemaining": int(math.Floor(cert.NotAfter.Sub(now).Hours() / 24)),
+				"Expired":       cert.NotAfter.Before(now),
+			}})
+		}
+	}
+	skipped, err := k.scanNamespaces(ctx, certificateNamespaces, func(namespace string) error {
+		secrets, err := k.manager.accessControlClientSet.Secrets(namespace)
+		if err != nil {
+			return err
+		}
+		secretList, err := secrets.List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		for _, secret := range secretList.Items {
+			for key, data := range secret.Data {
+				collect(namespace, "Secret", secret.Name, key, data)
+			}
+		}
+		configMaps, err := k.manager.accessControlClientSet.ConfigMaps(namespace)
+		if err != nil {
+			return err
+		}
+		configMapList, err := configMaps.List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		for _, configMap := range configMapList.Items {
+			if _, ok := configMap.Labels[trustedCABundleLabel]; ok || strings.HasSuffix(configMap.Name, "trusted-ca-bundle") {
+				continue
+			}
+			for key, data := range configMap.Data {
+				collect(namespace, "ConfigMap", configMap.Name, key, []byte(data))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.SliceStable(expiring, func(i, j int) bool {
+		if !expiring[i].notAfter.Equal(expiring[j].notAfter) {
+			return expiring[i].notAfter.Before(expiring[j].notAfter)
+		}
+		return expiring[i].source < expiring[j].source
+	})
+	ret := make([]map[string]any, 0, len(expiring))
+	for _, e := range expiring {
+		ret = append(ret, e.details)
+	}
+	return ret, skipped, nil
+}
+
+// parseCertificates parses all the certificates of the provided PEM bundle, blocks that aren't valid certificates
+// are ignored
+func parseCertificates(data []byte) []*x509.Certificate {
+	var ret []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return ret
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		if cert, err := x509.ParseCertificate(block.Bytes); err == nil {
+			ret = append(ret, cert)
+		}
+	}
+}
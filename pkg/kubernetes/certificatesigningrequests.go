@@ -0,0 +1,294 @@
+package kubernetes
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// nodeUserPrefix is the prefix of the username (and certificate common name) of the nodes (e.g. system:node:worker-1)
+const nodeUserPrefix = "system:node:"
+
+// nodeBootstrapperUser is the service account requesting the initial client certificate of the OpenShift nodes
+const nodeBootstrapperUser = "system:serviceaccount:openshift-machine-config-operator:node-bootstrapper"
+
+// nodesGroup is the organization of the kubelet client and serving certificates
+const nodesGroup = "system:nodes"
+
+// nodeCertificateUsages are the key usages allowed for the kubelet client and serving certificates by signer name, the
+// first one (client auth or server auth) is required
+var nodeCertificateUsages = map[string][]certificatesv1.KeyUsage{
+	certificatesv1.KubeAPIServerClientKubeletSignerName: {certificatesv1.UsageClientAuth, certificatesv1.UsageDigitalSignature, certificatesv1.UsageKeyEncipherment},
+	certificatesv1.KubeletServingSignerName:             {certificatesv1.UsageServerAuth, certificatesv1.UsageDigitalSignature, certificatesv1.UsageKeyEncipherment},
+}
+
+// CertificateSigningRequestsList returns the pending CertificateSigningRequests (neither approved, denied, nor failed)
+// with their requestor, signer name, age, and the node they were requested for (for the kubelet client and serving
+// certificates), sorted by creation time (oldest first).
+// Nodes can't join the cluster (or serve logs and exec) until their CSRs are approved.
+func (k *Kubernetes) CertificateSigningRequestsList(ctx context.Context) ([]map[string]any, error) {
+	pending, err := k.certificateSigningRequestsPending(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]map[string]any, 0, len(pending))
+	for _, csr := range pending {
+		ret = append(ret, certificateSigningRequestSummary(&csr))
+	}
+	return ret, nil
+}
+
+// CertificateSigningRequestsApprove approves the pending kubelet client and serving CertificateSigningRequests with
+// the provided name and/or requested for the provided node and returns the approved ones.
+// Only the CSRs of the node bootstrap flow are approved: the kubelet client certificates requested by the
+// node-bootstrapper service account (or the node itself) and the kubelet serving certificates requested by the node
+// itself (see isNodeBootstrapRequest). The CSRs of a node requested by anyone else are skipped, or refused if selected
+// by name.
+// The matching CSRs are validated (see validateNodeCertificateSigningRequest) before approving any of them, none is
+// approved if any fails the validation.
+func (k *Kubernetes) CertificateSigningRequestsApprove(ctx context.Context, name, node string) ([]map[string]any, error) {
+	if name == "" && node == "" {
+		return nil, errors.New("either a CSR name or a node is required")
+	}
+	pending, err := k.certificateSigningRequestsPending(ctx)
+	if err != nil {
+		return nil, err
+	}
+	csrs, err := k.manager.accessControlClientSet.CertificateSigningRequests()
+	if err != nil {
+		return nil, err
+	}
+	// All the matching CSRs are validated before approving any of them
+	var toApprove []certificatesv1.CertificateSigningRequest
+	for _, csr := range pending {
+		if name != "" && csr.Name != name {
+			continue
+		}
+		csrNode := certificateSigningRequestNode(&csr)
+		if node != "" && csrNode != node {
+			continue
+		}
+		if csrNode == "" {
+			return nil, fmt.Errorf("CSR %s is not a kubelet client or serving certificate request of a node", csr.Name)
+		}
+		if !isNodeBootstrapRequest(&csr, csrNode) {
+			if name == "" {
+				continue
+			}
+			return nil, fmt.Errorf("CSR %s can't be approved: requested by %s, not by the node-bootstrapper service account or node %s",
+				csr.Name, csr.Spec.Username, csrNode)
+		}
+		if err = k.validateNodeCertificateSigningRequest(ctx, &csr, csrNode); err != nil {
+			return nil, fmt.Errorf("CSR %s can't be approved: %v", csr.Name, err)
+		}
+		toApprove = append(toApprove, csr)
+	}
+	ret := make([]map[string]any, 0, len(toApprove))
+	for _, csr := range toApprove {
+		csr.Status.Conditions = append(csr.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
+			Type:           certificatesv1.CertificateApproved,
+			Status:         v1.ConditionTrue,
+			Reason:         "KubernetesMCPServerApprove",
+			Message:        "This CSR was approved by the Kubernetes MCP Server",
+			LastUpdateTime: metav1.Now(),
+		})
+		approved, err := csrs.UpdateApproval(ctx, csr.Name, &csr, metav1.UpdateOptions{})
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, certificateSigningRequestSummary(approved))
+	}
+	return ret, nil
+}
+
+// certificateSigningRequestsPending returns the CertificateSigningRequests that are neither approved, denied, nor
+// failed, sorted by creation time (oldest first)
+func (k *Kubernetes) certificateSigningRequestsPending(ctx context.Context) ([]certificatesv1.CertificateSigningRequest, error) {
+	csrs, err := k.manager.accessControlClientSet.CertificateSigningRequests()
+	if err != nil {
+		return nil, err
+	}
+	csrList, err := csrs.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	pending := make([]certificatesv1.CertificateSigningRequest, 0)
+	for _, csr := range csrList.Items {
+		if len(csr.Status.Conditions) == 0 {
+			pending = append(pending, csr)
+		}
+	}
+	sort.SliceStable(pending, func(i, j int) bool {
+		if !pending[i].CreationTimestamp.Equal(&pending[j].CreationTimestamp) {
+			return pending[i].CreationTimestamp.Before(&pending[j].CreationTimestamp)
+		}
+		return pending[i].Name < pending[j].Name
+	})
+	return pending, nil
+}
+
+// validateNodeCertificateSigningRequest returns an error if the provided kubelet client or serving CertificateSigningRequest
+// for the provided node must not be approved:
+// - the request organization must be system:nodes and its key usages limited to those of the signer
+// - client certificates must have no subject alternative names and be requested for an existing Node or Machine
+// - serving certificates must be requested for an existing Node and their DNS and IP subject alternative names must
+// be addresses of the Node
+func (k *Kubernetes) validateNodeCertificateSigningRequest(ctx context.Context, csr *certificatesv1.CertificateSigningRequest, node string) error {
+	request, err := parseCertificateRequest(csr)
+	if err != nil {
+		return err
+	}
+	if !slices.Equal(request.Subject.Organization, []string{nodesGroup}) {
+		return fmt.Errorf("organization %v, expected %s", request.Subject.Organization, nodesGroup)
+	}
+	allowedUsages := nodeCertificateUsages[csr.Spec.SignerName]
+	if !slices.Contains(csr.Spec.Usages, allowedUsages[0]) {
+		return fmt.Errorf("missing key usage %s", allowedUsages[0])
+	}
+	for _, usage := range csr.Spec.Usages {
+		if !slices.Contains(allowedUsages, usage) {
+			return fmt.Errorf("unexpected key usage %s", usage)
+		}
+	}
+	if len(request.EmailAddresses) > 0 || len(request.URIs) > 0 {
+		return errors.New("unexpected email or URI subject alternative names")
+	}
+	nodes, err := k.manager.accessControlClientSet.Nodes()
+	if err != nil {
+		return err
+	}
+	nodeObj, err := nodes.Get(ctx, node, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if csr.Spec.SignerName == certificatesv1.KubeAPIServerClientKubeletSignerName {
+		if len(request.DNSNames) > 0 || len(request.IPAddresses) > 0 {
+			return errors.New("unexpected subject alternative names for a client certificate")
+		}
+		if err == nil {
+			return nil
+		}
+		// The Node of a client certificate doesn't exist yet when joining the cluster, its Machine must
+		if !k.machineExists(ctx, node) {
+			return fmt.Errorf("no Node or Machine %s found", node)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("node %s not found, serving certificates are only approved for existing nodes", node)
+	}
+	if len(request.DNSNames) == 0 && len(request.IPAddresses) == 0 {
+		return errors.New("missing subject alternative names for a serving certificate")
+	}
+	addresses := make(map[string]bool)
+	for _, address := range nodeObj.Status.Addresses {
+		addresses[address.Address] = true
+	}
+	for _, dnsName := range request.DNSNames {
+		if !addresses[dnsName] {
+			return fmt.Errorf("DNS name %s is not an address of node %s", dnsName, node)
+		}
+	}
+	for _, ip := range request.IPAddresses {
+		if !addresses[ip.String()] {
+			return fmt.Errorf("IP address %s is not an address of node %s", ip, node)
+		}
+	}
+	return nil
+}
+
+// machineExists returns true if an OpenShift Machine (machine.openshift.io) for the provided node exists: its node
+// reference or one of its host names matches the node name
+func (k *Kubernetes) machineExists(ctx context.Context, node string) bool {
+	machines, err := k.ResourcesList(ctx, &schema.GroupVersionKind{
+		Group: "machine.openshift.io", Version: "v1beta1", Kind: "Machine",
+	}, "openshift-machine-api", ResourceListOptions{})
+	if err != nil {
+		return false
+	}
+	for _, machine := range machines.(*unstructured.UnstructuredList).Items {
+		if nodeRef, _, _ := unstructured.NestedString(machine.Object, "status", "nodeRef", "name"); nodeRef == node {
+			return true
+		}
+		addresses, _, _ := unstructured.NestedSlice(machine.Object, "status", "addresses")
+		for _, address := range addresses {
+			a, ok := address.(map[string]interface{})
+			if ok && (a["type"] == string(v1.NodeHostName) || a["type"] == string(v1.NodeInternalDNS)) && a["address"] == node {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// certificateSigningRequestSummary returns the name, requestor, signer name, age, node (if any), and approval status
+// of the provided CertificateSigningRequest
+func certificateSigningRequestSummary(csr *certificatesv1.CertificateSigningRequest) map[string]any {
+	summary := map[string]any{
+		"Name":       csr.Name,
+		"Requestor":  csr.Spec.Username,
+		"SignerName": csr.Spec.SignerName,
+		"Status":     "Pending",
+	}
+	for _, condition := range csr.Status.Conditions {
+		if condition.Status == v1.ConditionTrue {
+			summary["Status"] = string(condition.Type)
+		}
+	}
+	if node := certificateSigningRequestNode(csr); node != "" {
+		summary["Node"] = node
+	}
+	if !csr.CreationTimestamp.IsZero() {
+		summary["Age"] = duration.HumanDuration(time.Since(csr.CreationTimestamp.Time))
+	}
+	return summary
+}
+
+// certificateSigningRequestNode returns the name of the node the provided kubelet client or serving
+// CertificateSigningRequest was requested for (from the system:node:<name> common name of the request), or an empty
+// string for any other CSR
+func certificateSigningRequestNode(csr *certificatesv1.CertificateSigningRequest) string {
+	if _, ok := nodeCertificateUsages[csr.Spec.SignerName]; !ok {
+		return ""
+	}
+	request, err := parseCertificateRequest(csr)
+	if err != nil {
+		return ""
+	}
+	if node, ok := strings.CutPrefix(request.Subject.CommonName, nodeUserPrefix); ok {
+		return node
+	}
+	return ""
+}
+
+// parseCertificateRequest returns the x509 certificate request of the provided CertificateSigningRequest
+func parseCertificateRequest(csr *certificatesv1.CertificateSigningRequest) (*x509.CertificateRequest, error) {
+	block, _ := pem.Decode(csr.Spec.Request)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, errors.New("invalid PEM encoded certificate request")
+	}
+	return x509.ParseCertificateRequest(block.Bytes)
+}
+
+// isNodeBootstrapRequest returns true if the provided CertificateSigningRequest for the provided node was requested
+// by the node-bootstrapper service account (client certificates only) or by the node itself
+func isNodeBootstrapRequest(csr *certificatesv1.CertificateSigningRequest, node string) bool {
+	if csr.Spec.Username == nodeUserPrefix+node {
+		return true
+	}
+	return csr.Spec.SignerName == certificatesv1.KubeAPIServerClientKubeletSignerName && csr.Spec.Username == nodeBootstrapperUser
+}
@@ -0,0 +1,251 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	authenticationv1api "k8s.io/api/authentication/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ClusterInfo assembles an overview of the current OpenShift cluster: web console URL, API server URL, cluster ID,
+// OpenShift version, and infrastructure platform.
+// Information that can't be retrieved (e.g. forbidden) is omitted and the reason is reported under Unavailable.
+func (k *Kubernetes) ClusterInfo(ctx context.Context) map[string]any {
+	info := map[string]any{
+		"APIServerURL": k.manager.cfg.Host,
+	}
+	var unavailable []string
+	console, err := k.ResourcesGet(ctx, routeGVK, "openshift-console", "console")
+	if err == nil {
+		if host, _, _ := unstructured.NestedString(console.Object, "spec", "host"); host != "" {
+			info["ConsoleURL"] = "https://" + host
+		}
+	} else {
+		unavailable = append(unavailable, fmt.Sprintf("ConsoleURL: %v", err))
+	}
+	clusterVersion, err := k.ResourcesGet(ctx, &schema.GroupVersionKind{
+		Group: "config.openshift.io", Version: "v1", Kind: "ClusterVersion",
+	}, "", "version")
+	if err == nil {
+		info["ClusterID"], _, _ = unstructured.NestedString(clusterVersion.Object, "spec", "clusterID")
+		info["Version"], _, _ = unstructured.NestedString(clusterVersion.Object, "status", "desired", "version")
+	} else {
+		unavailable = append(unavailable, fmt.Sprintf("ClusterID: %v", err))
+	}
+	infrastructure, err := k.ResourcesGet(ctx, &schema.GroupVersionKind{
+		Group: "config.openshift.io", Version: "v1", Kind: "Infrastructure",
+	}, "", "cluster")
+	if err == nil {
+		platform, _, _ := unstructured.NestedString(infrastructure.Object, "status", "platformStatus", "type")
+		if platform == "" {
+			platform, _, _ = unstructured.NestedString(infrastructure.Object, "status", "platform")
+		}
+		info["Platform"] = platform
+		if apiServerURL, _, _ := unstructured.NestedString(infrastructure.Object, "status", "apiServerURL"); apiServerURL != "" {
+			info["APIServerURL"] = apiServerURL
+		}
+	} else {
+		unavailable = append(unavailable, fmt.Sprintf("Platform: %v", err))
+	}
+	if len(unavailable) > 0 {
+		info["Unavailable"] = unavailable
+	}
+	return info
+}
+
+// imageMirrorKinds are the OpenShift kinds configuring the mirrors of the image registries along with the path of
+// their source/mirrors entries
+var imageMirrorKinds = []struct {
+	gvk  schema.GroupVersionKind
+	path []string
+}{
+	{schema.GroupVersionKind{Group: "operator.openshift.io", Version: "v1alpha1", Kind: "ImageContentSourcePolicy"}, []string{"spec", "repositoryDigestMirrors"}},
+	{schema.GroupVersionKind{Group: "config.openshift.io", Version: "v1", Kind: "ImageDigestMirrorSet"}, []string{"spec", "imageDigestMirrors"}},
+}
+
+// ClusterImageConfig reports the image registries configuration of the current OpenShift cluster: the allowed,
+// blocked, and insecure registries of the cluster Image config (image.config.openshift.io) and the mirrors configured
+// by the ImageContentSourcePolicies and ImageDigestMirrorSets.
+// Mirror kinds not served by the cluster are skipped, information that can't be retrieved (e.g. forbidden) is
+// reported under Unavailable.
+func (k *Kubernetes) ClusterImageConfig(ctx context.Context) map[string]any {
+	config := map[string]any{}
+	var unavailable []string
+	image, err := k.ResourcesGet(ctx, &schema.GroupVersionKind{
+		Group: "config.openshift.io", Version: "v1", Kind: "Image",
+	}, "", "cluster")
+	if err == nil {
+		for key, field := range map[string]string{
+			"AllowedRegistries":  "allowedRegistries",
+			"BlockedRegistries":  "blockedRegistries",
+			"InsecureRegistries": "insecureRegistries",
+			"SearchRegistries":   "containerRuntimeSearchRegistries",
+		} {
+			if registries, _, _ := unstructured.NestedStringSlice(image.Object, "spec", "registrySources", field); len(registries) > 0 {
+				config[key] = registries
+			}
+		}
+		if hostname, _, _ := unstructured.NestedString(image.Object, "status", "internalRegistryHostname"); hostname != "" {
+			config["InternalRegistryHostname"] = hostname
+		}
+	} else {
+		unavailable = append(unavailable, fmt.Sprintf("Image: %v", err))
+	}
+	mirrors := make([]map[string]any, 0)
+	for _, mirrorKind := range imageMirrorKinds {
+		if _, err = k.resourceFor(&mirrorKind.gvk); err != nil {
+			continue
+		}
+		list, err := k.ResourcesList(ctx, &mirrorKind.gvk, "", ResourceListOptions{})
+		if err != nil {
+			unavailable = append(unavailable, fmt.Sprintf("%s: %v", mirrorKind.gvk.Kind, err))
+			continue
+		}
+		for _, item := range list.(*unstructured.UnstructuredList).Items {
+			entries, _, _ := unstructured.NestedSlice(item.Object, mirrorKind.path...)
+			for _, entry := range entries {
+				entryMap, ok := entry.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				mirror := map[string]any{"Origin": mirrorKind.gvk.Kind + "/" + item.GetName()}
+				mirror["Source"], _, _ = unstructured.NestedString(entryMap, "source")
+				mirror["Mirrors"], _, _ = unstructured.NestedStringSlice(entryMap, "mirrors")
+				if policy, _, _ := unstructured.NestedString(entryMap, "mirrorSourcePolicy"); policy != "" {
+					mirror["MirrorSourcePolicy"] = policy
+				}
+				mirrors = append(mirrors, mirror)
+			}
+		}
+	}
+	if len(mirrors) > 0 {
+		config["Mirrors"] = mirrors
+	}
+	if len(unavailable) > 0 {
+		config["Unavailable"] = unavailable
+	}
+	return config
+}
+
+// WhoAmI reports the identity the server is operating as in the current cluster along with the active kubeconfig
+// context name.
+// In OpenShift, the identity is retrieved from the users.openshift.io ~ (current user) endpoint.
+// Otherwise, or if the OpenShift endpoint is not available, a SelfSubjectReview is performed.
+func (k *Kubernetes) WhoAmI(ctx context.Context) (map[string]any, error) {
+	info := map[string]any{}
+	if contextName, err := k.ConfigurationContextsDefault(); err == nil && contextName != "" {
+		info["Context"] = contextName
+	}
+	if k.manager.IsOpenShift(ctx) {
+		user, err := k.ResourcesGet(ctx, &schema.GroupVersionKind{
+			Group: "user.openshift.io", Version: "v1", Kind: "User",
+		}, "", "~")
+		if err == nil {
+			info["Username"] = user.GetName()
+			if uid := string(user.GetUID()); uid != "" {
+				info["UID"] = uid
+			}
+			if groups, _, _ := unstructured.NestedStringSlice(user.Object, "groups"); len(groups) > 0 {
+				info["Groups"] = groups
+			}
+			info["Source"] = "users.openshift.io/~"
+			return info, nil
+		}
+	}
+	selfSubjectReviews, err := k.manager.accessControlClientSet.SelfSubjectReviews()
+	if err != nil {
+		return nil, err
+	}
+	review, err := selfSubjectReviews.Create(ctx, &authenticationv1api.SelfSubjectReview{}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	info["Username"] = review.Status.UserInfo.Username
+	if review.Status.UserInfo.UID != "" {
+		info["UID"] = review.Status.UserInfo.UID
+	}
+	if len(review.Status.UserInfo.Groups) > 0 {
+		info["Groups"] = review.Status.UserInfo.Groups
+	}
+	info["Source"] = "SelfSubjectReview"
+	return info, nil
+}
+
+// Namespace and name of the OpenShift global pull secret
+const (
+	pullSecretNamespace = "openshift-config"
+	pullSecretName      = "pull-secret"
+)
+
+// Registries hosting the Red Hat product and certified operator images, credentials for them are expected in the
+// global pull secret
+var redHatRegistries = []string{"registry.redhat.io", "registry.connect.redhat.com"}
+
+// ClusterPullSecretStatus reports the registries with credentials configured in the OpenShift global pull secret
+// (openshift-config/pull-secret) and whether the Red Hat registries are among them.
+// Only the registry hostnames are reported, the credentials are never returned.
+func (k *Kubernetes) ClusterPullSecretStatus(ctx context.Context) (map[string]any, error) {
+	secrets, err := k.manager.accessControlClientSet.Secrets(pullSecretNamespace)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := secrets.Get(ctx, pullSecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var auths map[string]json.RawMessage
+	if data, ok := secret.Data[v1.DockerConfigJsonKey]; ok {
+		dockerConfig := struct {
+			Auths map[string]json.RawMessage `json:"auths"`
+		}{}
+		if err = json.Unmarshal(data, &dockerConfig); err != nil {
+			return nil, fmt.Errorf("failed to parse %s of secret %s/%s: %v", v1.DockerConfigJsonKey, pullSecretNamespace, pullSecretName, err)
+		}
+		auths = dockerConfig.Auths
+	} else if data, ok = secret.Data[v1.DockerConfigKey]; ok {
+		if err = json.Unmarshal(data, &auths); err != nil {
+			return nil, fmt.Errorf("failed to parse %s of secret %s/%s: %v", v1.DockerConfigKey, pullSecretNamespace, pullSecretName, err)
+		}
+	} else {
+		return nil, fmt.Errorf("secret %s/%s has no %s or %s key", pullSecretNamespace, pullSecretName, v1.DockerConfigJsonKey, v1.DockerConfigKey)
+	}
+	registries := make([]string, 0, len(auths))
+	for registry := range auths {
+		registries = append(registries, registryHost(registry))
+	}
+	sort.Strings(registries)
+	registries = slices.Compact(registries)
+	status := map[string]any{
+		"Secret":     pullSecretNamespace + "/" + pullSecretName,
+		"Registries": registries,
+	}
+	redHat := make(map[string]bool, len(redHatRegistries))
+	var missing []string
+	for _, registry := range redHatRegistries {
+		redHat[registry] = slices.Contains(registries, registry)
+		if !redHat[registry] {
+			missing = append(missing, registry)
+		}
+	}
+	status["RedHatRegistries"] = redHat
+	if len(missing) > 0 {
+		status["MissingRedHatRegistries"] = missing
+	}
+	return status, nil
+}
+
+// registryHost returns the hostname of the provided docker config registry key, that may be a URL
+// (e.g. https://index.docker.io/v1/ -> index.docker.io)
+func registryHost(registry string) string {
+	registry = strings.TrimPrefix(strings.TrimPrefix(registry, "https://"), "http://")
+	host, _, _ := strings.Cut(registry, "/")
+	return host
+}
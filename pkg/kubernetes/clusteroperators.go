@@ -0,0 +1,134 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var clusterOperatorGVK = &schema.GroupVersionKind{Group: "config.openshift.io", Version: "v1", Kind: "ClusterOperator"}
+
+// ClusterOperatorsDiagnose summarizes the root causes of the degraded ClusterOperators (or the provided one if degraded).
+// For each degraded operator, the full Degraded condition message is correlated with the failing Pods and the Warning
+// events of the namespaces the operator relates to (derived from the operator's relatedObjects).
+// Namespaces that can't be inspected (e.g. forbidden) are reported under Unavailable.
+func (k *Kubernetes) ClusterOperatorsDiagnose(ctx context.Context, name string) ([]map[string]any, error) {
+	var operators []unstructured.Unstructured
+	if name != "" {
+		operator, err := k.ResourcesGet(ctx, clusterOperatorGVK, "", name)
+		if err != nil {
+			return nil, err
+		}
+		operators = append(operators, *operator)
+	} else {
+		list, err := k.ResourcesList(ctx, clusterOperatorGVK, "", ResourceListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		operators = list.(*unstructured.UnstructuredList).Items
+	}
+	sort.Slice(operators, func(i, j int) bool {
+		return operators[i].GetName() < operators[j].GetName()
+	})
+	ret := make([]map[string]any, 0)
+	for _, operator := range operators {
+		conditions := clusterOperatorConditions(&operator)
+		degraded, ok := conditions["Degraded"]
+		if !ok || degraded["status"] != "True" {
+			continue
+		}
+		diagnosis := map[string]any{
+			"Name":    operator.GetName(),
+			"Reason":  degraded["reason"],
+			"Message": degraded["message"],
+		}
+		if since := degraded["lastTransitionTime"]; since != "" {
+			diagnosis["Since"] = since
+		}
+		for _, conditionType := range []string{"Available", "Progressing"} {
+			if condition, ok := conditions[conditionType]; ok {
+				diagnosis[conditionType] = condition["status"]
+			}
+		}
+		namespaces := clusterOperatorNamespaces(&operator)
+		diagnosis["Namespaces"] = namespaces
+		var failingPods, unavailable []string
+		for _, namespace := range namespaces {
+			pods, err := k.ResourcesList(ctx, &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Pod"}, namespace, ResourceListOptions{})
+			if err != nil {
+				unavailable = append(unavailable, fmt.Sprintf("%s pods: %v", namespace, err))
+				continue
+			}
+			for _, pod := range podsFailing(pods.(*unstructured.UnstructuredList).Items) {
+				failingPods = append(failingPods, namespace+"/"+pod)
+			}
+		}
+		if len(failingPods) > 0 {
+			diagnosis["FailingPods"] = failingPods
+		}
+		if len(namespaces) > 0 {
+			warnings, skipped, err := k.EventsWarnings(ctx, namespaces)
+			if err != nil {
+				unavailable = append(unavailable, fmt.Sprintf("events: %v", err))
+			}
+			for _, warning := range skipped {
+				unavailable = append(unavailable, warning.Namespace+" events: "+warning.Reason)
+			}
+			if len(warnings) > 0 {
+				diagnosis["WarningEvents"] = warnings
+			}
+		}
+		if len(unavailable) > 0 {
+			diagnosis["Unavailable"] = unavailable
+		}
+		ret = append(ret, diagnosis)
+	}
+	return ret, nil
+}
+
+// clusterOperatorConditions returns the status conditions (type, status, reason, message, lastTransitionTime) of the
+// provided ClusterOperator by condition type
+func clusterOperatorConditions(operator *unstructured.Unstructured) map[string]map[string]string {
+	ret := make(map[string]map[string]string)
+	conditions, _, _ := unstructured.NestedSlice(operator.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		values := make(map[string]string)
+		for _, field := range []string{"type", "status", "reason", "message", "lastTransitionTime"} {
+			values[field], _, _ = unstructured.NestedString(condition, field)
+		}
+		ret[values["type"]] = values
+	}
+	return ret
+}
+
+// clusterOperatorNamespaces returns the sorted namespaces referenced by the relatedObjects of the provided
+// ClusterOperator (either namespaces themselves or namespaced objects)
+func clusterOperatorNamespaces(operator *unstructured.Unstructured) []string {
+	namespaces := make([]string, 0)
+	relatedObjects, _, _ := unstructured.NestedSlice(operator.Object, "status", "relatedObjects")
+	for _, r := range relatedObjects {
+		relatedObject, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		group, _, _ := unstructured.NestedString(relatedObject, "group")
+		resource, _, _ := unstructured.NestedString(relatedObject, "resource")
+		namespace, _, _ := unstructured.NestedString(relatedObject, "namespace")
+		if group == "" && resource == "namespaces" {
+			namespace, _, _ = unstructured.NestedString(relatedObject, "name")
+		}
+		if namespace != "" && !slices.Contains(namespaces, namespace) {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
@@ -0,0 +1,118 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// controlPlaneComponents are the OpenShift control-plane ClusterOperators along with the namespace and the label
+// selector of their static pods
+var controlPlaneComponents = []struct {
+	name          string
+	namespace     string
+	labelSelector string
+}{
+	{"etcd", "openshift-etcd", "etcd=true"},
+	{"kube-apiserver", "openshift-kube-apiserver", "apiserver=true"},
+	{"kube-controller-manager", "openshift-kube-controller-manager", "kube-controller-manager=true"},
+}
+
+// ControlPlaneHealth summarizes the health of the OpenShift control plane (etcd, kube-apiserver, and
+// kube-controller-manager) by checking the conditions of their ClusterOperators and the readiness of their static pods.
+// Components whose operator or pods can't be retrieved (e.g. forbidden) are reported as unhealthy under Unavailable.
+func (k *Kubernetes) ControlPlaneHealth(ctx context.Context) (map[string]any, error) {
+	healthy := true
+	components := make([]map[string]any, 0, len(controlPlaneComponents))
+	for _, c := range controlPlaneComponents {
+		component := map[string]any{"Name": c.name, "Namespace": c.namespace}
+		componentHealthy := true
+		var unavailable []string
+		if operator, err := k.ResourcesGet(ctx, clusterOperatorGVK, "", c.name); err != nil {
+			componentHealthy = false
+			unavailable = append(unavailable, fmt.Sprintf("clusteroperator: %v", err))
+		} else {
+			conditions := clusterOperatorConditions(operator)
+			for _, conditionType := range []string{"Available", "Progressing", "Degraded"} {
+				if condition, ok := conditions[conditionType]; ok {
+					component[conditionType] = condition["status"]
+				}
+			}
+			if conditions["Available"]["status"] != "True" {
+				componentHealthy = false
+			}
+			if degraded := conditions["Degraded"]; degraded["status"] == "True" {
+				componentHealthy = false
+				component["DegradedReason"] = degraded["reason"]
+				component["DegradedMessage"] = degraded["message"]
+			}
+		}
+		pods, err := k.ResourcesList(ctx, &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Pod"}, c.namespace, ResourceListOptions{
+			ListOptions: metav1.ListOptions{LabelSelector: c.labelSelector},
+		})
+		if err != nil {
+			componentHealthy = false
+			unavailable = append(unavailable, fmt.Sprintf("pods: %v", err))
+		} else {
+			items := pods.(*unstructured.UnstructuredList).Items
+			notReady := podsNotReadyReasons(items)
+			component["ReadyPods"] = fmt.Sprintf("%d/%d", len(items)-len(notReady), len(items))
+			if len(notReady) > 0 {
+				component["NotReadyPods"] = notReady
+			}
+			if len(items) == 0 || len(notReady) > 0 {
+				componentHealthy = false
+			}
+		}
+		if len(unavailable) > 0 {
+			component["Unavailable"] = unavailable
+		}
+		component["Healthy"] = componentHealthy
+		healthy = healthy && componentHealthy
+		components = append(components, component)
+	}
+	return map[string]any{"Healthy": healthy, "Components": components}, nil
+}
+
+// podsNotReadyReasons returns the sorted names of the provided pods that are not Ready along with the reason
+// (the waiting reason of the first container not running, or the Ready condition reason, or the pod phase)
+func podsNotReadyReasons(items []unstructured.Unstructured) []string {
+	ret := make([]string, 0)
+	for _, item := range items {
+		pod := &v1.Pod{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, pod); err != nil {
+			continue
+		}
+		ready := false
+		reason := string(pod.Status.Phase)
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == v1.PodReady {
+				ready = condition.Status == v1.ConditionTrue
+				if condition.Reason != "" {
+					reason = condition.Reason
+				}
+			}
+		}
+		if ready {
+			continue
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.State.Waiting != nil && status.State.Waiting.Reason != "" {
+				reason = status.State.Waiting.Reason
+				break
+			}
+		}
+		if reason == "" {
+			reason = "NotReady"
+		}
+		ret = append(ret, pod.Name+": "+reason)
+	}
+	sort.Strings(ret)
+	return ret
+}
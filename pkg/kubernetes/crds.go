@@ -0,0 +1,62 @@
+package kubernetes
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// CRDsList summarizes the installed CustomResourceDefinitions (group, kind, served versions, scope, and short names),
+// optionally filtered by the provided API group substring (case-insensitive).
+// Results are sorted by group and name.
+func (k *Kubernetes) CRDsList(ctx context.Context, group string) ([]map[string]any, error) {
+	raw, err := k.ResourcesList(ctx, &schema.GroupVersionKind{
+		Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition",
+	}, "", ResourceListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var crds []apiextensionsv1.CustomResourceDefinition
+	for _, item := range raw.(*unstructured.UnstructuredList).Items {
+		crd := apiextensionsv1.CustomResourceDefinition{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &crd); err != nil {
+			return nil, err
+		}
+		if group != "" && !strings.Contains(strings.ToLower(crd.Spec.Group), strings.ToLower(group)) {
+			continue
+		}
+		crds = append(crds, crd)
+	}
+	sort.Slice(crds, func(i, j int) bool {
+		if crds[i].Spec.Group != crds[j].Spec.Group {
+			return crds[i].Spec.Group < crds[j].Spec.Group
+		}
+		return crds[i].Name < crds[j].Name
+	})
+	ret := make([]map[string]any, 0, len(crds))
+	for _, crd := range crds {
+		versions := make([]string, 0, len(crd.Spec.Versions))
+		for _, version := range crd.Spec.Versions {
+			if version.Served {
+				versions = append(versions, version.Name)
+			}
+		}
+		current := map[string]any{
+			"Name":     crd.Name,
+			"Group":    crd.Spec.Group,
+			"Kind":     crd.Spec.Names.Kind,
+			"Versions": versions,
+			"Scope":    string(crd.Spec.Scope),
+		}
+		if len(crd.Spec.Names.ShortNames) > 0 {
+			current["ShortNames"] = crd.Spec.Names.ShortNames
+		}
+		ret = append(ret, current)
+	}
+	return ret, nil
+}
@@ -0,0 +1,44 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var deploymentConfigGVK = &schema.GroupVersionKind{Group: "apps.openshift.io", Version: "v1", Kind: "DeploymentConfig"}
+
+func (k *Kubernetes) DeploymentConfigsList(ctx context.Context, namespace string, options ResourceListOptions) (runtime.Unstructured, error) {
+	return k.ResourcesList(ctx, deploymentConfigGVK, namespace, options)
+}
+
+// DeploymentConfigsRolloutLatest triggers a new rollout of the DeploymentConfig by bumping its status.latestVersion
+// (the same approach taken by `oc rollout latest` on legacy clients, supported by the DeploymentConfig update strategy).
+// Returns the updated DeploymentConfig.
+func (k *Kubernetes) DeploymentConfigsRolloutLatest(ctx context.Context, namespace, name string) (*unstructured.Unstructured, error) {
+	gvr, err := k.resourceFor(deploymentConfigGVK)
+	if err != nil {
+		return nil, err
+	}
+	namespace = k.NamespaceOrDefault(namespace)
+	deploymentConfigs := k.manager.dynamicClient.Resource(*gvr).Namespace(namespace)
+	deploymentConfig, err := deploymentConfigs.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if paused, _, _ := unstructured.NestedBool(deploymentConfig.Object, "spec", "paused"); paused {
+		return nil, fmt.Errorf("cannot deploy a paused deployment config %s", name)
+	}
+	latestVersion, _, err := unstructured.NestedInt64(deploymentConfig.Object, "status", "latestVersion")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read status.latestVersion: %w", err)
+	}
+	if err = unstructured.SetNestedField(deploymentConfig.Object, latestVersion+1, "status", "latestVersion"); err != nil {
+		return nil, err
+	}
+	return deploymentConfigs.Update(ctx, deploymentConfig, metav1.UpdateOptions{})
+}
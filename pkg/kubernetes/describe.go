@@ -0,0 +1,195 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+	sigsyaml "sigs.k8s.io/yaml"
+)
+
+// describeMaxEvents is the maximum number of (most recent) events of the described resource
+const describeMaxEvents = 20
+
+// lastAppliedConfigAnnotation is set by kubectl apply with the full manifest of the resource, too verbose to describe
+const lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// ResourcesDescribe returns a human readable description of the provided resource (like `kubectl describe`): its
+// metadata, the remaining top-level fields (spec, data, etc.), the status with the conditions as a table, and its most
+// recent events (retrieved with an involvedObject field selector).
+// Secret values are never returned, only their size.
+func (k *Kubernetes) ResourcesDescribe(ctx context.Context, gvk *schema.GroupVersionKind, namespace, name string) (string, error) {
+	obj, err := k.ResourcesGet(ctx, gvk, namespace, name)
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	ret := &strings.Builder{}
+	field := func(label, value string) {
+		ret.WriteString(fmt.Sprintf("%-14s%s\n", label+":", value))
+	}
+	field("Name", obj.GetName())
+	if obj.GetNamespace() != "" {
+		field("Namespace", obj.GetNamespace())
+	}
+	field("Kind", obj.GetKind())
+	field("API Version", obj.GetAPIVersion())
+	field("Labels", describeStringMap(obj.GetLabels()))
+	annotations := obj.GetAnnotations()
+	delete(annotations, lastAppliedConfigAnnotation)
+	field("Annotations", describeStringMap(annotations))
+	if created := obj.GetCreationTimestamp(); !created.IsZero() {
+		field("Created", fmt.Sprintf("%s (%s ago)", created.UTC().Format(time.RFC3339), duration.HumanDuration(now.Sub(created.Time))))
+	}
+	if controller := metav1.GetControllerOfNoCopy(obj); controller != nil {
+		field("Controlled By", controller.Kind+"/"+controller.Name)
+	}
+	if deleted := obj.GetDeletionTimestamp(); deleted != nil {
+		field("Deleting", fmt.Sprintf("since %s, finalizers: %s", deleted.UTC().Format(time.RFC3339), strings.Join(obj.GetFinalizers(), ", ")))
+	}
+	content := obj.DeepCopy().Object
+	if gvk.Group == "" && gvk.Kind == "Secret" {
+		describeRedactSecret(content)
+	}
+	conditions, _, _ := unstructured.NestedSlice(content, "status", "conditions")
+	unstructured.RemoveNestedField(content, "status", "conditions")
+	keys := make([]string, 0, len(content))
+	for key := range content {
+		switch key {
+		case "apiVersion", "kind", "metadata", "spec", "status":
+		default:
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range append(append([]string{"spec"}, keys...), "status") {
+		value, ok := content[key]
+		if !ok || value == nil {
+			continue
+		}
+		if section, ok := value.(map[string]interface{}); ok && len(section) == 0 {
+			continue
+		}
+		yamlValue, err := sigsyaml.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+		ret.WriteString(strings.ToUpper(key[:1]) + key[1:] + ":\n" + indent(string(yamlValue), "  ") + "\n")
+	}
+	if len(conditions) > 0 {
+		ret.WriteString("Conditions:\n")
+		table := tabwriter.NewWriter(ret, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(table, "  Type\tStatus\tReason\tLast Transition\tMessage")
+		for _, condition := range conditions {
+			c, _ := condition.(map[string]interface{})
+			lastTransition := "<unknown>"
+			if t, err := time.Parse(time.RFC3339, fmt.Sprint(c["lastTransitionTime"])); err == nil {
+				lastTransition = duration.HumanDuration(now.Sub(t)) + " ago"
+			}
+			_, _ = fmt.Fprintf(table, "  %v\t%v\t%s\t%s\t%s\n", c["type"], c["status"], describeOrNone(c["reason"]), lastTransition,
+				strings.TrimSpace(describeOrNone(c["message"])))
+		}
+		_ = table.Flush()
+	}
+	events, err := k.resourcesDescribeEvents(ctx, obj)
+	switch {
+	case err != nil:
+		field("Events", fmt.Sprintf("<unavailable: %v>", err))
+	case len(events) == 0:
+		field("Events", "<none>")
+	default:
+		ret.WriteString("Events:\n")
+		table := tabwriter.NewWriter(ret, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(table, "  Type\tReason\tAge\tCount\tFrom\tMessage")
+		for _, event := range events {
+			from := event.Source.Component
+			if from == "" {
+				from = event.ReportingController
+			}
+			_, _ = fmt.Fprintf(table, "  %s\t%s\t%s\t%d\t%s\t%s\n", event.Type, event.Reason,
+				duration.HumanDuration(now.Sub(eventTimestamp(&event))), eventCount(&event), describeOrNone(from), strings.TrimSpace(event.Message))
+		}
+		_ = table.Flush()
+	}
+	return ret.String(), nil
+}
+
+// resourcesDescribeEvents returns the most recent events involving the provided object (oldest first)
+func (k *Kubernetes) resourcesDescribeEvents(ctx context.Context, obj *unstructured.Unstructured) ([]v1.Event, error) {
+	events, err := k.manager.accessControlClientSet.Events(obj.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	eventList, err := events.List(ctx, metav1.ListOptions{FieldSelector: fields.SelectorFromSet(fields.Set{
+		"involvedObject.kind": obj.GetKind(),
+		"involvedObject.name": obj.GetName(),
+	}).String()})
+	if err != nil {
+		return nil, err
+	}
+	var ret []v1.Event
+	for _, event := range eventList.Items {
+		// Events of a previous object with the same name are not relevant
+		if event.InvolvedObject.Name == obj.GetName() && (event.InvolvedObject.UID == "" || event.InvolvedObject.UID == obj.GetUID()) {
+			ret = append(ret, event)
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return eventTimestamp(&ret[i]).Before(eventTimestamp(&ret[j]))
+	})
+	if len(ret) > describeMaxEvents {
+		ret = ret[len(ret)-describeMaxEvents:]
+	}
+	return ret, nil
+}
+
+// describeRedactSecret replaces the values of the provided Secret content with their size
+func describeRedactSecret(content map[string]interface{}) {
+	for _, key := range []string{"data", "stringData"} {
+		values, ok := content[key].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for name, value := range values {
+			data := fmt.Sprint(value)
+			if key == "data" {
+				if decoded, err := base64.StdEncoding.DecodeString(data); err == nil {
+					data = string(decoded)
+				}
+			}
+			values[name] = fmt.Sprintf("%d bytes", len(data))
+		}
+	}
+}
+
+// describeStringMap returns the sorted key=value pairs of the provided map (one per line aligned with the field
+// values), or <none> if the map is empty
+func describeStringMap(m map[string]string) string {
+	if len(m) == 0 {
+		return "<none>"
+	}
+	pairs := make([]string, 0, len(m))
+	for key, value := range m {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "\n"+strings.Repeat(" ", 14))
+}
+
+// describeOrNone returns the string value of the provided value, or <none> if it's empty
+func describeOrNone(value interface{}) string {
+	if value == nil || fmt.Sprint(value) == "" {
+		return "<none>"
+	}
+	return fmt.Sprint(value)
+}
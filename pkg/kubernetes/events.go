@@ -2,38 +2,39 @@ package kubernetes
 
 import (
 	"context"
+	"sort"
+	"strings"
+	"time"
+
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"strings"
 )
 
-func (k *Kubernetes) EventsList(ctx context.Context, namespace string) ([]map[string]any, error) {
+// EventsList lists the events of the provided namespace (or all namespaces).
+// Returns the continue token of the listed page (if any) in the second return value.
+func (k *Kubernetes) EventsList(ctx context.Context, namespace string, options ResourceListOptions) ([]map[string]any, string, error) {
 	var eventMap []map[string]any
+	options.AsTable = false
 	raw, err := k.ResourcesList(ctx, &schema.GroupVersionKind{
 		Group: "", Version: "v1", Kind: "Event",
-	}, namespace, ResourceListOptions{})
+	}, namespace, options)
 	if err != nil {
-		return eventMap, err
+		return eventMap, "", err
 	}
 	unstructuredList := raw.(*unstructured.UnstructuredList)
+	continueToken := unstructuredList.GetContinue()
 	if len(unstructuredList.Items) == 0 {
-		return eventMap, nil
+		return eventMap, continueToken, nil
 	}
 	for _, item := range unstructuredList.Items {
 		event := &v1.Event{}
 		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, event); err != nil {
-			return eventMap, err
-		}
-		timestamp := event.EventTime.Time
-		if timestamp.IsZero() && event.Series != nil {
-			timestamp = event.Series.LastObservedTime.Time
-		} else if timestamp.IsZero() && event.Count > 1 {
-			timestamp = event.LastTimestamp.Time
-		} else if timestamp.IsZero() {
-			timestamp = event.FirstTimestamp.Time
+			return eventMap, continueToken, err
 		}
+		timestamp := eventTimestamp(event)
 		eventMap = append(eventMap, map[string]any{
 			"Namespace": event.Namespace,
 			"Timestamp": timestamp.String(),
@@ -47,5 +48,89 @@ func (k *Kubernetes) EventsList(ctx context.Context, namespace string) ([]map[st
 			"Message": strings.TrimSpace(event.Message),
 		})
 	}
-	return eventMap, nil
+	return eventMap, continueToken, nil
+}
+
+// EventsWarnings aggregates the Warning events of the provided namespaces (or all namespaces if none is provided).
+// Results are grouped by namespace and sorted by the number of occurrences (descending).
+// Namespaces that can't be accessed (Forbidden or transient errors) are skipped and reported as warnings.
+func (k *Kubernetes) EventsWarnings(ctx context.Context, namespaces []string) ([]map[string]any, []ScanWarning, error) {
+	type namespaceWarnings struct {
+		namespace string
+		count     int32
+		events    []map[string]any
+	}
+	var aggregated []namespaceWarnings
+	skipped, err := k.scanNamespaces(ctx, namespaces, func(namespace string) error {
+		events, err := k.manager.accessControlClientSet.Events(namespace)
+		if err != nil {
+			return err
+		}
+		eventList, err := events.List(ctx, metav1.ListOptions{FieldSelector: "type=" + v1.EventTypeWarning})
+		if err != nil {
+			return err
+		}
+		if len(eventList.Items) == 0 {
+			return nil
+		}
+		warnings := namespaceWarnings{namespace: namespace}
+		sort.SliceStable(eventList.Items, func(i, j int) bool {
+			return eventCount(&eventList.Items[i]) > eventCount(&eventList.Items[j])
+		})
+		for _, event := range eventList.Items {
+			count := eventCount(&event)
+			warnings.count += count
+			warnings.events = append(warnings.events, map[string]any{
+				"Count":  count,
+				"Reason": event.Reason,
+				"InvolvedObject": map[string]string{
+					"apiVersion": event.InvolvedObject.APIVersion,
+					"Kind":       event.InvolvedObject.Kind,
+					"Name":       event.InvolvedObject.Name,
+				},
+				"Message": strings.TrimSpace(event.Message),
+			})
+		}
+		aggregated = append(aggregated, warnings)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.SliceStable(aggregated, func(i, j int) bool {
+		return aggregated[i].count > aggregated[j].count
+	})
+	ret := make([]map[string]any, 0, len(aggregated))
+	for _, warnings := range aggregated {
+		ret = append(ret, map[string]any{
+			"Namespace": warnings.namespace,
+			"Count":     warnings.count,
+			"Events":    warnings.events,
+		})
+	}
+	return ret, skipped, nil
+}
+
+// eventTimestamp returns the time of the last occurrence of the provided event
+func eventTimestamp(event *v1.Event) time.Time {
+	timestamp := event.EventTime.Time
+	if timestamp.IsZero() && event.Series != nil {
+		timestamp = event.Series.LastObservedTime.Time
+	} else if timestamp.IsZero() && event.Count > 1 {
+		timestamp = event.LastTimestamp.Time
+	} else if timestamp.IsZero() {
+		timestamp = event.FirstTimestamp.Time
+	}
+	return timestamp
+}
+
+// eventCount returns the number of occurrences of the provided event (at least 1)
+func eventCount(event *v1.Event) int32 {
+	if event.Series != nil && event.Series.Count > 0 {
+		return event.Series.Count
+	}
+	if event.Count > 0 {
+		return event.Count
+	}
+	return 1
 }
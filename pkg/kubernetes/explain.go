@@ -0,0 +1,203 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/openapi3"
+)
+
+// ResourcesExplain describes the provided resource kind or one of its fields (e.g. spec.containers) using the
+// OpenAPI v3 schema published by the cluster, mirroring `kubectl explain`.
+func (k *Kubernetes) ResourcesExplain(_ context.Context, gvk *schema.GroupVersionKind, fieldPath string) (string, error) {
+	if !isAllowed(k.manager.staticConfig, gvk) {
+		return "", isNotAllowedError(gvk)
+	}
+	gvSpec, err := openapi3.NewRoot(k.manager.discoveryClient.OpenAPIV3()).GVSpecAsMap(gvk.GroupVersion())
+	if err != nil {
+		return "", err
+	}
+	schemas, _ := nestedMap(gvSpec, "components", "schemas")
+	var kindSchema map[string]interface{}
+	for _, s := range schemas {
+		candidate, ok := s.(map[string]interface{})
+		if ok && schemaHasGVK(candidate, gvk) {
+			kindSchema = candidate
+			break
+		}
+	}
+	if kindSchema == nil {
+		return "", fmt.Errorf("no schema found for %s", gvk.String())
+	}
+	explained := openAPISchema{schemas: schemas}
+	ret := &strings.Builder{}
+	if gvk.Group != "" {
+		ret.WriteString(fmt.Sprintf("GROUP:      %s\n", gvk.Group))
+	}
+	ret.WriteString(fmt.Sprintf("KIND:       %s\nVERSION:    %s\n\n", gvk.Kind, gvk.Version))
+	current, fieldName := kindSchema, ""
+	for _, field := range strings.Split(strings.Trim(fieldPath, "."), ".") {
+		if field == "" {
+			continue
+		}
+		properties, _ := nestedMap(explained.resolve(explained.elementOf(current)), "properties")
+		next, ok := properties[field].(map[string]interface{})
+		if !ok {
+			return "", fmt.Errorf("field %q does not exist in %s", fieldPath, gvk.Kind)
+		}
+		current, fieldName = next, field
+	}
+	if fieldName != "" {
+		ret.WriteString(fmt.Sprintf("FIELD: %s <%s>\n\n", fieldName, explained.typeOf(current)))
+	}
+	ret.WriteString("DESCRIPTION:\n")
+	ret.WriteString(indent(explained.description(current), "    "))
+	ret.WriteString("\n")
+	resolved := explained.resolve(explained.elementOf(current))
+	properties, _ := nestedMap(resolved, "properties")
+	if len(properties) == 0 {
+		return ret.String(), nil
+	}
+	required := map[string]bool{}
+	if requiredFields, ok := resolved["required"].([]interface{}); ok {
+		for _, r := range requiredFields {
+			required[fmt.Sprint(r)] = true
+		}
+	}
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	ret.WriteString("\nFIELDS:\n")
+	for _, name := range names {
+		property, _ := properties[name].(map[string]interface{})
+		requiredMark := ""
+		if required[name] {
+			requiredMark = " -required-"
+		}
+		ret.WriteString(fmt.Sprintf("  %s\t<%s>%s\n", name, explained.typeOf(property), requiredMark))
+		ret.WriteString(indent(explained.description(property), "    "))
+		ret.WriteString("\n\n")
+	}
+	return strings.TrimSuffix(ret.String(), "\n"), nil
+}
+
+// openAPISchema navigates the schemas of an OpenAPI v3 document decoded as a map
+type openAPISchema struct {
+	schemas map[string]interface{}
+}
+
+// resolve follows the $ref (direct or wrapped in a single allOf, as published by Kubernetes) of the provided schema
+func (o openAPISchema) resolve(s map[string]interface{}) map[string]interface{} {
+	for i := 0; i < 10 && s != nil; i++ {
+		ref, ok := s["$ref"].(string)
+		if !ok {
+			if allOf, isAllOf := s["allOf"].([]interface{}); isAllOf && len(allOf) == 1 {
+				ref, _ = nestedString(allOf[0], "$ref")
+			}
+		}
+		if ref == "" {
+			return s
+		}
+		s, _ = o.schemas[strings.TrimPrefix(ref, "#/components/schemas/")].(map[string]interface{})
+	}
+	return s
+}
+
+// elementOf returns the schema of the elements of array and map schemas, or the schema itself otherwise
+func (o openAPISchema) elementOf(s map[string]interface{}) map[string]interface{} {
+	resolved := o.resolve(s)
+	if items, ok := resolved["items"].(map[string]interface{}); ok {
+		return o.elementOf(items)
+	}
+	if additionalProperties, ok := resolved["additionalProperties"].(map[string]interface{}); ok {
+		return o.elementOf(additionalProperties)
+	}
+	return resolved
+}
+
+func (o openAPISchema) description(s map[string]interface{}) string {
+	if description, ok := s["description"].(string); ok && description != "" {
+		return description
+	}
+	if description, ok := o.resolve(s)["description"].(string); ok && description != "" {
+		return description
+	}
+	return "<empty>"
+}
+
+func (o openAPISchema) typeOf(s map[string]interface{}) string {
+	ref, _ := s["$ref"].(string)
+	if allOf, ok := s["allOf"].([]interface{}); ok && len(allOf) == 1 {
+		ref, _ = nestedString(allOf[0], "$ref")
+	}
+	if ref != "" {
+		name := strings.TrimPrefix(ref, "#/components/schemas/")
+		resolved := o.resolve(s)
+		if t, ok := resolved["type"].(string); ok && t != "object" {
+			return t
+		}
+		return name[strings.LastIndex(name, ".")+1:]
+	}
+	switch t, _ := s["type"].(string); t {
+	case "array":
+		items, _ := s["items"].(map[string]interface{})
+		return "[]" + o.typeOf(items)
+	case "object":
+		if additionalProperties, ok := s["additionalProperties"].(map[string]interface{}); ok {
+			return "map[string]" + o.typeOf(additionalProperties)
+		}
+		return "Object"
+	case "":
+		return "Object"
+	default:
+		return t
+	}
+}
+
+func schemaHasGVK(s map[string]interface{}, gvk *schema.GroupVersionKind) bool {
+	gvks, _ := s["x-kubernetes-group-version-kind"].([]interface{})
+	for _, candidate := range gvks {
+		group, _ := nestedString(candidate, "group")
+		version, _ := nestedString(candidate, "version")
+		kind, _ := nestedString(candidate, "kind")
+		if group == gvk.Group && version == gvk.Version && kind == gvk.Kind {
+			return true
+		}
+	}
+	return false
+}
+
+func nestedMap(obj map[string]interface{}, fields ...string) (map[string]interface{}, bool) {
+	var current interface{} = obj
+	for _, field := range fields {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		current = m[field]
+	}
+	ret, ok := current.(map[string]interface{})
+	return ret, ok
+}
+
+func nestedString(obj interface{}, field string) (string, bool) {
+	m, ok := obj.(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	ret, ok := m[field].(string)
+	return ret, ok
+}
+
+func indent(text, prefix string) string {
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	for i := range lines {
+		lines[i] = prefix + lines[i]
+	}
+	return strings.Join(lines, "\n")
+}
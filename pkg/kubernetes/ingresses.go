@@ -0,0 +1,118 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ingressClassAnnotation is the deprecated annotation used to set the class of an Ingress before spec.ingressClassName
+const ingressClassAnnotation = "kubernetes.io/ingress.class"
+
+// IngressesList summarizes the Ingresses in the provided namespace (or all namespaces): their ingress class, host
+// rules with the backend services they route to, TLS secrets, and load balancer addresses.
+func (k *Kubernetes) IngressesList(ctx context.Context, namespace string) ([]map[string]any, error) {
+	raw, err := k.ResourcesList(ctx, &schema.GroupVersionKind{
+		Group: "networking.k8s.io", Version: "v1", Kind: "Ingress",
+	}, namespace, ResourceListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var ret []map[string]any
+	for _, item := range raw.(*unstructured.UnstructuredList).Items {
+		ingress := &networkingv1.Ingress{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, ingress); err != nil {
+			return nil, err
+		}
+		current := map[string]any{
+			"Namespace": ingress.Namespace,
+			"Name":      ingress.Name,
+		}
+		if ingress.Spec.IngressClassName != nil {
+			current["IngressClass"] = *ingress.Spec.IngressClassName
+		} else if class, ok := ingress.Annotations[ingressClassAnnotation]; ok {
+			current["IngressClass"] = class
+		}
+		hosts, rules, services := make([]string, 0), make([]string, 0), make([]string, 0)
+		addService := func(backend *networkingv1.IngressBackend) string {
+			description := ingressBackend(backend)
+			if backend.Service != nil && !slices.Contains(services, backend.Service.Name) {
+				services = append(services, backend.Service.Name)
+			}
+			return description
+		}
+		if ingress.Spec.DefaultBackend != nil {
+			current["DefaultBackend"] = addService(ingress.Spec.DefaultBackend)
+		}
+		for _, rule := range ingress.Spec.Rules {
+			host := rule.Host
+			if host == "" {
+				host = "*"
+			} else if !slices.Contains(hosts, host) {
+				hosts = append(hosts, host)
+			}
+			if rule.HTTP == nil {
+				continue
+			}
+			for _, path := range rule.HTTP.Paths {
+				description := host + path.Path
+				if path.PathType != nil {
+					description += " (" + string(*path.PathType) + ")"
+				}
+				rules = append(rules, description+" -> "+addService(&path.Backend))
+			}
+		}
+		sort.Strings(services)
+		current["Hosts"] = hosts
+		current["Rules"] = rules
+		current["Services"] = services
+		if len(ingress.Spec.TLS) > 0 {
+			tls := make([]map[string]any, 0, len(ingress.Spec.TLS))
+			for _, t := range ingress.Spec.TLS {
+				tls = append(tls, map[string]any{"SecretName": t.SecretName, "Hosts": t.Hosts})
+			}
+			current["TLS"] = tls
+		}
+		var addresses []string
+		for _, lb := range ingress.Status.LoadBalancer.Ingress {
+			if lb.IP != "" {
+				addresses = append(addresses, lb.IP)
+			}
+			if lb.Hostname != "" {
+				addresses = append(addresses, lb.Hostname)
+			}
+		}
+		if len(addresses) > 0 {
+			current["Addresses"] = addresses
+		}
+		ret = append(ret, current)
+	}
+	return ret, nil
+}
+
+// ingressBackend returns a human-readable description of the provided Ingress backend (e.g. web:80, web:http, or
+// the referenced resource for non-service backends)
+func ingressBackend(backend *networkingv1.IngressBackend) string {
+	if backend.Service != nil {
+		port := backend.Service.Port.Name
+		if port == "" {
+			port = fmt.Sprintf("%d", backend.Service.Port.Number)
+		}
+		return backend.Service.Name + ":" + port
+	}
+	if backend.Resource != nil {
+		kind := backend.Resource.Kind
+		if backend.Resource.APIGroup != nil && *backend.Resource.APIGroup != "" {
+			kind += "." + *backend.Resource.APIGroup
+		}
+		return strings.ToLower(kind) + "/" + backend.Resource.Name
+	}
+	return ""
+}
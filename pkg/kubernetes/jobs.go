@@ -0,0 +1,126 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// JobsList summarizes the Jobs of the provided namespace (or all namespaces).
+// For each Job, the completions, active/succeeded/failed Pod counts, and start and completion times are reported.
+// If failedOnly is true, only the Jobs that have failed are returned.
+// Namespaces that can't be scanned (Forbidden or transient errors) are skipped and reported as warnings.
+func (k *Kubernetes) JobsList(ctx context.Context, namespace string, failedOnly bool) ([]map[string]any, []ScanWarning, error) {
+	var jobMap []map[string]any
+	items, skipped, err := k.resourcesScan(ctx, &schema.GroupVersionKind{
+		Group: "batch", Version: "v1", Kind: "Job",
+	}, namespace, metav1.ListOptions{})
+	if err != nil {
+		return jobMap, nil, err
+	}
+	for _, item := range items {
+		job := &batchv1.Job{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, job); err != nil {
+			return jobMap, nil, err
+		}
+		status, reason := jobStatus(job)
+		if failedOnly && status != "Failed" {
+			continue
+		}
+		completions := int32(1)
+		if job.Spec.Completions != nil {
+			completions = *job.Spec.Completions
+		}
+		current := map[string]any{
+			"Namespace":   job.Namespace,
+			"Name":        job.Name,
+			"Status":      status,
+			"Completions": fmt.Sprintf("%d/%d", job.Status.Succeeded, completions),
+			"Active":      job.Status.Active,
+			"Succeeded":   job.Status.Succeeded,
+			"Failed":      job.Status.Failed,
+		}
+		if reason != "" {
+			current["Reason"] = reason
+		}
+		if job.Status.StartTime != nil {
+			current["StartTime"] = job.Status.StartTime.UTC().Format(time.RFC3339)
+		}
+		if job.Status.CompletionTime != nil {
+			current["CompletionTime"] = job.Status.CompletionTime.UTC().Format(time.RFC3339)
+		}
+		for _, owner := range job.OwnerReferences {
+			if owner.Kind == "CronJob" {
+				current["CronJob"] = owner.Name
+			}
+		}
+		jobMap = append(jobMap, current)
+	}
+	return jobMap, skipped, nil
+}
+
+// CronJobsList summarizes the CronJobs of the provided namespace (or all namespaces).
+// For each CronJob, the schedule, suspend state, last schedule and last successful times, and active Jobs are reported.
+// Namespaces that can't be scanned (Forbidden or transient errors) are skipped and reported as warnings.
+func (k *Kubernetes) CronJobsList(ctx context.Context, namespace string) ([]map[string]any, []ScanWarning, error) {
+	var cronJobMap []map[string]any
+	items, skipped, err := k.resourcesScan(ctx, &schema.GroupVersionKind{
+		Group: "batch", Version: "v1", Kind: "CronJob",
+	}, namespace, metav1.ListOptions{})
+	if err != nil {
+		return cronJobMap, nil, err
+	}
+	for _, item := range items {
+		cronJob := &batchv1.CronJob{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, cronJob); err != nil {
+			return cronJobMap, nil, err
+		}
+		activeJobs := make([]string, 0, len(cronJob.Status.Active))
+		for _, active := range cronJob.Status.Active {
+			activeJobs = append(activeJobs, active.Name)
+		}
+		current := map[string]any{
+			"Namespace":  cronJob.Namespace,
+			"Name":       cronJob.Name,
+			"Schedule":   cronJob.Spec.Schedule,
+			"Suspend":    cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend,
+			"ActiveJobs": activeJobs,
+		}
+		if cronJob.Spec.TimeZone != nil {
+			current["TimeZone"] = *cronJob.Spec.TimeZone
+		}
+		if cronJob.Status.LastScheduleTime != nil {
+			current["LastScheduleTime"] = cronJob.Status.LastScheduleTime.UTC().Format(time.RFC3339)
+		}
+		if cronJob.Status.LastSuccessfulTime != nil {
+			current["LastSuccessfulTime"] = cronJob.Status.LastSuccessfulTime.UTC().Format(time.RFC3339)
+		}
+		cronJobMap = append(cronJobMap, current)
+	}
+	return cronJobMap, skipped, nil
+}
+
+// jobStatus returns the status of the provided Job (Complete, Failed, Suspended, or Running) along with the reason of
+// its failure (if any)
+func jobStatus(job *batchv1.Job) (string, string) {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != v1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobFailed:
+			return "Failed", condition.Reason
+		case batchv1.JobComplete:
+			return "Complete", ""
+		case batchv1.JobSuspended:
+			return "Suspended", ""
+		}
+	}
+	return "Running", ""
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"sync"
 
 	"github.com/containers/kubernetes-mcp-server/pkg/config"
 	"github.com/containers/kubernetes-mcp-server/pkg/helm"
@@ -30,6 +32,12 @@ type Manager struct {
 	accessControlRESTMapper *AccessControlRESTMapper
 	dynamicClient           *dynamic.DynamicClient
 
+	openShiftVersionMutex sync.Mutex
+	openShiftMinorVersion int
+
+	impersonatedMutex    sync.Mutex
+	impersonatedManagers map[string]*Manager
+
 	staticConfig         *config.StaticConfig
 	CloseWatchKubeConfig CloseWatchKubeConfig
 }
@@ -299,3 +307,80 @@ func (m *Manager) Derived(ctx context.Context) (*Kubernetes, error) {
 	}
 	return derived, nil
 }
+
+// maxImpersonatedManagers bounds the number of impersonating managers cached by a Manager (the cache is reset when
+// reached)
+const maxImpersonatedManagers = 64
+
+// Impersonate returns a Kubernetes client performing the requests as the provided user and groups (impersonation
+// headers). The identity of the current client must be allowed to impersonate them, requests are Forbidden otherwise.
+func (k *Kubernetes) Impersonate(userName string, groups []string) (*Kubernetes, error) {
+	manager, err := k.manager.impersonated(userName, groups)
+	if err != nil {
+		return nil, err
+	}
+	return &Kubernetes{manager: manager}, nil
+}
+
+// impersonated returns the Manager impersonating the provided user and groups, the managers are cached by user and
+// groups so that their clients (and discovery cache) are reused across requests
+func (m *Manager) impersonated(userName string, groups []string) (*Manager, error) {
+	key := strings.Join(append([]string{userName}, slices.Sorted(slices.Values(groups))...), "\n")
+	m.impersonatedMutex.Lock()
+	defer m.impersonatedMutex.Unlock()
+	if manager, ok := m.impersonatedManagers[key]; ok {
+		return manager, nil
+	}
+	impersonate := rest.ImpersonationConfig{UserName: userName, Groups: groups}
+	cfg := rest.CopyConfig(m.cfg)
+	cfg.Impersonate = impersonate
+	manager, err := newManager(m.staticConfig, cfg, &impersonatedClientConfig{delegate: m.clientCmdConfig, impersonate: impersonate})
+	if err != nil {
+		return nil, err
+	}
+	if m.impersonatedManagers == nil || len(m.impersonatedManagers) >= maxImpersonatedManagers {
+		m.impersonatedManagers = make(map[string]*Manager)
+	}
+	m.impersonatedManagers[key] = manager
+	return manager, nil
+}
+
+// impersonatedClientConfig is a clientcmd.ClientConfig impersonating the provided user and groups so that the clients
+// created from the kubeconfig (e.g. Helm) are impersonated too
+type impersonatedClientConfig struct {
+	delegate    clientcmd.ClientConfig
+	impersonate rest.ImpersonationConfig
+}
+
+var _ clientcmd.ClientConfig = (*impersonatedClientConfig)(nil)
+
+func (c *impersonatedClientConfig) RawConfig() (clientcmdapi.Config, error) {
+	rawConfig, err := c.delegate.RawConfig()
+	if err != nil {
+		return rawConfig, err
+	}
+	rawConfig = *rawConfig.DeepCopy()
+	for _, authInfo := range rawConfig.AuthInfos {
+		authInfo.Impersonate = c.impersonate.UserName
+		authInfo.ImpersonateGroups = c.impersonate.Groups
+	}
+	return rawConfig, nil
+}
+
+func (c *impersonatedClientConfig) ClientConfig() (*rest.Config, error) {
+	cfg, err := c.delegate.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	cfg = rest.CopyConfig(cfg)
+	cfg.Impersonate = c.impersonate
+	return cfg, nil
+}
+
+func (c *impersonatedClientConfig) Namespace() (string, bool, error) {
+	return c.delegate.Namespace()
+}
+
+func (c *impersonatedClientConfig) ConfigAccess() clientcmd.ConfigAccess {
+	return c.delegate.ConfigAccess()
+}
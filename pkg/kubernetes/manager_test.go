@@ -197,6 +197,49 @@ func (s *ManagerTestSuite) TestNewKubeconfigManager() {
 	})
 }
 
+func (s *ManagerTestSuite) TestImpersonate() {
+	manager, err := NewKubeconfigManager(&config.StaticConfig{KubeConfig: s.mockServer.KubeconfigFile(s.T())}, "")
+	s.Require().NoError(err)
+	s.T().Cleanup(manager.Close)
+	k := &Kubernetes{manager: manager}
+	impersonated, err := k.Impersonate("alice", []string{"devs", "admins"})
+	s.Require().NoError(err)
+	s.Run("impersonates the user and groups", func() {
+		s.Equal(rest.ImpersonationConfig{UserName: "alice", Groups: []string{"devs", "admins"}}, impersonated.manager.cfg.Impersonate)
+		s.Empty(manager.cfg.Impersonate.UserName, "original rest config shouldn't be modified")
+	})
+	s.Run("impersonates the clients created from the kubeconfig", func() {
+		cfg, err := impersonated.manager.ToRawKubeConfigLoader().ClientConfig()
+		s.Require().NoError(err)
+		s.Equal("alice", cfg.Impersonate.UserName)
+		s.Equal([]string{"devs", "admins"}, cfg.Impersonate.Groups)
+		rawConfig, err := impersonated.manager.ToRawKubeConfigLoader().RawConfig()
+		s.Require().NoError(err)
+		s.Require().NotEmpty(rawConfig.AuthInfos)
+		for _, authInfo := range rawConfig.AuthInfos {
+			s.Equal("alice", authInfo.Impersonate)
+		}
+		originalRawConfig, err := manager.clientCmdConfig.RawConfig()
+		s.Require().NoError(err)
+		for _, authInfo := range originalRawConfig.AuthInfos {
+			s.Empty(authInfo.Impersonate, "original kubeconfig shouldn't be modified")
+		}
+	})
+	s.Run("reuses the impersonating manager for the same user and groups", func() {
+		again, err := k.Impersonate("alice", []string{"admins", "devs"})
+		s.Require().NoError(err)
+		s.Same(impersonated.manager, again.manager)
+	})
+	s.Run("creates a new impersonating manager for other users or groups", func() {
+		other, err := k.Impersonate("alice", []string{"devs"})
+		s.Require().NoError(err)
+		s.NotSame(impersonated.manager, other.manager)
+		other, err = k.Impersonate("bob", []string{"devs", "admins"})
+		s.Require().NoError(err)
+		s.NotSame(impersonated.manager, other.manager)
+	})
+}
+
 func TestManager(t *testing.T) {
 	suite.Run(t, new(ManagerTestSuite))
 }
@@ -2,6 +2,20 @@ package kubernetes
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -17,3 +31,436 @@ func (k *Kubernetes) ProjectsList(ctx context.Context, options ResourceListOptio
 		Group: "project.openshift.io", Version: "v1", Kind: "Project",
 	}, "", options)
 }
+
+type NamespacesCreateOptions struct {
+	Labels      map[string]string
+	Annotations map[string]string
+	// ResourceQuota hard limits (e.g. cpu, memory, pods) of the ResourceQuota to create in the namespace (none if empty)
+	ResourceQuota map[v1.ResourceName]string
+	// LimitRange container default limits and requests of the LimitRange to create in the namespace (none if nil)
+	LimitRange *NamespacesCreateLimitRange
+}
+
+type NamespacesCreateLimitRange struct {
+	// Default limits applied to containers that don't specify them (e.g. cpu, memory)
+	Default map[v1.ResourceName]string
+	// DefaultRequest requests applied to containers that don't specify them (e.g. cpu, memory)
+	DefaultRequest map[v1.ResourceName]string
+}
+
+// NamespacesCreate creates a namespace along with the optional ResourceQuota and LimitRange.
+// Quantities are validated before creating any object.
+// Returns the kind and name of the created objects.
+func (k *Kubernetes) NamespacesCreate(ctx context.Context, name string, options NamespacesCreateOptions) ([]string, error) {
+	hard, err := resourceList(options.ResourceQuota)
+	if err != nil {
+		return nil, fmt.Errorf("invalid resource quota: %w", err)
+	}
+	var limitRange *v1.LimitRange
+	if options.LimitRange != nil {
+		limitRange = &v1.LimitRange{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-limit-range", Namespace: name},
+			Spec: v1.LimitRangeSpec{Limits: []v1.LimitRangeItem{{
+				Type: v1.LimitTypeContainer,
+			}}},
+		}
+		if limitRange.Spec.Limits[0].Default, err = resourceList(options.LimitRange.Default); err != nil {
+			return nil, fmt.Errorf("invalid limit range default: %w", err)
+		}
+		if limitRange.Spec.Limits[0].DefaultRequest, err = resourceList(options.LimitRange.DefaultRequest); err != nil {
+			return nil, fmt.Errorf("invalid limit range default request: %w", err)
+		}
+	}
+	namespaces, err := k.manager.accessControlClientSet.Namespaces()
+	if err != nil {
+		return nil, err
+	}
+	namespace, err := namespaces.Create(ctx, &v1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:        name,
+		Labels:      options.Labels,
+		Annotations: options.Annotations,
+	}}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	created := []string{"Namespace " + namespace.Name}
+	if len(hard) > 0 {
+		resourceQuotas, err := k.manager.accessControlClientSet.ResourceQuotas(name)
+		if err != nil {
+			return created, err
+		}
+		resourceQuota, err := resourceQuotas.Create(ctx, &v1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-quota", Namespace: name},
+			Spec:       v1.ResourceQuotaSpec{Hard: hard},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return created, err
+		}
+		created = append(created, "ResourceQuota "+resourceQuota.Namespace+"/"+resourceQuota.Name)
+	}
+	if limitRange != nil {
+		limitRanges, err := k.manager.accessControlClientSet.LimitRanges(name)
+		if err != nil {
+			return created, err
+		}
+		if limitRange, err = limitRanges.Create(ctx, limitRange, metav1.CreateOptions{}); err != nil {
+			return created, err
+		}
+		created = append(created, "LimitRange "+limitRange.Namespace+"/"+limitRange.Name)
+	}
+	return created, nil
+}
+
+func resourceList(quantities map[v1.ResourceName]string) (v1.ResourceList, error) {
+	if len(quantities) == 0 {
+		return nil, nil
+	}
+	ret := v1.ResourceList{}
+	for name, value := range quantities {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		ret[name] = quantity
+	}
+	return ret, nil
+}
+
+// Container waiting reasons that denote a failing Pod
+var failingContainerReasons = []string{
+	"CrashLoopBackOff", "CreateContainerConfigError", "CreateContainerError", "ErrImagePull", "ImagePullBackOff",
+	"InvalidImageName", "RunContainerError",
+}
+
+// NamespacesInventory summarizes the contents of the provided namespace (or the configured one): the number of
+// objects of each namespaced kind served by the cluster, along with notable statuses (failing Pods and pending
+// PersistentVolumeClaims).
+// Kinds are enumerated with discovery, denied resources are excluded.
+// Kinds that can't be listed (e.g. forbidden) are reported under Unavailable.
+func (k *Kubernetes) NamespacesInventory(ctx context.Context, namespace string) (map[string]any, error) {
+	namespace = k.NamespaceOrDefault(namespace)
+	apiResourceLists, unavailable, err := k.APIResourcesList(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	var resources []map[string]any
+	var failingPods, pendingClaims []string
+	for _, apiResourceList := range apiResourceLists {
+		gv, _ := schema.ParseGroupVersion(apiResourceList.GroupVersion)
+		// Events are served by both the core and events.k8s.io groups
+		if gv.Group == "events.k8s.io" {
+			continue
+		}
+		for _, apiResource := range apiResourceList.APIResources {
+			if !apiResource.Namespaced || !slices.Contains(apiResource.Verbs, "list") {
+				continue
+			}
+			list, err := k.manager.dynamicClient.Resource(gv.WithResource(apiResource.Name)).Namespace(namespace).
+				List(ctx, metav1.ListOptions{})
+			if err != nil {
+				unavailable = append(unavailable, fmt.Sprintf("%s/%s: %v", apiResourceList.GroupVersion, apiResource.Kind, err))
+				continue
+			}
+			if len(list.Items) == 0 {
+				continue
+			}
+			resources = append(resources, map[string]any{
+				"APIVersion": apiResourceList.GroupVersion,
+				"Kind":       apiResource.Kind,
+				"Count":      len(list.Items),
+			})
+			switch gv.WithKind(apiResource.Kind) {
+			case v1.SchemeGroupVersion.WithKind("Pod"):
+				failingPods = append(failingPods, podsFailing(list.Items)...)
+			case v1.SchemeGroupVersion.WithKind("PersistentVolumeClaim"):
+				for _, item := range list.Items {
+					if phase, _, _ := unstructured.NestedString(item.Object, "status", "phase"); phase == string(v1.ClaimPending) {
+						pendingClaims = append(pendingClaims, item.GetName())
+					}
+				}
+			}
+		}
+	}
+	sort.SliceStable(resources, func(i, j int) bool {
+		if resources[i]["Kind"] != resources[j]["Kind"] {
+			return resources[i]["Kind"].(string) < resources[j]["Kind"].(string)
+		}
+		return resources[i]["APIVersion"].(string) < resources[j]["APIVersion"].(string)
+	})
+	inventory := map[string]any{
+		"Namespace": namespace,
+		"Resources": resources,
+	}
+	if len(failingPods) > 0 {
+		inventory["FailingPods"] = failingPods
+	}
+	if len(pendingClaims) > 0 {
+		inventory["PendingPersistentVolumeClaims"] = pendingClaims
+	}
+	if len(unavailable) > 0 {
+		inventory["Unavailable"] = unavailable
+	}
+	return inventory, nil
+}
+
+// podsFailing returns the name and failure reason of the provided Pods that are failed, unschedulable, or have
+// containers that can't be started or keep crashing.
+func podsFailing(items []unstructured.Unstructured) []string {
+	var ret []string
+	for _, item := range items {
+		pod := &v1.Pod{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, pod); err != nil {
+			continue
+		}
+		reason := ""
+		switch {
+		case pod.Status.Phase == v1.PodFailed:
+			reason = string(v1.PodFailed)
+			if pod.Status.Reason != "" {
+				reason = pod.Status.Reason
+			}
+		case pod.Status.Phase == v1.PodPending:
+			for _, condition := range pod.Status.Conditions {
+				if condition.Type == v1.PodScheduled && condition.Status == v1.ConditionFalse {
+					reason = condition.Reason
+				}
+			}
+		}
+		for _, status := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+			if reason == "" && status.State.Waiting != nil && slices.Contains(failingContainerReasons, status.State.Waiting.Reason) {
+				reason = status.State.Waiting.Reason
+			}
+		}
+		if reason != "" {
+			ret = append(ret, pod.Name+": "+reason)
+		}
+	}
+	return ret
+}
+
+// NamespacesStuck reports the namespaces stuck in the Terminating phase along with their remaining finalizers,
+// the conditions reported by the namespace controller describing the resources blocking the deletion,
+// and the commands to clear the finalizers manually (finalizers are never removed automatically).
+func (k *Kubernetes) NamespacesStuck(ctx context.Context) ([]map[string]any, error) {
+	namespaces, err := k.manager.accessControlClientSet.Namespaces()
+	if err != nil {
+		return nil, err
+	}
+	namespaceList, err := namespaces.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var stuck []map[string]any
+	for _, ns := range namespaceList.Items {
+		if ns.Status.Phase != v1.NamespaceTerminating {
+			continue
+		}
+		specFinalizers := make([]string, 0, len(ns.Spec.Finalizers))
+		for _, finalizer := range ns.Spec.Finalizers {
+			specFinalizers = append(specFinalizers, string(finalizer))
+		}
+		blocking := make([]string, 0)
+		for _, condition := range ns.Status.Conditions {
+			if condition.Status == v1.ConditionTrue {
+				blocking = append(blocking, fmt.Sprintf("%s: %s", condition.Type, condition.Message))
+			}
+		}
+		commands := make([]string, 0)
+		if len(ns.Finalizers) > 0 {
+			commands = append(commands, fmt.Sprintf(
+				`kubectl patch namespace %s --type=merge -p '{"metadata":{"finalizers":null}}'`, ns.Name))
+		}
+		if len(specFinalizers) > 0 {
+			commands = append(commands, fmt.Sprintf(
+				`kubectl get namespace %s -o json | jq '.spec.finalizers = []' | kubectl replace --raw /api/v1/namespaces/%s/finalize -f -`, ns.Name, ns.Name))
+		}
+		terminatingSince := ""
+		if ns.DeletionTimestamp != nil {
+			terminatingSince = ns.DeletionTimestamp.UTC().String()
+		}
+		stuck = append(stuck, map[string]any{
+			"Name":               ns.Name,
+			"TerminatingSince":   terminatingSince,
+			"Finalizers":         ns.Finalizers,
+			"SpecFinalizers":     specFinalizers,
+			"BlockingConditions": blocking,
+			"ClearCommands":      commands,
+		})
+	}
+	return stuck, nil
+}
+
+// NamespacesCompareKinds are the kinds compared by NamespacesCompare if none is provided
+var NamespacesCompareKinds = []schema.GroupVersionKind{
+	{Group: "", Version: "v1", Kind: "ConfigMap"},
+	{Group: "", Version: "v1", Kind: "Secret"},
+	{Group: "", Version: "v1", Kind: "Service"},
+	{Group: "", Version: "v1", Kind: "ServiceAccount"},
+	{Group: "", Version: "v1", Kind: "PersistentVolumeClaim"},
+	{Group: "apps", Version: "v1", Kind: "Deployment"},
+	{Group: "apps", Version: "v1", Kind: "StatefulSet"},
+	{Group: "apps", Version: "v1", Kind: "DaemonSet"},
+	{Group: "batch", Version: "v1", Kind: "CronJob"},
+	{Group: "networking.k8s.io", Version: "v1", Kind: "Ingress"},
+	{Group: "networking.k8s.io", Version: "v1", Kind: "NetworkPolicy"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "Role"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "RoleBinding"},
+}
+
+// NamespacesCompare reports the drift between the objects of the provided kinds (or NamespacesCompareKinds if none
+// is provided, skipping the kinds not served by the cluster) in namespaceA and namespaceB: the objects that exist in
+// only one of the namespaces and the objects whose normalized manifests differ (as a unified diff).
+// Manifests are normalized by stripping the server-managed fields (see ResourcesExport) and replacing the references
+// to the namespace itself (e.g. RoleBinding subjects, service DNS names) so that only the relevant differences remain.
+// Secret values are never returned, they're replaced by an HMAC keyed by a random key generated for each comparison so
+// that differences are still detected but the values can't be brute-forced from the output.
+// Objects created automatically in every namespace (e.g. the default ServiceAccount, kube-root-ca.crt) are ignored.
+func (k *Kubernetes) NamespacesCompare(ctx context.Context, namespaceA, namespaceB string, gvks []schema.GroupVersionKind) ([]map[string]any, error) {
+	explicit := len(gvks) > 0
+	if !explicit {
+		gvks = NamespacesCompareKinds
+	}
+	secretKey := make([]byte, 32)
+	if _, err := rand.Read(secretKey); err != nil {
+		return nil, err
+	}
+	var ret []map[string]any
+	for _, gvk := range gvks {
+		if _, err := k.resourceFor(&gvk); err != nil {
+			if explicit {
+				return nil, err
+			}
+			continue
+		}
+		objects := make([]map[string]*unstructured.Unstructured, 2)
+		for i, namespace := range []string{namespaceA, namespaceB} {
+			list, err := k.ResourcesList(ctx, &gvk, namespace, ResourceListOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list %s in namespace %s: %v", gvk.Kind, namespace, err)
+			}
+			objects[i] = make(map[string]*unstructured.Unstructured)
+			for _, item := range list.(*unstructured.UnstructuredList).Items {
+				if namespacesCompareIgnored(&item) {
+					continue
+				}
+				objects[i][item.GetName()] = namespacesCompareNormalized(&item, namespace, secretKey)
+			}
+		}
+		var names []string
+		for name := range objects[0] {
+			names = append(names, name)
+		}
+		for name := range objects[1] {
+			if _, ok := objects[0][name]; !ok {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			a, inA := objects[0][name]
+			b, inB := objects[1][name]
+			drift := map[string]any{"Kind": gvk.Kind, "Name": name}
+			switch {
+			case !inB:
+				drift["Drift"] = "only in " + namespaceA
+			case !inA:
+				drift["Drift"] = "only in " + namespaceB
+			default:
+				yamlA, err := diffableYaml(a)
+				if err != nil {
+					return nil, err
+				}
+				yamlB, err := diffableYaml(b)
+				if err != nil {
+					return nil, err
+				}
+				diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+					A:        difflib.SplitLines(yamlA),
+					B:        difflib.SplitLines(yamlB),
+					FromFile: namespaceA + "/" + name,
+					ToFile:   namespaceB + "/" + name,
+					Context:  3,
+				})
+				if err != nil {
+					return nil, err
+				}
+				if diff == "" {
+					continue
+				}
+				drift["Drift"] = "differs"
+				drift["Diff"] = diff
+			}
+			ret = append(ret, drift)
+		}
+	}
+	return ret, nil
+}
+
+// namespacesCompareIgnored returns true if the provided object is created automatically in every namespace
+func namespacesCompareIgnored(obj *unstructured.Unstructured) bool {
+	switch obj.GetKind() {
+	case "ConfigMap":
+		return slices.Contains([]string{"kube-root-ca.crt", "openshift-service-ca.crt"}, obj.GetName())
+	case "ServiceAccount":
+		return slices.Contains([]string{"default", "builder", "deployer", "pipeline"}, obj.GetName())
+	case "Secret":
+		_, serviceAccount := obj.GetAnnotations()[v1.ServiceAccountNameKey]
+		return serviceAccount
+	case "RoleBinding":
+		return strings.HasPrefix(obj.GetName(), "system:")
+	}
+	return false
+}
+
+// namespacesCompareNormalized returns a copy of the provided object stripped of the server-managed fields, with the
+// references to the provided namespace replaced by a placeholder and the Secret values replaced by their HMAC with the
+// provided key
+func namespacesCompareNormalized(obj *unstructured.Unstructured, namespace string, secretKey []byte) *unstructured.Unstructured {
+	obj = obj.DeepCopy()
+	exportObject(obj)
+	unstructured.RemoveNestedField(obj.Object, "metadata", "namespace")
+	annotations := obj.GetAnnotations()
+	delete(annotations, "deployment.kubernetes.io/revision")
+	if len(annotations) == 0 {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "annotations")
+	} else {
+		obj.SetAnnotations(annotations)
+	}
+	if obj.GetKind() == "Secret" {
+		for _, field := range []string{"data", "stringData"} {
+			data, _, _ := unstructured.NestedMap(obj.Object, field)
+			for key, value := range data {
+				mac := hmac.New(sha256.New, secretKey)
+				_, _ = mac.Write([]byte(fmt.Sprint(value)))
+				data[key] = "hmac:" + hex.EncodeToString(mac.Sum(nil))[:12]
+			}
+			if len(data) > 0 {
+				_ = unstructured.SetNestedMap(obj.Object, data, field)
+			}
+		}
+	}
+	obj.Object = namespacesCompareReplaceNamespace(obj.Object, namespace).(map[string]interface{})
+	return obj
+}
+
+// namespacesCompareReplaceNamespace replaces the references to the provided namespace in the string values of the
+// provided object (exact values, service DNS names, and service account usernames) by a placeholder
+func namespacesCompareReplaceNamespace(value interface{}, namespace string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = namespacesCompareReplaceNamespace(item, namespace)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = namespacesCompareReplaceNamespace(item, namespace)
+		}
+	case string:
+		if v == namespace {
+			return "<namespace>"
+		}
+		v = strings.ReplaceAll(v, "."+namespace+".svc", ".<namespace>.svc")
+		return strings.ReplaceAll(v, "system:serviceaccount:"+namespace+":", "system:serviceaccount:<namespace>:")
+	}
+	return value
+}
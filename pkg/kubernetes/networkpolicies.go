@@ -0,0 +1,155 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// NetworkPoliciesForPod returns the NetworkPolicies of the provided namespace whose pod selector matches the provided
+// Pod (or the provided Pod labels if no name is provided), summarizing their ingress and egress rules.
+// The Pod is isolated for ingress (or egress) if any of the matching policies applies to that direction, in that case
+// only the traffic allowed by the rules of the matching policies is permitted.
+func (k *Kubernetes) NetworkPoliciesForPod(ctx context.Context, namespace, name string, podLabels map[string]string) (map[string]any, error) {
+	namespace = k.NamespaceOrDefault(namespace)
+	ret := map[string]any{"Namespace": namespace}
+	if name != "" {
+		pods, err := k.manager.accessControlClientSet.Pods(namespace)
+		if err != nil {
+			return nil, err
+		}
+		pod, err := pods.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		ret["Pod"] = pod.Name
+		podLabels = pod.Labels
+	}
+	ret["Labels"] = podLabels
+	networkPolicies, err := k.manager.accessControlClientSet.NetworkPolicies(namespace)
+	if err != nil {
+		return nil, err
+	}
+	policyList, err := networkPolicies.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	ingressIsolated, egressIsolated := false, false
+	matching := make([]map[string]any, 0)
+	for _, policy := range policyList.Items {
+		selector, err := metav1.LabelSelectorAsSelector(&policy.Spec.PodSelector)
+		if err != nil || !selector.Matches(labels.Set(podLabels)) {
+			continue
+		}
+		summary := map[string]any{
+			"Name":        policy.Name,
+			"PodSelector": labelSelectorString(&policy.Spec.PodSelector, "all pods"),
+		}
+		policyTypes := networkPolicyTypes(&policy)
+		summary["PolicyTypes"] = policyTypes
+		if slices.Contains(policyTypes, string(networkingv1.PolicyTypeIngress)) {
+			ingressIsolated = true
+			rules := make([]string, 0, len(policy.Spec.Ingress))
+			for _, rule := range policy.Spec.Ingress {
+				rules = append(rules, "allow from "+networkPolicyPeers(rule.From)+" on "+networkPolicyPorts(rule.Ports))
+			}
+			if len(rules) == 0 {
+				rules = append(rules, "deny all ingress")
+			}
+			summary["Ingress"] = rules
+		}
+		if slices.Contains(policyTypes, string(networkingv1.PolicyTypeEgress)) {
+			egressIsolated = true
+			rules := make([]string, 0, len(policy.Spec.Egress))
+			for _, rule := range policy.Spec.Egress {
+				rules = append(rules, "allow to "+networkPolicyPeers(rule.To)+" on "+networkPolicyPorts(rule.Ports))
+			}
+			if len(rules) == 0 {
+				rules = append(rules, "deny all egress")
+			}
+			summary["Egress"] = rules
+		}
+		matching = append(matching, summary)
+	}
+	ret["IngressIsolated"] = ingressIsolated
+	ret["EgressIsolated"] = egressIsolated
+	ret["NetworkPolicies"] = matching
+	return ret, nil
+}
+
+// networkPolicyTypes returns the policy types of the provided NetworkPolicy, defaulted like the API server does when
+// not specified (Ingress, and Egress if the policy has egress rules)
+func networkPolicyTypes(policy *networkingv1.NetworkPolicy) []string {
+	ret := make([]string, 0, 2)
+	for _, policyType := range policy.Spec.PolicyTypes {
+		ret = append(ret, string(policyType))
+	}
+	if len(ret) == 0 {
+		ret = append(ret, string(networkingv1.PolicyTypeIngress))
+		if len(policy.Spec.Egress) > 0 {
+			ret = append(ret, string(networkingv1.PolicyTypeEgress))
+		}
+	}
+	return ret
+}
+
+// networkPolicyPeers returns a human-readable description of the provided NetworkPolicy peers
+func networkPolicyPeers(peers []networkingv1.NetworkPolicyPeer) string {
+	if len(peers) == 0 {
+		return "anywhere"
+	}
+	descriptions := make([]string, 0, len(peers))
+	for _, peer := range peers {
+		switch {
+		case peer.IPBlock != nil:
+			description := "CIDR " + peer.IPBlock.CIDR
+			if len(peer.IPBlock.Except) > 0 {
+				description += " except " + strings.Join(peer.IPBlock.Except, ", ")
+			}
+			descriptions = append(descriptions, description)
+		case peer.NamespaceSelector != nil:
+			description := "pods (" + labelSelectorString(peer.PodSelector, "all") + ")"
+			descriptions = append(descriptions, description+" in namespaces ("+labelSelectorString(peer.NamespaceSelector, "all")+")")
+		default:
+			descriptions = append(descriptions, "pods ("+labelSelectorString(peer.PodSelector, "all")+") in the same namespace")
+		}
+	}
+	return strings.Join(descriptions, " or ")
+}
+
+// networkPolicyPorts returns a human-readable description of the provided NetworkPolicy ports
+func networkPolicyPorts(ports []networkingv1.NetworkPolicyPort) string {
+	if len(ports) == 0 {
+		return "all ports"
+	}
+	descriptions := make([]string, 0, len(ports))
+	for _, port := range ports {
+		protocol := v1.ProtocolTCP
+		if port.Protocol != nil {
+			protocol = *port.Protocol
+		}
+		switch {
+		case port.Port == nil:
+			descriptions = append(descriptions, string(protocol)+"/all")
+		case port.EndPort != nil:
+			descriptions = append(descriptions, fmt.Sprintf("%s/%s-%d", protocol, port.Port.String(), *port.EndPort))
+		default:
+			descriptions = append(descriptions, string(protocol)+"/"+port.Port.String())
+		}
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+// labelSelectorString returns the provided label selector in string format (or the provided text if it selects everything)
+func labelSelectorString(selector *metav1.LabelSelector, all string) string {
+	if selector == nil || (len(selector.MatchLabels) == 0 && len(selector.MatchExpressions) == 0) {
+		return all
+	}
+	return metav1.FormatLabelSelector(selector)
+}
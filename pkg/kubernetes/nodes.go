@@ -3,8 +3,34 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/version"
 )
 
+// DefaultNodesDebugImage is the image of the node debug Pods, the same support tools image used by `oc debug node`
+const DefaultNodesDebugImage = "registry.redhat.io/rhel9/support-tools"
+
+// Default duration NodesDrain waits for the evicted pods of a node to terminate
+const DefaultNodesDrainTimeout = 5 * time.Minute
+
 func (k *Kubernetes) NodesLog(ctx context.Context, name string, query string, tailLines int64) (string, error) {
 	// Use the node proxy API to access logs from the kubelet
 	// https://kubernetes.io/docs/concepts/cluster-administration/system-logs/#log-query
@@ -58,3 +84,439 @@ func (k *Kubernetes) NodesStatsSummary(ctx context.Context, name string) (string
 
 	return string(rawData), nil
 }
+
+// NodesCordon marks the provided node as unschedulable so that no new pods are scheduled on it
+func (k *Kubernetes) NodesCordon(ctx context.Context, name string) error {
+	nodes, err := k.manager.accessControlClientSet.Nodes()
+	if err != nil {
+		return err
+	}
+	_, err = nodes.Patch(ctx, name, types.StrategicMergePatchType, []byte(`{"spec":{"unschedulable":true}}`), metav1.PatchOptions{})
+	return err
+}
+
+// NodesDrain evicts the pods running on the provided node and waits (up to the provided timeout) for them to terminate.
+// Evictions honor PodDisruptionBudgets, pods managed by a DaemonSet, mirror pods and completed pods are skipped
+// (same as `kubectl drain --ignore-daemonsets`).
+// Returns the evicted pods (namespace/name), including the ones evicted before a failure.
+func (k *Kubernetes) NodesDrain(ctx context.Context, name string, timeout time.Duration) ([]string, error) {
+	if timeout <= 0 {
+		timeout = DefaultNodesDrainTimeout
+	}
+	pods, err := k.manager.accessControlClientSet.Pods("")
+	if err != nil {
+		return nil, err
+	}
+	podList, err := pods.List(ctx, metav1.ListOptions{FieldSelector: fields.OneTermEqualSelector("spec.nodeName", name).String()})
+	if err != nil {
+		return nil, err
+	}
+	evicted := make([]string, 0)
+	evictedPods := make([]v1.Pod, 0)
+	for _, pod := range podList.Items {
+		if !isDrainable(&pod) {
+			continue
+		}
+		namespacedPods, err := k.manager.accessControlClientSet.Pods(pod.Namespace)
+		if err != nil {
+			return evicted, err
+		}
+		err = namespacedPods.EvictV1(ctx, &policyv1.Eviction{ObjectMeta: metav1.ObjectMeta{Namespace: pod.Namespace, Name: pod.Name}})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return evicted, fmt.Errorf("failed to evict pod %s/%s: %w", pod.Namespace, pod.Name, err)
+		}
+		evicted = append(evicted, pod.Namespace+"/"+pod.Name)
+		evictedPods = append(evictedPods, pod)
+	}
+	err = wait.PollUntilContextTimeout(ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		for _, pod := range evictedPods {
+			namespacedPods, err := k.manager.accessControlClientSet.Pods(pod.Namespace)
+			if err != nil {
+				return false, err
+			}
+			current, err := namespacedPods.Get(ctx, pod.Name, metav1.GetOptions{})
+			if apierrors.IsNotFound(err) {
+				continue
+			} else if err != nil {
+				return false, err
+			}
+			// A pod with the same name but a different UID has been recreated, the evicted one is gone
+			if current.UID == pod.UID {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return evicted, fmt.Errorf("failed waiting for the evicted pods to terminate: %w", err)
+	}
+	return evicted, nil
+}
+
+// NodesDebug generates a privileged debug Pod pinned to the provided node, mirroring `oc debug node/<name>`: the Pod
+// shares the host network, PID, and IPC namespaces, tolerates all the taints, and mounts the host root filesystem at
+// /host (use `chroot /host` to run commands on the host).
+// The Pod is only created if live is true, otherwise the generated manifest is returned for review.
+func (k *Kubernetes) NodesDebug(ctx context.Context, namespace, node, image string, live bool) (*unstructured.Unstructured, error) {
+	nodes, err := k.manager.accessControlClientSet.Nodes()
+	if err != nil {
+		return nil, err
+	}
+	if _, err = nodes.Get(ctx, node, metav1.GetOptions{}); err != nil {
+		return nil, err
+	}
+	if image == "" {
+		image = DefaultNodesDebugImage
+	}
+	// The name is also the value of the name label, the node part is truncated to fit in a label value (as oc debug does)
+	suffix := "-debug-" + rand.String(5)
+	name := strings.ReplaceAll(node, ".", "-")
+	name = name[:min(len(name), validation.LabelValueMaxLength-len(suffix))] + suffix
+	pod := &v1.Pod{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: k.NamespaceOrDefault(namespace),
+			Labels: map[string]string{
+				AppKubernetesName:      name,
+				AppKubernetesComponent: "node-debug",
+				AppKubernetesManagedBy: version.BinaryName,
+			},
+			Annotations: map[string]string{
+				"debug.openshift.io/source-container": "container-00",
+				"debug.openshift.io/source-resource":  "/v1, Resource=nodes/" + node,
+			},
+		},
+		Spec: v1.PodSpec{
+			NodeName:      node,
+			HostNetwork:   true,
+			HostPID:       true,
+			HostIPC:       true,
+			RestartPolicy: v1.RestartPolicyNever,
+			Tolerations:   []v1.Toleration{{Operator: v1.TolerationOpExists}},
+			Containers: []v1.Container{{
+				Name:    "container-00",
+				Image:   image,
+				Command: []string{"/bin/sh", "-c", "sleep infinity"},
+				SecurityContext: &v1.SecurityContext{
+					Privileged: ptr.To(true),
+					RunAsUser:  ptr.To(int64(0)),
+				},
+				VolumeMounts: []v1.VolumeMount{{Name: "host", MountPath: "/host"}},
+			}},
+			Volumes: []v1.Volume{{
+				Name: "host",
+				VolumeSource: v1.VolumeSource{
+					HostPath: &v1.HostPathVolumeSource{Path: "/", Type: ptr.To(v1.HostPathDirectory)},
+				},
+			}},
+		},
+	}
+	raw, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	if err != nil {
+		return nil, err
+	}
+	obj := &unstructured.Unstructured{Object: raw}
+	if !live {
+		return obj, nil
+	}
+	created, err := k.resourcesCreateOrUpdate(ctx, []*unstructured.Unstructured{obj})
+	if err != nil {
+		return nil, err
+	}
+	return created[0], nil
+}
+
+// NodesListSortBy are the sort orders supported by NodesList
+var NodesListSortBy = []string{"name", "cpu", "memory", "age"}
+
+// NodesListRoles are the node roles supported by the NodesList filter
+var NodesListRoles = []string{"control-plane", "worker"}
+
+// nodeRoleLabelPrefix is the prefix of the labels setting the roles of a node (e.g. node-role.kubernetes.io/worker)
+const nodeRoleLabelPrefix = "node-role.kubernetes.io/"
+
+type NodesListOptions struct {
+	// SortBy is the sort order of the nodes (see NodesListSortBy), by name if empty
+	SortBy string
+	// Role only returns the nodes with the provided role (see NodesListRoles), all the nodes if empty
+	Role string
+}
+
+// NodesList summarizes the nodes of the cluster: their roles (derived from the node-role.kubernetes.io labels), ready
+// condition, kubelet version, OS image, allocatable CPU and memory, and age.
+// Nodes are sorted by name, by allocatable CPU or memory (largest first), or by age (oldest first).
+// Nodes without a control-plane (or legacy master) role are considered workers.
+func (k *Kubernetes) NodesList(ctx context.Context, options NodesListOptions) ([]map[string]any, error) {
+	if options.SortBy != "" && !slices.Contains(NodesListSortBy, options.SortBy) {
+		return nil, fmt.Errorf("unsupported sort_by %s, supported values are: %s", options.SortBy, strings.Join(NodesListSortBy, ", "))
+	}
+	if options.Role != "" && !slices.Contains(NodesListRoles, options.Role) {
+		return nil, fmt.Errorf("unsupported role %s, supported values are: %s", options.Role, strings.Join(NodesListRoles, ", "))
+	}
+	nodes, err := k.manager.accessControlClientSet.Nodes()
+	if err != nil {
+		return nil, err
+	}
+	nodeList, err := nodes.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]v1.Node, 0, len(nodeList.Items))
+	for _, node := range nodeList.Items {
+		if options.Role == "" || slices.Contains(nodeRoles(&node), options.Role) {
+			items = append(items, node)
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		switch options.SortBy {
+		case "cpu":
+			if c := items[i].Status.Allocatable.Cpu().Cmp(*items[j].Status.Allocatable.Cpu()); c != 0 {
+				return c > 0
+			}
+		case "memory":
+			if c := items[i].Status.Allocatable.Memory().Cmp(*items[j].Status.Allocatable.Memory()); c != 0 {
+				return c > 0
+			}
+		case "age":
+			if !items[i].CreationTimestamp.Equal(&items[j].CreationTimestamp) {
+				return items[i].CreationTimestamp.Before(&items[j].CreationTimestamp)
+			}
+		}
+		return items[i].Name < items[j].Name
+	})
+	ret := make([]map[string]any, 0, len(items))
+	for _, node := range items {
+		ready := "Unknown"
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == v1.NodeReady {
+				ready = string(condition.Status)
+			}
+		}
+		current := map[string]any{
+			"Name":           node.Name,
+			"Roles":          nodeRoles(&node),
+			"Ready":          ready,
+			"KubeletVersion": node.Status.NodeInfo.KubeletVersion,
+			"OSImage":        node.Status.NodeInfo.OSImage,
+			"CPU":            node.Status.Allocatable.Cpu().String(),
+			"Memory":         node.Status.Allocatable.Memory().String(),
+		}
+		if node.Spec.Unschedulable {
+			current["Unschedulable"] = true
+		}
+		if !node.CreationTimestamp.IsZero() {
+			current["Age"] = duration.HumanDuration(time.Since(node.CreationTimestamp.Time))
+		}
+		ret = append(ret, current)
+	}
+	return ret, nil
+}
+
+// nodeRoles returns the sorted roles of the provided node from its node-role.kubernetes.io labels, the legacy master
+// role is reported as control-plane and nodes without a control-plane role are reported as workers
+func nodeRoles(node *v1.Node) []string {
+	roles := make([]string, 0)
+	for label := range node.Labels {
+		role, ok := strings.CutPrefix(label, nodeRoleLabelPrefix)
+		if !ok || role == "" {
+			continue
+		}
+		if role == "master" {
+			role = "control-plane"
+		}
+		if !slices.Contains(roles, role) {
+			roles = append(roles, role)
+		}
+	}
+	if !slices.Contains(roles, "control-plane") && !slices.Contains(roles, "worker") {
+		roles = append(roles, "worker")
+	}
+	sort.Strings(roles)
+	return roles
+}
+
+// NodesTaintReport lists the taints of all the nodes and reports the scheduling exclusions they cause for the Pods of
+// the provided namespace, or for the Pods of the provided workload (Deployment, StatefulSet, DaemonSet, or Job) if a
+// kind and name are provided: the nodes each Pod can't be scheduled on because it doesn't tolerate their NoSchedule or
+// NoExecute taints (PreferNoSchedule taints are listed but don't exclude any Pod).
+func (k *Kubernetes) NodesTaintReport(ctx context.Context, namespace, kind, name string) (map[string]any, error) {
+	namespace = k.NamespaceOrDefault(namespace)
+	type subject struct {
+		name        string
+		tolerations []v1.Toleration
+	}
+	var subjects []subject
+	if kind != "" {
+		gvk, err := workloadGVK(kind)
+		if err != nil {
+			return nil, err
+		}
+		workload, err := k.ResourcesGet(ctx, gvk, namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		rawTemplate, _, _ := unstructured.NestedMap(workload.Object, "spec", "template")
+		template := &v1.PodTemplateSpec{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(rawTemplate, template); err != nil {
+			return nil, err
+		}
+		subjects = append(subjects, subject{name: kind + "/" + name, tolerations: template.Spec.Tolerations})
+	} else {
+		pods, err := k.manager.accessControlClientSet.Pods(namespace)
+		if err != nil {
+			return nil, err
+		}
+		podList, err := pods.List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, pod := range podList.Items {
+			if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+				continue
+			}
+			subjects = append(subjects, subject{name: "Pod/" + pod.Name, tolerations: pod.Spec.Tolerations})
+		}
+		sort.Slice(subjects, func(i, j int) bool {
+			return subjects[i].name < subjects[j].name
+		})
+	}
+	nodes, err := k.manager.accessControlClientSet.Nodes()
+	if err != nil {
+		return nil, err
+	}
+	nodeList, err := nodes.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(nodeList.Items, func(i, j int) bool {
+		return nodeList.Items[i].Name < nodeList.Items[j].Name
+	})
+	taints := make([]map[string]any, 0)
+	for _, node := range nodeList.Items {
+		if len(node.Spec.Taints) == 0 {
+			continue
+		}
+		nodeTaints := make([]string, 0, len(node.Spec.Taints))
+		for _, taint := range node.Spec.Taints {
+			nodeTaints = append(nodeTaints, taint.ToString())
+		}
+		taints = append(taints, map[string]any{"Node": node.Name, "Taints": nodeTaints})
+	}
+	mismatches := make([]map[string]any, 0)
+	for _, s := range subjects {
+		excluded := make([]map[string]any, 0)
+		for _, node := range nodeList.Items {
+			untolerated := untoleratedTaints(s.tolerations, node.Spec.Taints)
+			if len(untolerated) == 0 {
+				continue
+			}
+			nodeTaints := make([]string, 0, len(untolerated))
+			for _, taint := range untolerated {
+				nodeTaints = append(nodeTaints, taint.ToString())
+			}
+			excluded = append(excluded, map[string]any{"Node": node.Name, "UntoleratedTaints": nodeTaints})
+		}
+		if len(excluded) == 0 {
+			continue
+		}
+		mismatches = append(mismatches, map[string]any{
+			"Name":          s.name,
+			"ExcludedNodes": excluded,
+			"EligibleNodes": len(nodeList.Items) - len(excluded),
+		})
+	}
+	return map[string]any{"Namespace": namespace, "Taints": taints, "Mismatches": mismatches}, nil
+}
+
+// NodesMaintenance cordons and drains, one after the other, the nodes matching the provided label selector.
+// Nodes are processed sequentially to preserve the availability of the workloads, the maintenance stops at the first
+// node that fails to be cordoned or drained.
+// The maintenance is refused if, once all the matching nodes are cordoned, more than maxUnavailable nodes of the
+// cluster would be unavailable (not ready or unschedulable).
+// Returns the progress of every matching node and whether the maintenance completed.
+func (k *Kubernetes) NodesMaintenance(ctx context.Context, labelSelector string, maxUnavailable int, timeout time.Duration) ([]map[string]any, bool, error) {
+	nodes, err := k.manager.accessControlClientSet.Nodes()
+	if err != nil {
+		return nil, false, err
+	}
+	nodeList, err := nodes.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	selectedList, err := nodes.List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return nil, false, err
+	}
+	if len(selectedList.Items) == 0 {
+		return nil, false, fmt.Errorf("no nodes match the label selector %s", labelSelector)
+	}
+	selected := make([]string, 0, len(selectedList.Items))
+	for _, node := range selectedList.Items {
+		selected = append(selected, node.Name)
+	}
+	sort.Strings(selected)
+	unavailable := len(selected)
+	for _, node := range nodeList.Items {
+		if !slices.Contains(selected, node.Name) && (node.Spec.Unschedulable || !isNodeReady(&node)) {
+			unavailable++
+		}
+	}
+	if unavailable > maxUnavailable {
+		return nil, false, fmt.Errorf("draining the %d matching nodes (%s) would leave %d of %d nodes unavailable, exceeding max_unavailable=%d",
+			len(selected), strings.Join(selected, ", "), unavailable, len(nodeList.Items), maxUnavailable)
+	}
+	ret := make([]map[string]any, 0, len(selected))
+	completed := true
+	for _, name := range selected {
+		progress := map[string]any{"Node": name}
+		ret = append(ret, progress)
+		if !completed {
+			progress["Status"] = "skipped"
+			continue
+		}
+		if err = k.NodesCordon(ctx, name); err != nil {
+			progress["Status"] = "failed"
+			progress["Error"] = fmt.Sprintf("failed to cordon node: %v", err)
+			completed = false
+			continue
+		}
+		progress["Cordoned"] = true
+		evicted, err := k.NodesDrain(ctx, name, timeout)
+		progress["EvictedPods"] = evicted
+		if err != nil {
+			progress["Status"] = "failed"
+			progress["Error"] = fmt.Sprintf("failed to drain node: %v", err)
+			completed = false
+			continue
+		}
+		progress["Status"] = "drained"
+	}
+	return ret, completed, nil
+}
+
+// isDrainable returns true if the provided pod needs to be evicted when draining its node
+func isDrainable(pod *v1.Pod) bool {
+	if _, mirror := pod.Annotations[v1.MirrorPodAnnotationKey]; mirror {
+		return false
+	}
+	if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+		return false
+	}
+	for _, owner := range pod.OwnerReferences {
+		if owner.Controller != nil && *owner.Controller && owner.Kind == "DaemonSet" {
+			return false
+		}
+	}
+	return true
+}
+
+// isNodeReady returns true if the provided node reports a Ready condition with status True
+func isNodeReady(node *v1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == v1.NodeReady {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,120 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var clusterServiceVersionGVK = &schema.GroupVersionKind{Group: "operators.coreos.com", Version: "v1alpha1", Kind: "ClusterServiceVersion"}
+
+// ClusterServiceVersionGet summarizes the OLM ClusterServiceVersion with the provided name (or operator display name,
+// case-insensitive) in the provided namespace: its phase, version, supported install modes, the CRDs and APIServices
+// it owns and requires, and the Deployments it manages.
+func (k *Kubernetes) ClusterServiceVersionGet(ctx context.Context, namespace, name string) (map[string]any, error) {
+	namespace = k.NamespaceOrDefault(namespace)
+	csv, err := k.ResourcesGet(ctx, clusterServiceVersionGVK, namespace, name)
+	if apierrors.IsNotFound(err) {
+		csv, err = k.clusterServiceVersionByDisplayName(ctx, namespace, name, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	ret := map[string]any{
+		"Name":      csv.GetName(),
+		"Namespace": csv.GetNamespace(),
+	}
+	for field, path := range map[string][]string{
+		"DisplayName": {"spec", "displayName"},
+		"Version":     {"spec", "version"},
+		"Provider":    {"spec", "provider", "name"},
+		"Replaces":    {"spec", "replaces"},
+		"Phase":       {"status", "phase"},
+		"Reason":      {"status", "reason"},
+		"Message":     {"status", "message"},
+	} {
+		if value, _, _ := unstructured.NestedString(csv.Object, path...); value != "" {
+			ret[field] = value
+		}
+	}
+	installModes, _, _ := unstructured.NestedSlice(csv.Object, "spec", "installModes")
+	supported := make([]string, 0, len(installModes))
+	for _, installMode := range installModes {
+		if mode, ok := installMode.(map[string]interface{}); ok && mode["supported"] == true {
+			supported = append(supported, fmt.Sprint(mode["type"]))
+		}
+	}
+	ret["InstallModes"] = supported
+	for field, path := range map[string][]string{
+		"OwnedCRDs":           {"spec", "customresourcedefinitions", "owned"},
+		"RequiredCRDs":        {"spec", "customresourcedefinitions", "required"},
+		"OwnedAPIServices":    {"spec", "apiservicedefinitions", "owned"},
+		"RequiredAPIServices": {"spec", "apiservicedefinitions", "required"},
+	} {
+		if apis := clusterServiceVersionAPIs(csv, path...); len(apis) > 0 {
+			ret[field] = apis
+		}
+	}
+	deployments, _, _ := unstructured.NestedSlice(csv.Object, "spec", "install", "spec", "deployments")
+	deploymentNames := make([]string, 0, len(deployments))
+	for _, deployment := range deployments {
+		if d, ok := deployment.(map[string]interface{}); ok {
+			deploymentNames = append(deploymentNames, fmt.Sprint(d["name"]))
+		}
+	}
+	ret["Deployments"] = deploymentNames
+	return ret, nil
+}
+
+// clusterServiceVersionByDisplayName returns the single ClusterServiceVersion of the provided namespace whose display
+// name matches the provided one (case-insensitive), notFound is returned if there is none
+func (k *Kubernetes) clusterServiceVersionByDisplayName(ctx context.Context, namespace, displayName string, notFound error) (*unstructured.Unstructured, error) {
+	list, err := k.ResourcesList(ctx, clusterServiceVersionGVK, namespace, ResourceListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var matches []unstructured.Unstructured
+	for _, item := range list.(*unstructured.UnstructuredList).Items {
+		if value, _, _ := unstructured.NestedString(item.Object, "spec", "displayName"); strings.EqualFold(value, displayName) {
+			matches = append(matches, item)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, notFound
+	case 1:
+		return &matches[0], nil
+	}
+	names := make([]string, 0, len(matches))
+	for _, match := range matches {
+		names = append(names, match.GetName())
+	}
+	sort.Strings(names)
+	return nil, fmt.Errorf("multiple cluster service versions with display name %s found, use one of: %s", displayName, strings.Join(names, ", "))
+}
+
+// clusterServiceVersionAPIs returns the CRDs or APIServices (name, version, kind) listed in the provided field of the
+// ClusterServiceVersion spec
+func clusterServiceVersionAPIs(csv *unstructured.Unstructured, path ...string) []map[string]any {
+	items, _, _ := unstructured.NestedSlice(csv.Object, path...)
+	ret := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		definition, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		api := map[string]any{}
+		for _, field := range []string{"name", "group", "version", "kind", "displayName"} {
+			if value, ok := definition[field].(string); ok && value != "" {
+				api[strings.ToUpper(field[:1])+field[1:]] = value
+			}
+		}
+		ret = append(ret, api)
+	}
+	return ret
+}
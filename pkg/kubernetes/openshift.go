@@ -2,12 +2,19 @@ package kubernetes
 
 import (
 	"context"
+	"fmt"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/version"
 )
 
 type Openshift interface {
 	IsOpenShift(context.Context) bool
+	// OpenShiftMinorVersion returns the minor version of the OpenShift 4.x cluster (e.g. 15 for 4.15.3)
+	// and whether it could be detected.
+	OpenShiftMinorVersion(context.Context) (int, bool)
 }
 
 func (m *Manager) IsOpenShift(_ context.Context) bool {
@@ -18,3 +25,61 @@ func (m *Manager) IsOpenShift(_ context.Context) bool {
 	}.String())
 	return err == nil
 }
+
+func (m *Manager) OpenShiftMinorVersion(ctx context.Context) (int, bool) {
+	m.openShiftVersionMutex.Lock()
+	defer m.openShiftVersionMutex.Unlock()
+	if m.openShiftMinorVersion > 0 {
+		return m.openShiftMinorVersion, true
+	}
+	if !m.IsOpenShift(ctx) {
+		return 0, false
+	}
+	minor, err := m.clusterVersionMinor(ctx)
+	if err != nil {
+		// ClusterVersion is not readable by every user, infer the version from the Kubernetes version instead
+		minor, err = m.kubernetesVersionMinor()
+	}
+	if err != nil {
+		return 0, false
+	}
+	// Only successful detections are cached, so that transient failures are retried
+	m.openShiftMinorVersion = minor
+	return minor, true
+}
+
+// clusterVersionMinor returns the minor version reported by the config.openshift.io/v1 ClusterVersion
+func (m *Manager) clusterVersionMinor(ctx context.Context) (int, error) {
+	clusterVersion, err := m.dynamicClient.Resource(schema.GroupVersionResource{
+		Group: "config.openshift.io", Version: "v1", Resource: "clusterversions",
+	}).Get(ctx, "version", metav1.GetOptions{})
+	if err != nil {
+		return 0, err
+	}
+	desired, _, _ := unstructured.NestedString(clusterVersion.Object, "status", "desired", "version")
+	v, err := version.ParseGeneric(desired)
+	if err != nil {
+		return 0, err
+	}
+	if v.Major() != 4 {
+		return 0, fmt.Errorf("unsupported OpenShift version %s", desired)
+	}
+	return int(v.Minor()), nil
+}
+
+// kubernetesVersionMinor infers the OpenShift minor version from the Kubernetes server version
+// (OpenShift 4.x ships Kubernetes 1.(x+13), e.g. 4.15 ships Kubernetes 1.28)
+func (m *Manager) kubernetesVersionMinor() (int, error) {
+	serverVersion, err := m.discoveryClient.ServerVersion()
+	if err != nil {
+		return 0, err
+	}
+	v, err := version.ParseGeneric(serverVersion.GitVersion)
+	if err != nil {
+		return 0, err
+	}
+	if v.Major() != 1 || v.Minor() < 14 {
+		return 0, fmt.Errorf("unsupported Kubernetes version %s", serverVersion.GitVersion)
+	}
+	return int(v.Minor()) - 13, nil
+}
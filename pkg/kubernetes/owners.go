@@ -0,0 +1,152 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ownedKinds are the kinds inspected to find the children controlled by an object (the workloads and their Pods)
+var ownedKinds = []schema.GroupVersionKind{
+	{Group: "apps", Version: "v1", Kind: "Deployment"},
+	{Group: "apps", Version: "v1", Kind: "StatefulSet"},
+	{Group: "apps", Version: "v1", Kind: "DaemonSet"},
+	{Group: "apps", Version: "v1", Kind: "ReplicaSet"},
+	{Group: "batch", Version: "v1", Kind: "Job"},
+	{Group: "", Version: "v1", Kind: "ReplicationController"},
+	{Group: "", Version: "v1", Kind: "Pod"},
+}
+
+// maxOwnersDepth bounds the walks of the ownerReferences chains (guards against cycles)
+const maxOwnersDepth = 10
+
+// ResourcesOwners walks the ownerReferences of the provided resource up to its root owner (e.g. Pod -> ReplicaSet ->
+// Deployment) and returns the chain starting with the resource itself.
+// The controller reference is followed (or the first reference if none is the controller). An owner that can't be
+// retrieved (deleted, replaced by an object with a different uid, forbidden, or a namespaced owner of a cluster-scoped
+// resource) ends the chain and is reported with the reason under Missing.
+// If children is true, the workloads and Pods controlled by the resource are listed recursively under Children.
+func (k *Kubernetes) ResourcesOwners(ctx context.Context, gvk *schema.GroupVersionKind, namespace, name string, children bool) (map[string]any, error) {
+	obj, err := k.ResourcesGet(ctx, gvk, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	chain := []map[string]any{ownerChainEntry(obj)}
+	visited := map[types.UID]bool{obj.GetUID(): true}
+	for current := obj; len(chain) <= maxOwnersDepth; {
+		owner := controllerOwnerReference(current.GetOwnerReferences())
+		if owner == nil {
+			break
+		}
+		entry := map[string]any{"APIVersion": owner.APIVersion, "Kind": owner.Kind, "Name": owner.Name}
+		ownerGVK := schema.FromAPIVersionAndKind(owner.APIVersion, owner.Kind)
+		ownerNamespace := ""
+		if namespaced, _ := k.isNamespaced(&ownerGVK); namespaced {
+			// Namespaced owners must live in the namespace of their dependents
+			if current.GetNamespace() == "" {
+				entry["Missing"] = "namespaced owner of a cluster-scoped resource is not allowed"
+				chain = append(chain, entry)
+				break
+			}
+			ownerNamespace = current.GetNamespace()
+			entry["Namespace"] = ownerNamespace
+		}
+		ownerObj, err := k.ResourcesGet(ctx, &ownerGVK, ownerNamespace, owner.Name)
+		switch {
+		case err != nil:
+			entry["Missing"] = err.Error()
+		case owner.UID != "" && ownerObj.GetUID() != owner.UID:
+			entry["Missing"] = fmt.Sprintf("owner with uid %s not found, found uid %s", owner.UID, ownerObj.GetUID())
+		case visited[ownerObj.GetUID()]:
+			entry["Missing"] = "ownerReferences cycle"
+		}
+		if _, missing := entry["Missing"]; missing {
+			chain = append(chain, entry)
+			break
+		}
+		visited[ownerObj.GetUID()] = true
+		chain = append(chain, ownerChainEntry(ownerObj))
+		current = ownerObj
+	}
+	ret := map[string]any{"Chain": chain}
+	if children {
+		if ret["Children"], err = k.resourcesControlledChildren(ctx, obj); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+// resourcesControlledChildren returns the objects of the ownedKinds (in the namespace of the provided object, or all
+// namespaces for cluster-scoped objects) controlled by the provided object, recursively.
+// Kinds not served by the cluster or that can't be listed (forbidden) are skipped.
+func (k *Kubernetes) resourcesControlledChildren(ctx context.Context, obj *unstructured.Unstructured) ([]map[string]any, error) {
+	controlled := map[types.UID][]unstructured.Unstructured{}
+	for _, kind := range ownedKinds {
+		if _, err := k.resourceFor(&kind); err != nil {
+			continue
+		}
+		list, err := k.ResourcesList(ctx, &kind, obj.GetNamespace(), ResourceListOptions{})
+		if apierrors.IsForbidden(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		for _, item := range list.(*unstructured.UnstructuredList).Items {
+			if controller := metav1.GetControllerOfNoCopy(&item); controller != nil {
+				controlled[controller.UID] = append(controlled[controller.UID], item)
+			}
+		}
+	}
+	var walk func(uid types.UID, depth int) []map[string]any
+	walk = func(uid types.UID, depth int) []map[string]any {
+		items := controlled[uid]
+		sort.SliceStable(items, func(i, j int) bool {
+			if items[i].GetKind() != items[j].GetKind() {
+				return items[i].GetKind() < items[j].GetKind()
+			}
+			return items[i].GetName() < items[j].GetName()
+		})
+		ret := make([]map[string]any, 0, len(items))
+		for _, item := range items {
+			entry := ownerChainEntry(&item)
+			if depth < maxOwnersDepth {
+				if grandChildren := walk(item.GetUID(), depth+1); len(grandChildren) > 0 {
+					entry["Children"] = grandChildren
+				}
+			}
+			ret = append(ret, entry)
+		}
+		return ret
+	}
+	return walk(obj.GetUID(), 1), nil
+}
+
+// controllerOwnerReference returns the controller reference of the provided ownerReferences, or the first reference
+// if none is the controller, or nil if there are no references
+func controllerOwnerReference(references []metav1.OwnerReference) *metav1.OwnerReference {
+	for i := range references {
+		if references[i].Controller != nil && *references[i].Controller {
+			return &references[i]
+		}
+	}
+	if len(references) > 0 {
+		return &references[0]
+	}
+	return nil
+}
+
+// ownerChainEntry returns the apiVersion, kind, namespace (if any), and name identifying the provided object
+func ownerChainEntry(obj *unstructured.Unstructured) map[string]any {
+	entry := map[string]any{"APIVersion": obj.GetAPIVersion(), "Kind": obj.GetKind(), "Name": obj.GetName()}
+	if obj.GetNamespace() != "" {
+		entry["Namespace"] = obj.GetNamespace()
+	}
+	return entry
+}
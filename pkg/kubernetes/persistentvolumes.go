@@ -0,0 +1,95 @@
+package kubernetes
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// PersistentVolumeClaimsList summarizes the PersistentVolumeClaims of the provided namespace (or all namespaces).
+// For each claim, the requested capacity is reported along with the capacity of the bound volume (if any).
+func (k *Kubernetes) PersistentVolumeClaimsList(ctx context.Context, namespace string) ([]map[string]any, error) {
+	var pvcMap []map[string]any
+	raw, err := k.ResourcesList(ctx, &schema.GroupVersionKind{
+		Group: "", Version: "v1", Kind: "PersistentVolumeClaim",
+	}, namespace, ResourceListOptions{})
+	if err != nil {
+		return pvcMap, err
+	}
+	for _, item := range raw.(*unstructured.UnstructuredList).Items {
+		pvc := &v1.PersistentVolumeClaim{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, pvc); err != nil {
+			return pvcMap, err
+		}
+		requested := ""
+		if storage, ok := pvc.Spec.Resources.Requests[v1.ResourceStorage]; ok {
+			requested = storage.String()
+		}
+		capacity := ""
+		if storage, ok := pvc.Status.Capacity[v1.ResourceStorage]; ok {
+			capacity = storage.String()
+		}
+		storageClass := ""
+		if pvc.Spec.StorageClassName != nil {
+			storageClass = *pvc.Spec.StorageClassName
+		}
+		pvcMap = append(pvcMap, map[string]any{
+			"Namespace":         pvc.Namespace,
+			"Name":              pvc.Name,
+			"Phase":             string(pvc.Status.Phase),
+			"RequestedCapacity": requested,
+			"Capacity":          capacity,
+			"StorageClass":      storageClass,
+			"AccessModes":       accessModes(pvc.Spec.AccessModes),
+			"VolumeName":        pvc.Spec.VolumeName,
+		})
+	}
+	return pvcMap, nil
+}
+
+// PersistentVolumesGet summarizes the provided PersistentVolume including its underlying volume source.
+func (k *Kubernetes) PersistentVolumesGet(ctx context.Context, name string) (map[string]any, error) {
+	raw, err := k.ResourcesGet(ctx, &schema.GroupVersionKind{
+		Group: "", Version: "v1", Kind: "PersistentVolume",
+	}, "", name)
+	if err != nil {
+		return nil, err
+	}
+	pv := &v1.PersistentVolume{}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, pv); err != nil {
+		return nil, err
+	}
+	capacity := ""
+	if storage, ok := pv.Spec.Capacity[v1.ResourceStorage]; ok {
+		capacity = storage.String()
+	}
+	claim := ""
+	if pv.Spec.ClaimRef != nil {
+		claim = pv.Spec.ClaimRef.Namespace + "/" + pv.Spec.ClaimRef.Name
+	}
+	volumeSource, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&pv.Spec.PersistentVolumeSource)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{
+		"Name":          pv.Name,
+		"Phase":         string(pv.Status.Phase),
+		"Capacity":      capacity,
+		"StorageClass":  pv.Spec.StorageClassName,
+		"AccessModes":   accessModes(pv.Spec.AccessModes),
+		"ReclaimPolicy": string(pv.Spec.PersistentVolumeReclaimPolicy),
+		"Claim":         claim,
+		"VolumeSource":  volumeSource,
+	}, nil
+}
+
+func accessModes(modes []v1.PersistentVolumeAccessMode) []string {
+	ret := make([]string, 0, len(modes))
+	for _, mode := range modes {
+		ret = append(ret, string(mode))
+	}
+	return ret
+}
@@ -5,15 +5,27 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"net"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	labelutil "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/tools/remotecommand"
 	"k8s.io/metrics/pkg/apis/metrics"
 	metricsv1beta1api "k8s.io/metrics/pkg/apis/metrics/v1beta1"
@@ -96,6 +108,62 @@ func (k *Kubernetes) PodsDelete(ctx context.Context, namespace, name string) (st
 		k.ResourcesDelete(ctx, &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Pod"}, namespace, name)
 }
 
+// PodsEvict evicts the provided Pod through the Eviction API, honoring its PodDisruptionBudgets (unlike a delete).
+// An eviction blocked by a PodDisruptionBudget is not an error, Evicted is false and the blocking budgets are reported
+// under BlockedBy along with the reason.
+func (k *Kubernetes) PodsEvict(ctx context.Context, namespace, name string) (map[string]any, error) {
+	namespace = k.NamespaceOrDefault(namespace)
+	pods, err := k.manager.accessControlClientSet.Pods(namespace)
+	if err != nil {
+		return nil, err
+	}
+	pod, err := pods.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	ret := map[string]any{"Namespace": namespace, "Name": name}
+	err = pods.EvictV1(ctx, &policyv1.Eviction{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}})
+	if err == nil {
+		ret["Evicted"] = true
+		return ret, nil
+	}
+	// The eviction API rejects evictions that would violate a PodDisruptionBudget with 429 TooManyRequests
+	if !apierrors.IsTooManyRequests(err) {
+		return nil, err
+	}
+	ret["Evicted"] = false
+	reason := err.Error()
+	var statusErr *apierrors.StatusError
+	if errors.As(err, &statusErr) && statusErr.ErrStatus.Details != nil {
+		for _, cause := range statusErr.ErrStatus.Details.Causes {
+			if cause.Type == policyv1.DisruptionBudgetCause {
+				reason = cause.Message
+			}
+		}
+	}
+	ret["Reason"] = reason
+	if budgets, err := k.ResourcesList(ctx, &schema.GroupVersionKind{
+		Group: "policy", Version: "v1", Kind: "PodDisruptionBudget",
+	}, namespace, ResourceListOptions{}); err == nil {
+		var blockedBy []string
+		for _, item := range budgets.(*unstructured.UnstructuredList).Items {
+			budget := &policyv1.PodDisruptionBudget{}
+			if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, budget); err != nil {
+				continue
+			}
+			selector, err := metav1.LabelSelectorAsSelector(budget.Spec.Selector)
+			if err != nil || !selector.Matches(labelutil.Set(pod.Labels)) {
+				continue
+			}
+			blockedBy = append(blockedBy, budget.Name)
+		}
+		if len(blockedBy) > 0 {
+			ret["BlockedBy"] = blockedBy
+		}
+	}
+	return ret, nil
+}
+
 func (k *Kubernetes) PodsLog(ctx context.Context, namespace, name, container string, previous bool, tail int64) (string, error) {
 	pods, err := k.manager.accessControlClientSet.Pods(k.NamespaceOrDefault(namespace))
 	if err != nil {
@@ -221,6 +289,554 @@ func (k *Kubernetes) PodsTop(ctx context.Context, options PodsTopOptions) (*metr
 	return k.manager.accessControlClientSet.PodsMetricses(ctx, namespace, options.Name, options.ListOptions)
 }
 
+// podsNearLimitPercent is the usage percentage of a container limit above which the container is reported as near
+// its limit (CPU throttling, memory OOM kill)
+const podsNearLimitPercent = 90
+
+// PodsResourcePressure joins the live usage of the containers (metrics.k8s.io) of the pods in the provided namespace
+// (or all namespaces) with their requests and limits and reports the CPU and memory usages at least thresholdPercent
+// of the request (under-provisioned workloads) or at least 90% of the limit (throttled or about to be OOM killed),
+// sorted by the highest usage percentage.
+// Namespaces that can't be scanned (Forbidden or transient errors) are skipped and reported as warnings.
+func (k *Kubernetes) PodsResourcePressure(ctx context.Context, namespace string, thresholdPercent int) ([]map[string]any, []ScanWarning, error) {
+	if !k.supportsGroupVersion(metrics.GroupName + "/" + metricsv1beta1api.SchemeGroupVersion.Version) {
+		return nil, nil, errors.New("metrics API is not available")
+	}
+	podMetrics, skipped, err := k.podsMetricsScan(ctx, namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	items, podsSkipped, err := k.resourcesScan(ctx, &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Pod"}, namespace, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	skipped = appendScanWarnings(skipped, podsSkipped...)
+	containers := make(map[string]v1.Container)
+	for _, item := range items {
+		pod := &v1.Pod{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, pod); err != nil {
+			return nil, nil, err
+		}
+		for _, container := range pod.Spec.Containers {
+			containers[pod.Namespace+"/"+pod.Name+"/"+container.Name] = container
+		}
+	}
+	type pressure struct {
+		percent int64
+		details map[string]any
+	}
+	var pressures []pressure
+	for _, podMetric := range podMetrics {
+		for _, containerMetric := range podMetric.Containers {
+			container, ok := containers[podMetric.Namespace+"/"+podMetric.Name+"/"+containerMetric.Name]
+			if !ok {
+				continue
+			}
+			for _, resourceName := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+				usage, ok := containerMetric.Usage[resourceName]
+				if !ok {
+					continue
+				}
+				details := map[string]any{
+					"Namespace": podMetric.Namespace,
+					"Pod":       podMetric.Name,
+					"Container": containerMetric.Name,
+					"Resource":  string(resourceName),
+					"Usage":     usage.String(),
+				}
+				var issues []string
+				var maxPercent int64
+				if request, ok := container.Resources.Requests[resourceName]; ok && !request.IsZero() {
+					percent := usage.MilliValue() * 100 / request.MilliValue()
+					details["Request"] = request.String()
+					details["RequestPercent"] = percent
+					if percent >= int64(thresholdPercent) {
+						issues = append(issues, fmt.Sprintf("usage at %d%% of request", percent))
+					}
+					maxPercent = max(maxPercent, percent)
+				}
+				if limit, ok := container.Resources.Limits[resourceName]; ok && !limit.IsZero() {
+					percent := usage.MilliValue() * 100 / limit.MilliValue()
+					details["Limit"] = limit.String()
+					details["LimitPercent"] = percent
+					if percent >= podsNearLimitPercent {
+						issues = append(issues, fmt.Sprintf("usage at %d%% of limit", percent))
+					}
+					maxPercent = max(maxPercent, percent)
+				}
+				if len(issues) == 0 {
+					continue
+				}
+				details["Issues"] = issues
+				pressures = append(pressures, pressure{percent: maxPercent, details: details})
+			}
+		}
+	}
+	sort.SliceStable(pressures, func(i, j int) bool {
+		if pressures[i].percent != pressures[j].percent {
+			return pressures[i].percent > pressures[j].percent
+		}
+		for _, key := range []string{"Namespace", "Pod", "Container", "Resource"} {
+			if a, b := pressures[i].details[key].(string), pressures[j].details[key].(string); a != b {
+				return a < b
+			}
+		}
+		return false
+	})
+	ret := make([]map[string]any, 0, len(pressures))
+	for _, p := range pressures {
+		ret = append(ret, p.details)
+	}
+	return ret, skipped, nil
+}
+
+// podsMetricsScan lists the pod metrics of the provided namespace or all namespaces.
+// If the metrics can't be listed across all namespaces at once (forbidden), each namespace is listed individually and
+// the namespaces that can't be listed are reported as warnings (see scanNamespaces).
+func (k *Kubernetes) podsMetricsScan(ctx context.Context, namespace string) ([]metrics.PodMetrics, []ScanWarning, error) {
+	podMetrics, err := k.manager.accessControlClientSet.PodsMetricses(ctx, namespace, "", metav1.ListOptions{})
+	if err == nil {
+		return podMetrics.Items, nil, nil
+	} else if namespace != "" || !apierrors.IsForbidden(err) {
+		return nil, nil, err
+	}
+	var items []metrics.PodMetrics
+	warnings, err := k.scanNamespaces(ctx, nil, func(namespace string) error {
+		podMetrics, err := k.manager.accessControlClientSet.PodsMetricses(ctx, namespace, "", metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		items = append(items, podMetrics.Items...)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return items, warnings, nil
+}
+
+// PodsRestarts summarizes the pods of the provided namespace (or all namespaces) whose containers restarted at least
+// minRestarts times, sorted by total restart count (descending).
+// For each restarted container, the reason and exit code of its last termination (e.g. OOMKilled, Error) are reported.
+// Namespaces that can't be scanned (Forbidden or transient errors) are skipped and reported as warnings.
+func (k *Kubernetes) PodsRestarts(ctx context.Context, namespace string, minRestarts int32) ([]map[string]any, []ScanWarning, error) {
+	items, skipped, err := k.resourcesScan(ctx, &schema.GroupVersionKind{
+		Group: "", Version: "v1", Kind: "Pod",
+	}, namespace, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	type podRestarts struct {
+		pod        *v1.Pod
+		restarts   int32
+		containers []map[string]any
+	}
+	var restarted []podRestarts
+	for _, item := range items {
+		pod := &v1.Pod{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, pod); err != nil {
+			return nil, nil, err
+		}
+		current := podRestarts{pod: pod}
+		for _, status := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+			if status.RestartCount == 0 {
+				continue
+			}
+			current.restarts += status.RestartCount
+			container := map[string]any{
+				"Name":     status.Name,
+				"Restarts": status.RestartCount,
+			}
+			if terminated := status.LastTerminationState.Terminated; terminated != nil {
+				container["LastTerminationReason"] = terminated.Reason
+				container["LastTerminationExitCode"] = terminated.ExitCode
+				if !terminated.FinishedAt.IsZero() {
+					container["LastTerminatedAt"] = terminated.FinishedAt.UTC().Format(time.RFC3339)
+				}
+			}
+			current.containers = append(current.containers, container)
+		}
+		if current.restarts > 0 && current.restarts >= minRestarts {
+			restarted = append(restarted, current)
+		}
+	}
+	sort.SliceStable(restarted, func(i, j int) bool {
+		if restarted[i].restarts != restarted[j].restarts {
+			return restarted[i].restarts > restarted[j].restarts
+		}
+		if restarted[i].pod.Namespace != restarted[j].pod.Namespace {
+			return restarted[i].pod.Namespace < restarted[j].pod.Namespace
+		}
+		return restarted[i].pod.Name < restarted[j].pod.Name
+	})
+	ret := make([]map[string]any, 0, len(restarted))
+	for _, r := range restarted {
+		ret = append(ret, map[string]any{
+			"Namespace":  r.pod.Namespace,
+			"Name":       r.pod.Name,
+			"Phase":      string(r.pod.Status.Phase),
+			"Restarts":   r.restarts,
+			"Containers": r.containers,
+		})
+	}
+	return ret, skipped, nil
+}
+
+// PodsNotReady reports the Running pods of the provided namespace (or all namespaces) whose Ready condition is False
+// (running but not serving traffic), along with how long they've been not ready, the containers that are not ready
+// with their readiness probes, and the readiness gates that are not satisfied.
+// Namespaces that can't be scanned (Forbidden or transient errors) are skipped and reported as warnings.
+func (k *Kubernetes) PodsNotReady(ctx context.Context, namespace string) ([]map[string]any, []ScanWarning, error) {
+	items, skipped, err := k.resourcesScan(ctx, &schema.GroupVersionKind{
+		Group: "", Version: "v1", Kind: "Pod",
+	}, namespace, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	ret := make([]map[string]any, 0)
+	for _, item := range items {
+		pod := &v1.Pod{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, pod); err != nil {
+			return nil, nil, err
+		}
+		if pod.Status.Phase != v1.PodRunning {
+			continue
+		}
+		conditions := make(map[v1.PodConditionType]v1.PodCondition)
+		for _, condition := range pod.Status.Conditions {
+			conditions[condition.Type] = condition
+		}
+		ready, ok := conditions[v1.PodReady]
+		if !ok || ready.Status != v1.ConditionFalse {
+			continue
+		}
+		current := map[string]any{
+			"Namespace": pod.Namespace,
+			"Name":      pod.Name,
+		}
+		if ready.Reason != "" {
+			current["Reason"] = ready.Reason
+		}
+		if ready.Message != "" {
+			current["Message"] = ready.Message
+		}
+		if !ready.LastTransitionTime.IsZero() {
+			current["NotReadySince"] = ready.LastTransitionTime.UTC().Format(time.RFC3339)
+			current["NotReadyFor"] = time.Since(ready.LastTransitionTime.Time).Round(time.Second).String()
+		}
+		var containers []map[string]any
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Ready {
+				continue
+			}
+			container := map[string]any{
+				"Name":     status.Name,
+				"Restarts": status.RestartCount,
+			}
+			switch {
+			case status.State.Waiting != nil:
+				container["State"] = "Waiting: " + status.State.Waiting.Reason
+			case status.State.Terminated != nil:
+				container["State"] = "Terminated: " + status.State.Terminated.Reason
+			case status.State.Running != nil:
+				container["State"] = "Running"
+			}
+			for _, c := range pod.Spec.Containers {
+				if c.Name == status.Name && c.ReadinessProbe != nil {
+					container["ReadinessProbe"] = probeDescription(c.ReadinessProbe)
+				}
+			}
+			containers = append(containers, container)
+		}
+		if len(containers) > 0 {
+			current["Containers"] = containers
+		}
+		var readinessGates []string
+		for _, gate := range pod.Spec.ReadinessGates {
+			if condition, ok := conditions[gate.ConditionType]; !ok || condition.Status != v1.ConditionTrue {
+				readinessGates = append(readinessGates, string(gate.ConditionType))
+			}
+		}
+		if len(readinessGates) > 0 {
+			current["FailingReadinessGates"] = readinessGates
+		}
+		ret = append(ret, current)
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		if ret[i]["Namespace"] != ret[j]["Namespace"] {
+			return ret[i]["Namespace"].(string) < ret[j]["Namespace"].(string)
+		}
+		return ret[i]["Name"].(string) < ret[j]["Name"].(string)
+	})
+	return ret, skipped, nil
+}
+
+// probeDescription returns a human-readable description of the provided probe (same format as `kubectl describe`)
+func probeDescription(probe *v1.Probe) string {
+	var action string
+	switch {
+	case probe.HTTPGet != nil:
+		scheme := strings.ToLower(string(probe.HTTPGet.Scheme))
+		if scheme == "" {
+			scheme = "http"
+		}
+		action = fmt.Sprintf("http-get %s://%s:%s%s", scheme, probe.HTTPGet.Host, probe.HTTPGet.Port.String(), probe.HTTPGet.Path)
+	case probe.TCPSocket != nil:
+		action = fmt.Sprintf("tcp-socket %s:%s", probe.TCPSocket.Host, probe.TCPSocket.Port.String())
+	case probe.GRPC != nil:
+		action = fmt.Sprintf("grpc <pod>:%d %s", probe.GRPC.Port, ptr.Deref(probe.GRPC.Service, ""))
+	case probe.Exec != nil:
+		action = "exec " + strings.Join(probe.Exec.Command, " ")
+	default:
+		action = "unknown"
+	}
+	return fmt.Sprintf("%s delay=%ds timeout=%ds period=%ds #success=%d #failure=%d", strings.TrimSpace(action),
+		probe.InitialDelaySeconds, probe.TimeoutSeconds, probe.PeriodSeconds, probe.SuccessThreshold, probe.FailureThreshold)
+}
+
+// PodsImagePullErrors reports the images that fail to be pulled by the containers of the pods in the provided namespace
+// (or all namespaces), i.e. containers waiting with an ImagePullBackOff or ErrImagePull reason (and similar).
+// Results are grouped by image so that a broken image used by many pods is reported once, sorted by number of
+// affected containers (descending).
+// Namespaces that can't be scanned (Forbidden or transient errors) are skipped and reported as warnings.
+func (k *Kubernetes) PodsImagePullErrors(ctx context.Context, namespace string) ([]map[string]any, []ScanWarning, error) {
+	items, skipped, err := k.resourcesScan(ctx, &schema.GroupVersionKind{
+		Group: "", Version: "v1", Kind: "Pod",
+	}, namespace, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	type imagePullError struct {
+		image        string
+		reasons      map[string]bool
+		message      string
+		requiresAuth bool
+		pullSecrets  map[string]bool
+		containers   []string
+	}
+	errorsByImage := make(map[string]*imagePullError)
+	for _, item := range items {
+		pod := &v1.Pod{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, pod); err != nil {
+			return nil, nil, err
+		}
+		for _, status := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+			waiting := status.State.Waiting
+			if waiting == nil || !slices.Contains(imagePullErrorReasons, waiting.Reason) {
+				continue
+			}
+			image := podContainerImage(pod, status.Name, status.Image)
+			current, ok := errorsByImage[image]
+			if !ok {
+				current = &imagePullError{image: image, reasons: map[string]bool{}, pullSecrets: map[string]bool{}}
+				errorsByImage[image] = current
+			}
+			current.reasons[waiting.Reason] = true
+			// ImagePullBackOff messages are generic, prefer the message of the actual pull error
+			if current.message == "" || (waiting.Reason != "ImagePullBackOff" && waiting.Message != "") {
+				current.message = waiting.Message
+			}
+			current.requiresAuth = current.requiresAuth || isImagePullAuthError(waiting.Message)
+			for _, secret := range pod.Spec.ImagePullSecrets {
+				current.pullSecrets[secret.Name] = true
+			}
+			current.containers = append(current.containers, pod.Namespace+"/"+pod.Name+" ("+status.Name+")")
+		}
+	}
+	pullErrors := make([]*imagePullError, 0, len(errorsByImage))
+	for _, pullError := range errorsByImage {
+		pullErrors = append(pullErrors, pullError)
+	}
+	sort.Slice(pullErrors, func(i, j int) bool {
+		if len(pullErrors[i].containers) != len(pullErrors[j].containers) {
+			return len(pullErrors[i].containers) > len(pullErrors[j].containers)
+		}
+		return pullErrors[i].image < pullErrors[j].image
+	})
+	ret := make([]map[string]any, 0, len(pullErrors))
+	for _, pullError := range pullErrors {
+		reasons := slices.Sorted(maps.Keys(pullError.reasons))
+		imageError := map[string]any{
+			"Image":        pullError.image,
+			"Registry":     imageRegistry(pullError.image),
+			"Reasons":      reasons,
+			"Message":      pullError.message,
+			"RequiresAuth": pullError.requiresAuth,
+			"Containers":   pullError.containers,
+		}
+		if len(pullError.pullSecrets) > 0 {
+			imageError["PullSecrets"] = slices.Sorted(maps.Keys(pullError.pullSecrets))
+		}
+		ret = append(ret, imageError)
+	}
+	return ret, skipped, nil
+}
+
+// Waiting reasons of the containers whose image can't be pulled
+var imagePullErrorReasons = []string{"ImagePullBackOff", "ErrImagePull", "ErrImageNeverPull", "InvalidImageName", "RegistryUnavailable"}
+
+// Fragments of the image pull error messages returned when the registry requires (valid) credentials
+var imagePullAuthErrors = []string{"unauthorized", "authentication required", "no basic auth credentials", "access denied",
+	"pull access denied", "insufficient_scope", "401", "403 forbidden"}
+
+// isImagePullAuthError returns true if the provided image pull error message reports missing or invalid credentials
+func isImagePullAuthError(message string) bool {
+	message = strings.ToLower(message)
+	for _, fragment := range imagePullAuthErrors {
+		if strings.Contains(message, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// podContainerImage returns the image of the provided container as defined in the Pod spec (the status image otherwise)
+func podContainerImage(pod *v1.Pod, container, statusImage string) string {
+	for _, c := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+		if c.Name == container && c.Image != "" {
+			return c.Image
+		}
+	}
+	return statusImage
+}
+
+// imageRegistry returns the registry host of the provided image reference (docker.io if not specified)
+func imageRegistry(image string) string {
+	host, _, found := strings.Cut(image, "/")
+	if found && (strings.ContainsAny(host, ".:") || host == "localhost") {
+		return host
+	}
+	return "docker.io"
+}
+
+// Maximum number of Warning events reported by PodsDiagnose
+const podsDiagnoseMaxEvents = 10
+
+// PodsDiagnose returns a consolidated view of the provided Pod to troubleshoot failures: phase, state and last state of
+// each container (including exit codes and reasons), configured probes, and the most recent Warning events of the Pod.
+func (k *Kubernetes) PodsDiagnose(ctx context.Context, namespace, name string) (map[string]any, error) {
+	namespace = k.NamespaceOrDefault(namespace)
+	pods, err := k.manager.accessControlClientSet.Pods(namespace)
+	if err != nil {
+		return nil, err
+	}
+	pod, err := pods.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	ret := map[string]any{
+		"Namespace": pod.Namespace,
+		"Name":      pod.Name,
+		"Phase":     string(pod.Status.Phase),
+	}
+	if pod.Status.Reason != "" {
+		ret["Reason"] = pod.Status.Reason
+	}
+	if pod.Status.Message != "" {
+		ret["Message"] = pod.Status.Message
+	}
+	if pod.Spec.NodeName != "" {
+		ret["Node"] = pod.Spec.NodeName
+	}
+	if initContainers := podsDiagnoseContainers(pod.Spec.InitContainers, pod.Status.InitContainerStatuses); len(initContainers) > 0 {
+		ret["InitContainers"] = initContainers
+	}
+	ret["Containers"] = podsDiagnoseContainers(pod.Spec.Containers, pod.Status.ContainerStatuses)
+	events, err := k.manager.accessControlClientSet.Events(namespace)
+	if err != nil {
+		return nil, err
+	}
+	eventList, err := events.List(ctx, metav1.ListOptions{FieldSelector: fields.SelectorFromSet(fields.Set{
+		"involvedObject.kind": "Pod",
+		"involvedObject.name": pod.Name,
+		"type":                v1.EventTypeWarning,
+	}).String()})
+	if err != nil {
+		return nil, err
+	}
+	var warnings []v1.Event
+	for _, event := range eventList.Items {
+		// Events of a previous Pod with the same name are not relevant
+		if event.Type == v1.EventTypeWarning && event.InvolvedObject.Name == pod.Name &&
+			(event.InvolvedObject.UID == "" || event.InvolvedObject.UID == pod.UID) {
+			warnings = append(warnings, event)
+		}
+	}
+	sort.SliceStable(warnings, func(i, j int) bool {
+		return eventTimestamp(&warnings[i]).After(eventTimestamp(&warnings[j]))
+	})
+	if len(warnings) > podsDiagnoseMaxEvents {
+		warnings = warnings[:podsDiagnoseMaxEvents]
+	}
+	warningEvents := make([]map[string]any, 0, len(warnings))
+	for _, event := range warnings {
+		warningEvents = append(warningEvents, map[string]any{
+			"Timestamp": eventTimestamp(&event).UTC().Format(time.RFC3339),
+			"Count":     eventCount(&event),
+			"Reason":    event.Reason,
+			"Message":   strings.TrimSpace(event.Message),
+		})
+	}
+	ret["WarningEvents"] = warningEvents
+	return ret, nil
+}
+
+// podsDiagnoseContainers returns the state, last state and probe configuration of the provided containers
+func podsDiagnoseContainers(containers []v1.Container, statuses []v1.ContainerStatus) []map[string]any {
+	ret := make([]map[string]any, 0, len(containers))
+	for _, container := range containers {
+		diagnosis := map[string]any{"Name": container.Name}
+		for _, status := range statuses {
+			if status.Name != container.Name {
+				continue
+			}
+			diagnosis["Ready"] = status.Ready
+			diagnosis["Restarts"] = status.RestartCount
+			if state := containerStateMap(status.State); state != nil {
+				diagnosis["State"] = state
+			}
+			if lastState := containerStateMap(status.LastTerminationState); lastState != nil {
+				diagnosis["LastState"] = lastState
+			}
+		}
+		if container.StartupProbe != nil {
+			diagnosis["StartupProbe"] = container.StartupProbe
+		}
+		if container.ReadinessProbe != nil {
+			diagnosis["ReadinessProbe"] = container.ReadinessProbe
+		}
+		if container.LivenessProbe != nil {
+			diagnosis["LivenessProbe"] = container.LivenessProbe
+		}
+		ret = append(ret, diagnosis)
+	}
+	return ret
+}
+
+// containerStateMap returns a summary of the provided container state (nil if the state is unknown)
+func containerStateMap(state v1.ContainerState) map[string]any {
+	switch {
+	case state.Waiting != nil:
+		ret := map[string]any{"Waiting": true, "Reason": state.Waiting.Reason}
+		if state.Waiting.Message != "" {
+			ret["Message"] = strings.TrimSpace(state.Waiting.Message)
+		}
+		return ret
+	case state.Running != nil:
+		return map[string]any{"Running": true, "StartedAt": state.Running.StartedAt.UTC().Format(time.RFC3339)}
+	case state.Terminated != nil:
+		ret := map[string]any{"Terminated": true, "Reason": state.Terminated.Reason, "ExitCode": state.Terminated.ExitCode}
+		if state.Terminated.Message != "" {
+			ret["Message"] = strings.TrimSpace(state.Terminated.Message)
+		}
+		if !state.Terminated.FinishedAt.IsZero() {
+			ret["FinishedAt"] = state.Terminated.FinishedAt.UTC().Format(time.RFC3339)
+		}
+		return ret
+	}
+	return nil
+}
+
 func (k *Kubernetes) PodsExec(ctx context.Context, namespace, name, container string, command []string) (string, error) {
 	namespace = k.NamespaceOrDefault(namespace)
 	pods, err := k.manager.accessControlClientSet.Pods(namespace)
@@ -263,3 +879,121 @@ func (k *Kubernetes) PodsExec(ctx context.Context, namespace, name, container st
 	}
 	return "", nil
 }
+
+// Default timeout of the connectivity checks performed by PodsProbe
+const DefaultProbeTimeout = 5 * time.Second
+
+type PodsProbeOptions struct {
+	// Container to run the check from (Optional, first container if not provided)
+	Container string
+	// Protocol of the check, either tcp (connection only) or http (GET request)
+	Protocol string
+	Host     string
+	Port     int
+	// Path of the http request (Optional, / if not provided)
+	Path    string
+	Timeout time.Duration
+}
+
+// podsProbeScript performs the connectivity check with the tools available in the container and always exits
+// successfully, printing the exit code of the check along with the start and end timestamps (nanoseconds).
+// The target is provided as positional arguments ($1 protocol, $2 host, $3 port, $4 timeout seconds, $5 URL) so that
+// it's never interpreted by the shell.
+const podsProbeScript = `probe() {
+  case "$1" in
+  tcp)
+    if command -v nc >/dev/null 2>&1; then nc -z -w "$4" "$2" "$3"
+    elif command -v bash >/dev/null 2>&1; then timeout "$4" bash -c '</dev/tcp/$0/$1' "$2" "$3"
+    else return 127; fi ;;
+  http)
+    if command -v curl >/dev/null 2>&1; then curl -s -o /dev/null --max-time "$4" "$5"
+    elif command -v wget >/dev/null 2>&1; then wget -q -O /dev/null -T "$4" "$5"; rc=$?; [ $rc -eq 8 ] && rc=0; return $rc
+    else return 127; fi ;;
+  esac
+}
+start=$(date +%s%N 2>/dev/null)
+probe "$@" >/dev/null 2>&1
+rc=$?
+end=$(date +%s%N 2>/dev/null)
+echo "rc=$rc start=$start end=$end"`
+
+var podsProbeResult = regexp.MustCompile(`rc=(\d+) start=(\S*) end=(\S*)`)
+
+// podsProbeCommand returns the command executed in the Pod container to check the connectivity to the provided target.
+func podsProbeCommand(options PodsProbeOptions) ([]string, error) {
+	// The host is passed as an argument of nc, curl, or wget: must be an IP address or a DNS-1123 host name (never an option)
+	if strings.HasPrefix(options.Host, "-") || (net.ParseIP(options.Host) == nil &&
+		len(validation.IsDNS1123Subdomain(strings.ToLower(strings.TrimSuffix(options.Host, ".")))) > 0) {
+		return nil, fmt.Errorf("invalid host %q, must be an IP address or a DNS host name", options.Host)
+	}
+	if options.Port < 1 || options.Port > 65535 {
+		return nil, fmt.Errorf("invalid port %d, must be between 1 and 65535", options.Port)
+	}
+	timeout := options.Timeout
+	if timeout <= 0 {
+		timeout = DefaultProbeTimeout
+	}
+	url := ""
+	switch options.Protocol {
+	case "", "tcp":
+		options.Protocol = "tcp"
+	case "http":
+		path := options.Path
+		if path == "" {
+			path = "/"
+		}
+		if !strings.HasPrefix(path, "/") {
+			return nil, fmt.Errorf("invalid path %q, must start with /", path)
+		}
+		url = "http://" + net.JoinHostPort(options.Host, strconv.Itoa(options.Port)) + path
+	default:
+		return nil, fmt.Errorf("invalid protocol %q, must be one of tcp, http", options.Protocol)
+	}
+	return []string{"sh", "-c", podsProbeScript, "probe", options.Protocol, options.Host, strconv.Itoa(options.Port),
+		strconv.Itoa(int(timeout.Seconds())), url}, nil
+}
+
+// PodsProbe checks the connectivity from the provided Pod to the provided target host and port using the Pod network.
+// The check is bounded by the provided timeout (DefaultProbeTimeout if not provided).
+// Returns whether the target is reachable and the latency of the check when the container allows to measure it.
+func (k *Kubernetes) PodsProbe(ctx context.Context, namespace, name string, options PodsProbeOptions) (map[string]any, error) {
+	command, err := podsProbeCommand(options)
+	if err != nil {
+		return nil, err
+	}
+	if options.Timeout <= 0 {
+		options.Timeout = DefaultProbeTimeout
+	}
+	// Leave some room for the exec session on top of the in-container check timeout
+	execCtx, cancel := context.WithTimeout(ctx, options.Timeout+10*time.Second)
+	defer cancel()
+	out, err := k.PodsExec(execCtx, namespace, name, options.Container, command)
+	if err != nil {
+		return nil, err
+	}
+	match := podsProbeResult.FindStringSubmatch(out)
+	if match == nil {
+		return nil, fmt.Errorf("unexpected probe output: %s", strings.TrimSpace(out))
+	}
+	ret := map[string]any{
+		"Pod":       k.NamespaceOrDefault(namespace) + "/" + name,
+		"Target":    net.JoinHostPort(command[5], command[6]),
+		"Protocol":  command[4],
+		"Reachable": match[1] == "0",
+	}
+	start, startErr := strconv.ParseInt(match[2], 10, 64)
+	end, endErr := strconv.ParseInt(match[3], 10, 64)
+	if startErr == nil && endErr == nil && end >= start {
+		ret["LatencyMs"] = float64(end-start) / float64(time.Millisecond)
+	}
+	switch match[1] {
+	case "0":
+	case "127":
+		ret["Reason"] = "no probing tool available in the container (tcp requires nc or bash, http requires curl or wget)"
+	case "124", "28":
+		ret["Reason"] = fmt.Sprintf("timed out after %s", options.Timeout)
+	default:
+		ret["Reason"] = fmt.Sprintf("connection failed (exit code %s)", match[1])
+	}
+	return ret, nil
+}
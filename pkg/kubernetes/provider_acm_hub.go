@@ -272,6 +272,10 @@ func (p *acmHubClusterProvider) IsOpenShift(ctx context.Context) bool {
 	return p.hubManager.IsOpenShift(ctx)
 }
 
+func (p *acmHubClusterProvider) OpenShiftMinorVersion(ctx context.Context) (int, bool) {
+	return p.hubManager.OpenShiftMinorVersion(ctx)
+}
+
 func (p *acmHubClusterProvider) VerifyToken(ctx context.Context, target, token, audience string) (*authenticationv1api.UserInfo, []string, error) {
 	manager, err := p.managerForCluster(target)
 	if err != nil {
@@ -85,6 +85,10 @@ func (p *kubeConfigClusterProvider) IsOpenShift(ctx context.Context) bool {
 	return p.managers[p.defaultContext].IsOpenShift(ctx)
 }
 
+func (p *kubeConfigClusterProvider) OpenShiftMinorVersion(ctx context.Context) (int, bool) {
+	return p.managers[p.defaultContext].OpenShiftMinorVersion(ctx)
+}
+
 func (p *kubeConfigClusterProvider) VerifyToken(ctx context.Context, context, token, audience string) (*authenticationv1api.UserInfo, []string, error) {
 	m, err := p.managerForContext(context)
 	if err != nil {
@@ -58,6 +58,10 @@ func (p *singleClusterProvider) IsOpenShift(ctx context.Context) bool {
 	return p.manager.IsOpenShift(ctx)
 }
 
+func (p *singleClusterProvider) OpenShiftMinorVersion(ctx context.Context) (int, bool) {
+	return p.manager.OpenShiftMinorVersion(ctx)
+}
+
 func (p *singleClusterProvider) VerifyToken(ctx context.Context, target, token, audience string) (*authenticationv1api.UserInfo, []string, error) {
 	if target != "" {
 		return nil, nil, fmt.Errorf("unable to get manager for other context/cluster with %s strategy", p.strategy)
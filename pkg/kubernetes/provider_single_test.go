@@ -56,6 +56,53 @@ func (s *ProviderSingleTestSuite) TestWithOpenShiftCluster() {
 	})
 }
 
+func (s *ProviderSingleTestSuite) TestOpenShiftMinorVersion() {
+	s.Run("returns false for non-OpenShift cluster", func() {
+		_, ok := s.provider.OpenShiftMinorVersion(s.T().Context())
+		s.False(ok, "Expected OpenShiftMinorVersion to not be detected")
+	})
+}
+
+func (s *ProviderSingleTestSuite) TestOpenShiftMinorVersionFromClusterVersion() {
+	clusterVersionRequests := 0
+	s.mockServer.Handle(&test.InOpenShiftHandler{})
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.EscapedPath() == "/apis/config.openshift.io/v1/clusterversions/version" {
+			clusterVersionRequests++
+			_, _ = w.Write([]byte(`{"apiVersion": "config.openshift.io/v1", "kind": "ClusterVersion",
+				"metadata": {"name": "version"}, "status": {"desired": {"version": "4.15.3"}}}`))
+		}
+	}))
+	s.Run("returns minor version of ClusterVersion", func() {
+		minor, ok := s.provider.OpenShiftMinorVersion(s.T().Context())
+		s.True(ok, "Expected OpenShiftMinorVersion to be detected")
+		s.Equal(15, minor)
+	})
+	s.Run("caches detected minor version", func() {
+		minor, _ := s.provider.OpenShiftMinorVersion(s.T().Context())
+		s.Equal(15, minor)
+		s.Equal(1, clusterVersionRequests)
+	})
+}
+
+func (s *ProviderSingleTestSuite) TestOpenShiftMinorVersionFromKubernetesVersion() {
+	s.mockServer.Handle(&test.InOpenShiftHandler{})
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.EscapedPath() {
+		case "/apis/config.openshift.io/v1/clusterversions/version":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Status", "status": "Failure", "reason": "Forbidden", "code": 403}`))
+		case "/version":
+			_, _ = w.Write([]byte(`{"major": "1", "minor": "29", "gitVersion": "v1.29.7+4510e9c"}`))
+		}
+	}))
+	s.Run("infers minor version from Kubernetes version when ClusterVersion is forbidden", func() {
+		minor, ok := s.provider.OpenShiftMinorVersion(s.T().Context())
+		s.True(ok, "Expected OpenShiftMinorVersion to be detected")
+		s.Equal(16, minor)
+	})
+}
+
 func (s *ProviderSingleTestSuite) TestVerifyToken() {
 	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		if req.URL.EscapedPath() == "/apis/authentication.k8s.io/v1/tokenreviews" {
@@ -0,0 +1,97 @@
+package kubernetes
+
+import (
+	"context"
+
+	authv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// CanIOptions identifies the action to check with CanI and, optionally, the subject to check it for
+type CanIOptions struct {
+	Verb        string
+	Namespace   string
+	Name        string
+	Subresource string
+	// User to check the action for, the current identity is checked if neither User nor Groups are provided
+	User   string
+	Groups []string
+}
+
+// CanI checks whether the action described by the provided options on the resource of the provided kind is allowed.
+// The action is checked for the current identity with a SelfSubjectAccessReview, or for the provided subject with a
+// SubjectAccessReview (which requires permission to create SubjectAccessReviews).
+// An empty namespace checks the action in all namespaces (or for cluster-scoped resources).
+func (k *Kubernetes) CanI(ctx context.Context, gvk *schema.GroupVersionKind, options CanIOptions) (map[string]any, error) {
+	gvr, err := k.resourceFor(gvk)
+	if err != nil {
+		return nil, err
+	}
+	resourceAttributes := &authv1.ResourceAttributes{
+		Namespace:   options.Namespace,
+		Verb:        options.Verb,
+		Group:       gvr.Group,
+		Version:     gvr.Version,
+		Resource:    gvr.Resource,
+		Subresource: options.Subresource,
+		Name:        options.Name,
+	}
+	var status authv1.SubjectAccessReviewStatus
+	if options.User == "" && len(options.Groups) == 0 {
+		accessReviews, err := k.manager.accessControlClientSet.SelfSubjectAccessReviews()
+		if err != nil {
+			return nil, err
+		}
+		response, err := accessReviews.Create(ctx, &authv1.SelfSubjectAccessReview{
+			Spec: authv1.SelfSubjectAccessReviewSpec{ResourceAttributes: resourceAttributes},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return nil, err
+		}
+		status = response.Status
+	} else {
+		accessReviews, err := k.manager.accessControlClientSet.SubjectAccessReviews()
+		if err != nil {
+			return nil, err
+		}
+		response, err := accessReviews.Create(ctx, &authv1.SubjectAccessReview{
+			Spec: authv1.SubjectAccessReviewSpec{ResourceAttributes: resourceAttributes, User: options.User, Groups: options.Groups},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return nil, err
+		}
+		status = response.Status
+	}
+	resource := gvr.GroupResource().String()
+	if options.Subresource != "" {
+		resource += "/" + options.Subresource
+	}
+	ret := map[string]any{
+		"Allowed":  status.Allowed,
+		"Verb":     options.Verb,
+		"Resource": resource,
+	}
+	if options.Namespace != "" {
+		ret["Namespace"] = options.Namespace
+	}
+	if options.Name != "" {
+		ret["Name"] = options.Name
+	}
+	if options.User != "" {
+		ret["User"] = options.User
+	}
+	if len(options.Groups) > 0 {
+		ret["Groups"] = options.Groups
+	}
+	if status.Denied {
+		ret["Denied"] = true
+	}
+	if status.Reason != "" {
+		ret["Reason"] = status.Reason
+	}
+	if status.EvaluationError != "" {
+		ret["EvaluationError"] = status.EvaluationError
+	}
+	return ret, nil
+}
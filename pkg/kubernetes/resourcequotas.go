@@ -0,0 +1,62 @@
+package kubernetes
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Percentage of a hard limit from which a quota resource is flagged as near its limit
+const ResourceQuotaNearLimitPercent = 90
+
+// ResourceQuotasList summarizes the ResourceQuotas of the provided namespace (or the configured namespace).
+// For each quota, the used and hard values of every resource are reported along with the percentage consumed.
+// Resources at or over ResourceQuotaNearLimitPercent are flagged as NearLimit.
+func (k *Kubernetes) ResourceQuotasList(ctx context.Context, namespace string) ([]map[string]any, error) {
+	namespace = k.NamespaceOrDefault(namespace)
+	resourceQuotas, err := k.manager.accessControlClientSet.ResourceQuotas(namespace)
+	if err != nil {
+		return nil, err
+	}
+	quotaList, err := resourceQuotas.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]map[string]any, 0, len(quotaList.Items))
+	for _, quota := range quotaList.Items {
+		resourceNames := make([]string, 0, len(quota.Status.Hard))
+		for name := range quota.Status.Hard {
+			resourceNames = append(resourceNames, string(name))
+		}
+		sort.Strings(resourceNames)
+		resources := make([]map[string]any, 0, len(resourceNames))
+		for _, name := range resourceNames {
+			hard := quota.Status.Hard[v1.ResourceName(name)]
+			used := quota.Status.Used[v1.ResourceName(name)]
+			// A zero hard limit doesn't allow any usage, the quota is already exhausted
+			percent := 100.0
+			if !hard.IsZero() {
+				percent = math.Round(float64(used.MilliValue())/float64(hard.MilliValue())*1000) / 10
+			}
+			resource := map[string]any{
+				"Resource":    name,
+				"Used":        used.String(),
+				"Hard":        hard.String(),
+				"PercentUsed": percent,
+			}
+			if percent >= ResourceQuotaNearLimitPercent {
+				resource["NearLimit"] = true
+			}
+			resources = append(resources, resource)
+		}
+		ret = append(ret, map[string]any{
+			"Namespace": quota.Namespace,
+			"Name":      quota.Name,
+			"Resources": resources,
+		})
+	}
+	return ret, nil
+}
@@ -2,18 +2,26 @@ package kubernetes
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"k8s.io/apimachinery/pkg/runtime"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/containers/kubernetes-mcp-server/pkg/version"
+	"github.com/pmezard/go-difflib/difflib"
 	authv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/yaml"
+	sigsyaml "sigs.k8s.io/yaml"
 )
 
 const (
@@ -39,10 +47,16 @@ func (k *Kubernetes) ResourcesList(ctx context.Context, gvk *schema.GroupVersion
 	if isNamespaced && !k.canIUse(ctx, gvr, namespace, "list") && namespace == "" {
 		namespace = k.manager.configuredNamespace()
 	}
-	if options.AsTable {
-		return k.resourcesListAsTable(ctx, gvk, gvr, namespace, options)
-	}
-	return k.manager.dynamicClient.Resource(*gvr).Namespace(namespace).List(ctx, options.ListOptions)
+	var ret runtime.Unstructured
+	err = withRetry(ctx, func() error {
+		if options.AsTable {
+			ret, err = k.resourcesListAsTable(ctx, gvk, gvr, namespace, options)
+		} else {
+			ret, err = k.manager.dynamicClient.Resource(*gvr).Namespace(namespace).List(ctx, options.ListOptions)
+		}
+		return err
+	})
+	return ret, err
 }
 
 func (k *Kubernetes) ResourcesGet(ctx context.Context, gvk *schema.GroupVersionKind, namespace, name string) (*unstructured.Unstructured, error) {
@@ -55,10 +69,106 @@ func (k *Kubernetes) ResourcesGet(ctx context.Context, gvk *schema.GroupVersionK
 	if namespaced, nsErr := k.isNamespaced(gvk); nsErr == nil && namespaced {
 		namespace = k.NamespaceOrDefault(namespace)
 	}
-	return k.manager.dynamicClient.Resource(*gvr).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	var ret *unstructured.Unstructured
+	err = withRetry(ctx, func() error {
+		ret, err = k.manager.dynamicClient.Resource(*gvr).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+		return err
+	})
+	return ret, err
+}
+
+// ResourcesExport retrieves the provided resource and strips the server-managed fields (status, uid, resourceVersion,
+// managedFields, etc.) and the fields defaulted or allocated by the cluster (e.g. Pod service account token volumes,
+// Service cluster IPs) so that the returned manifest can be re-applied in a different namespace or cluster.
+func (k *Kubernetes) ResourcesExport(ctx context.Context, gvk *schema.GroupVersionKind, namespace, name string) (*unstructured.Unstructured, error) {
+	obj, err := k.ResourcesGet(ctx, gvk, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	exportObject(obj)
+	return obj, nil
 }
 
-func (k *Kubernetes) ResourcesCreateOrUpdate(ctx context.Context, resource string) ([]*unstructured.Unstructured, error) {
+// exportObject strips the server-managed and cluster-defaulted fields of the provided object (see ResourcesExport)
+func exportObject(obj *unstructured.Unstructured) {
+	unstructured.RemoveNestedField(obj.Object, "status")
+	for _, field := range []string{"managedFields", "uid", "resourceVersion", "creationTimestamp", "generation",
+		"selfLink", "ownerReferences", "deletionTimestamp", "deletionGracePeriodSeconds"} {
+		unstructured.RemoveNestedField(obj.Object, "metadata", field)
+	}
+	annotations := obj.GetAnnotations()
+	delete(annotations, "kubectl.kubernetes.io/last-applied-configuration")
+	if len(annotations) == 0 {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "annotations")
+	} else {
+		obj.SetAnnotations(annotations)
+	}
+	switch obj.GroupVersionKind().GroupKind() {
+	case schema.GroupKind{Kind: "Pod"}:
+		if spec, ok := obj.Object["spec"].(map[string]interface{}); ok {
+			exportPodSpec(spec)
+		}
+	case schema.GroupKind{Kind: "Service"}:
+		// Headless Services must keep their clusterIP: None
+		if clusterIP, _, _ := unstructured.NestedString(obj.Object, "spec", "clusterIP"); clusterIP != "None" {
+			unstructured.RemoveNestedField(obj.Object, "spec", "clusterIP")
+			unstructured.RemoveNestedField(obj.Object, "spec", "clusterIPs")
+		}
+	}
+}
+
+// exportPodSpec strips the fields of the provided Pod spec that are set by the cluster: the assigned node, the
+// deprecated serviceAccount alias, and the injected service account token volumes and their mounts.
+func exportPodSpec(spec map[string]interface{}) {
+	delete(spec, "nodeName")
+	delete(spec, "serviceAccount")
+	volumes, _ := spec["volumes"].([]interface{})
+	injected := map[string]bool{}
+	var kept []interface{}
+	for _, v := range volumes {
+		volume, _ := v.(map[string]interface{})
+		if name, _ := volume["name"].(string); strings.HasPrefix(name, "kube-api-access-") && volume["projected"] != nil {
+			injected[name] = true
+			continue
+		}
+		kept = append(kept, v)
+	}
+	if len(injected) == 0 {
+		return
+	}
+	if len(kept) == 0 {
+		delete(spec, "volumes")
+	} else {
+		spec["volumes"] = kept
+	}
+	for _, containersField := range []string{"initContainers", "containers", "ephemeralContainers"} {
+		containers, _ := spec[containersField].([]interface{})
+		for _, c := range containers {
+			container, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			mounts, _ := container["volumeMounts"].([]interface{})
+			var keptMounts []interface{}
+			for _, m := range mounts {
+				mount, _ := m.(map[string]interface{})
+				if name, _ := mount["name"].(string); !injected[name] {
+					keptMounts = append(keptMounts, m)
+				}
+			}
+			if len(keptMounts) == 0 {
+				delete(container, "volumeMounts")
+			} else {
+				container["volumeMounts"] = keptMounts
+			}
+		}
+	}
+}
+
+// ResourcesCreateOrUpdate applies (server-side) the provided YAML or JSON resources.
+// If a resourceVersion is provided, the (single) resource is only updated if its live resourceVersion matches
+// (optimistic concurrency), a Conflict error is returned otherwise.
+func (k *Kubernetes) ResourcesCreateOrUpdate(ctx context.Context, resource, resourceVersion string) ([]*unstructured.Unstructured, error) {
 	separator := regexp.MustCompile(`\r?\n---\r?\n`)
 	resources := separator.Split(resource, -1)
 	var parsedResources []*unstructured.Unstructured
@@ -69,7 +179,14 @@ func (k *Kubernetes) ResourcesCreateOrUpdate(ctx context.Context, resource strin
 		}
 		parsedResources = append(parsedResources, &obj)
 	}
-	return k.resourcesCreateOrUpdate(ctx, parsedResources)
+	if resourceVersion != "" {
+		if len(parsedResources) != 1 {
+			return nil, errors.New("resource version can only be provided for a single resource")
+		}
+		parsedResources[0].SetResourceVersion(resourceVersion)
+	}
+	ret, err := k.resourcesCreateOrUpdate(ctx, parsedResources)
+	return ret, resourceVersionConflict(err, resourceVersion)
 }
 
 func (k *Kubernetes) ResourcesDelete(ctx context.Context, gvk *schema.GroupVersionKind, namespace, name string) error {
@@ -85,6 +202,184 @@ func (k *Kubernetes) ResourcesDelete(ctx context.Context, gvk *schema.GroupVersi
 	return k.manager.dynamicClient.Resource(*gvr).Namespace(namespace).Delete(ctx, name, metav1.DeleteOptions{})
 }
 
+// ResourcesDiff computes a unified diff between the provided resources (YAML or JSON, multiple documents supported)
+// and their live counterparts in the cluster.
+// The projected objects are computed with a server-side dry-run apply, so defaulting, admission, and field ownership
+// are taken into account (like `kubectl diff --server-side`).
+func (k *Kubernetes) ResourcesDiff(ctx context.Context, resource string) (string, error) {
+	separator := regexp.MustCompile(`\r?\n---\r?\n`)
+	var diffs []string
+	for _, r := range separator.Split(resource, -1) {
+		var obj unstructured.Unstructured
+		if err := yaml.NewYAMLToJSONDecoder(strings.NewReader(r)).Decode(&obj); err != nil {
+			return "", err
+		}
+		diff, err := k.resourceDiff(ctx, &obj)
+		if err != nil {
+			return "", err
+		}
+		diffs = append(diffs, diff)
+	}
+	return strings.Join(diffs, "\n"), nil
+}
+
+func (k *Kubernetes) resourceDiff(ctx context.Context, obj *unstructured.Unstructured) (string, error) {
+	gvk := obj.GroupVersionKind()
+	gvr, err := k.resourceFor(&gvk)
+	if err != nil {
+		return "", err
+	}
+	namespace := obj.GetNamespace()
+	// If it's a namespaced resource and namespace wasn't provided, try to use the default configured one
+	if namespaced, nsErr := k.isNamespaced(&gvk); nsErr == nil && namespaced {
+		namespace = k.NamespaceOrDefault(namespace)
+	}
+	name := gvk.Kind + " " + obj.GetName()
+	if namespace != "" {
+		name = gvk.Kind + " " + namespace + "/" + obj.GetName()
+	}
+	client := k.manager.dynamicClient.Resource(*gvr).Namespace(namespace)
+	live, err := client.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return fmt.Sprintf("# %s: would create\n", name), nil
+	} else if err != nil {
+		return "", err
+	}
+	projected, err := client.Apply(ctx, obj.GetName(), obj, metav1.ApplyOptions{
+		FieldManager: version.BinaryName,
+		DryRun:       []string{metav1.DryRunAll},
+	})
+	if err != nil {
+		return "", err
+	}
+	liveYaml, err := diffableYaml(live)
+	if err != nil {
+		return "", err
+	}
+	projectedYaml, err := diffableYaml(projected)
+	if err != nil {
+		return "", err
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(liveYaml),
+		B:        difflib.SplitLines(projectedYaml),
+		FromFile: name + " (live)",
+		ToFile:   name + " (projected)",
+		Context:  3,
+	})
+	if err != nil {
+		return "", err
+	}
+	if diff == "" {
+		return fmt.Sprintf("# %s: no differences\n", name), nil
+	}
+	return diff, nil
+}
+
+// diffableYaml marshals the provided object to YAML ignoring the fields that change on every write
+func diffableYaml(obj *unstructured.Unstructured) (string, error) {
+	obj = obj.DeepCopy()
+	obj.SetManagedFields(nil)
+	obj.SetResourceVersion("")
+	obj.SetGeneration(0)
+	ret, err := sigsyaml.Marshal(obj.Object)
+	if err != nil {
+		return "", err
+	}
+	return string(ret), nil
+}
+
+// ResourcesPatch applies the provided patch (strategic merge, JSON merge, or JSON patch) to the provided resource.
+// The patch body is validated for the provided patch type before being sent to the cluster.
+// If a resourceVersion is provided, the resource is only patched if its live resourceVersion matches (optimistic
+// concurrency), a Conflict error is returned otherwise.
+func (k *Kubernetes) ResourcesPatch(ctx context.Context, gvk *schema.GroupVersionKind, namespace, name string, patchType types.PatchType, patch []byte, resourceVersion string) (*unstructured.Unstructured, error) {
+	if err := validatePatch(patchType, patch); err != nil {
+		return nil, err
+	}
+	if resourceVersion != "" {
+		var err error
+		if patch, err = patchWithResourceVersion(patchType, patch, resourceVersion); err != nil {
+			return nil, err
+		}
+	}
+	gvr, err := k.resourceFor(gvk)
+	if err != nil {
+		return nil, err
+	}
+
+	// If it's a namespaced resource and namespace wasn't provided, try to use the default configured one
+	if namespaced, nsErr := k.isNamespaced(gvk); nsErr == nil && namespaced {
+		namespace = k.NamespaceOrDefault(namespace)
+	}
+	ret, err := k.manager.dynamicClient.Resource(*gvr).Namespace(namespace).Patch(ctx, name, patchType, patch, metav1.PatchOptions{})
+	return ret, resourceVersionConflict(err, resourceVersion)
+}
+
+// ResourcesSetMetadata adds or updates the provided key of the labels or annotations (field) of the provided resource,
+// or removes it if value is nil, with a JSON merge patch.
+// Returns the resulting metadata of the resource (without the managedFields).
+func (k *Kubernetes) ResourcesSetMetadata(ctx context.Context, gvk *schema.GroupVersionKind, namespace, name, field, key string, value *string) (map[string]interface{}, error) {
+	if field != "labels" && field != "annotations" {
+		return nil, fmt.Errorf("unsupported metadata field %s, supported fields are: labels, annotations", field)
+	}
+	if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid key %s: %s", key, strings.Join(errs, "; "))
+	}
+	if value != nil && field == "labels" {
+		if errs := validation.IsValidLabelValue(*value); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid label value %s: %s", *value, strings.Join(errs, "; "))
+		}
+	}
+	var patchValue interface{}
+	if value != nil {
+		patchValue = *value
+	}
+	patch, err := json.Marshal(map[string]interface{}{"metadata": map[string]interface{}{field: map[string]interface{}{key: patchValue}}})
+	if err != nil {
+		return nil, err
+	}
+	ret, err := k.ResourcesPatch(ctx, gvk, namespace, name, types.MergePatchType, patch, "")
+	if err != nil {
+		return nil, err
+	}
+	metadata, _, _ := unstructured.NestedMap(ret.Object, "metadata")
+	delete(metadata, "managedFields")
+	return metadata, nil
+}
+
+// patchWithResourceVersion adds the provided resourceVersion to the provided (valid) patch so that the API server
+// rejects the patch with a Conflict if the live resource has a different resourceVersion
+func patchWithResourceVersion(patchType types.PatchType, patch []byte, resourceVersion string) ([]byte, error) {
+	if patchType == types.JSONPatchType {
+		var operations []interface{}
+		if err := json.Unmarshal(patch, &operations); err != nil {
+			return nil, err
+		}
+		operations = append(operations, map[string]interface{}{
+			"op": "add", "path": "/metadata/resourceVersion", "value": resourceVersion,
+		})
+		return json.Marshal(operations)
+	}
+	var object map[string]interface{}
+	if err := json.Unmarshal(patch, &object); err != nil {
+		return nil, err
+	}
+	if err := unstructured.SetNestedField(object, resourceVersion, "metadata", "resourceVersion"); err != nil {
+		return nil, err
+	}
+	return json.Marshal(object)
+}
+
+// resourceVersionConflict returns a descriptive error if the provided error is a Conflict caused by a resourceVersion
+// mismatch, or the provided error otherwise
+func resourceVersionConflict(err error, resourceVersion string) error {
+	if err != nil && resourceVersion != "" && apierrors.IsConflict(err) {
+		return fmt.Errorf("conflict, the resource has been modified and no longer matches resource version %s, get the latest version and retry: %w", resourceVersion, err)
+	}
+	return err
+}
+
 // resourcesListAsTable retrieves a list of resources in a table format.
 // It's almost identical to the dynamic.DynamicClient implementation, but it uses a specific Accept header to request the table format.
 // dynamic.DynamicClient does not provide a way to set the HTTP header (TODO: create an issue to request this feature)
@@ -159,6 +454,35 @@ func (k *Kubernetes) resourcesCreateOrUpdate(ctx context.Context, resources []*u
 	return resources, nil
 }
 
+// validatePatch checks that the patch body is valid JSON and has the structure expected by the patch type:
+// a list of operations for JSON patches, an object for merge and strategic merge patches.
+func validatePatch(patchType types.PatchType, patch []byte) error {
+	switch patchType {
+	case types.JSONPatchType:
+		var operations []map[string]interface{}
+		if err := json.Unmarshal(patch, &operations); err != nil {
+			return fmt.Errorf("invalid JSON patch, expected a list of operations: %v", err)
+		}
+		for i, operation := range operations {
+			op, _ := operation["op"].(string)
+			if !slices.Contains([]string{"add", "remove", "replace", "move", "copy", "test"}, op) {
+				return fmt.Errorf("invalid JSON patch, operation %d has an invalid op %q", i, op)
+			}
+			if _, ok := operation["path"].(string); !ok {
+				return fmt.Errorf("invalid JSON patch, operation %d is missing path", i)
+			}
+		}
+	case types.MergePatchType, types.StrategicMergePatchType:
+		var object map[string]interface{}
+		if err := json.Unmarshal(patch, &object); err != nil {
+			return fmt.Errorf("invalid merge patch, expected a JSON object: %v", err)
+		}
+	default:
+		return fmt.Errorf("unsupported patch type %s", patchType)
+	}
+	return nil
+}
+
 func (k *Kubernetes) resourceFor(gvk *schema.GroupVersionKind) (*schema.GroupVersionResource, error) {
 	m, err := k.manager.accessControlRESTMapper.RESTMapping(schema.GroupKind{Group: gvk.Group, Kind: gvk.Kind}, gvk.Version)
 	if err != nil {
@@ -0,0 +1,47 @@
+package kubernetes
+
+import (
+	"context"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// readRetryBackoff is the exponential backoff of the read requests failing with a retryable error, its steps are the
+// number of retries (the request is attempted up to steps + 1 times)
+var readRetryBackoff = wait.Backoff{
+	Duration: 200 * time.Millisecond,
+	Factor:   2,
+	Jitter:   0.1,
+	Steps:    3,
+}
+
+// withRetry calls fn until it succeeds, fails with an error that is not retryable (e.g. NotFound, Forbidden), or
+// readRetryBackoff is exhausted, waiting with exponential backoff between the attempts. The last error is returned.
+// The REST client only retries the responses with a Retry-After header, this also covers the throttled or unavailable
+// servers not sending it and the dropped connections.
+// Only idempotent requests (get, list) must be retried.
+func withRetry(ctx context.Context, fn func() error) error {
+	backoff := readRetryBackoff
+	for {
+		err := fn()
+		if err == nil || !isRetryableError(err) || backoff.Steps < 1 {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff.Step()):
+		}
+	}
+}
+
+// isRetryableError returns true if the provided error is caused by a throttled, overloaded, or unreachable server so
+// that the request is likely to succeed if retried shortly
+func isRetryableError(err error) bool {
+	return apierrors.IsTooManyRequests(err) || apierrors.IsServerTimeout(err) || apierrors.IsTimeout(err) ||
+		apierrors.IsServiceUnavailable(err) || utilnet.IsConnectionReset(err) || utilnet.IsConnectionRefused(err) ||
+		utilnet.IsProbableEOF(err) || utilnet.IsHTTP2ConnectionLost(err)
+}
@@ -0,0 +1,137 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+	"github.com/stretchr/testify/suite"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+type RetryTestSuite struct {
+	suite.Suite
+	originalBackoff wait.Backoff
+	mockServer      *test.MockServer
+}
+
+func (s *RetryTestSuite) SetupTest() {
+	s.originalBackoff = readRetryBackoff
+	readRetryBackoff = wait.Backoff{Duration: time.Millisecond, Factor: 2, Steps: 3}
+	s.mockServer = test.NewMockServer()
+}
+
+func (s *RetryTestSuite) TearDownTest() {
+	readRetryBackoff = s.originalBackoff
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+// failing returns a function failing with the provided errors (one per call) and then succeeding, and the calls count
+func failing(errs ...error) (func() error, *int) {
+	calls := 0
+	return func() error {
+		calls++
+		if calls <= len(errs) {
+			return errs[calls-1]
+		}
+		return nil
+	}, &calls
+}
+
+func (s *RetryTestSuite) TestWithRetry() {
+	tooManyRequests := apierrors.NewTooManyRequests("throttled", 0)
+	s.Run("retries TooManyRequests until it succeeds", func() {
+		fn, calls := failing(tooManyRequests, tooManyRequests)
+		s.NoError(withRetry(context.Background(), fn))
+		s.Equal(3, *calls)
+	})
+	s.Run("retries ServerTimeout, ServiceUnavailable, and connection errors", func() {
+		fn, calls := failing(
+			apierrors.NewServerTimeout(schema.GroupResource{Resource: "pods"}, "list", 0),
+			apierrors.NewServiceUnavailable("unavailable"),
+			fmt.Errorf("read tcp: %w", syscall.ECONNRESET),
+		)
+		s.NoError(withRetry(context.Background(), fn))
+		s.Equal(4, *calls)
+	})
+	s.Run("gives up when the backoff is exhausted", func() {
+		fn, calls := failing(tooManyRequests, tooManyRequests, tooManyRequests, tooManyRequests, tooManyRequests)
+		s.True(apierrors.IsTooManyRequests(withRetry(context.Background(), fn)))
+		s.Equal(4, *calls)
+	})
+	s.Run("fails fast on NotFound", func() {
+		fn, calls := failing(apierrors.NewNotFound(schema.GroupResource{Resource: "pods"}, "pod-1"))
+		s.True(apierrors.IsNotFound(withRetry(context.Background(), fn)))
+		s.Equal(1, *calls)
+	})
+	s.Run("fails fast on Forbidden", func() {
+		fn, calls := failing(apierrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "pod-1", errors.New("denied")))
+		s.True(apierrors.IsForbidden(withRetry(context.Background(), fn)))
+		s.Equal(1, *calls)
+	})
+	s.Run("stops retrying when the context is done", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		fn, calls := failing(tooManyRequests, tooManyRequests)
+		s.True(apierrors.IsTooManyRequests(withRetry(ctx, fn)))
+		s.Equal(1, *calls)
+	})
+}
+
+func (s *RetryTestSuite) TestResourcesGetRetriesThrottledRequests() {
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "configmaps", Kind: "ConfigMap", Namespaced: true, Verbs: []string{"get", "list"}},
+		},
+	}))
+	requests := map[string]int{}
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/api/v1/namespaces/ns-1/configmaps/throttled":
+			// Throttled twice without Retry-After (not retried by the REST client)
+			if requests[req.URL.Path]++; requests[req.URL.Path] <= 2 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Status", "status": "Failure", "reason": "TooManyRequests", "code": 429}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "throttled", "namespace": "ns-1"}}`))
+		case "/api/v1/namespaces/ns-1/configmaps/missing":
+			requests[req.URL.Path]++
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Status", "status": "Failure", "reason": "NotFound", "code": 404}`))
+		}
+	}))
+	manager, err := NewKubeconfigManager(&config.StaticConfig{KubeConfig: s.mockServer.KubeconfigFile(s.T())}, "")
+	s.Require().NoError(err)
+	s.T().Cleanup(manager.Close)
+	k := &Kubernetes{manager: manager}
+	gvk := &schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+	s.Run("retries the throttled requests until they succeed", func() {
+		obj, err := k.ResourcesGet(context.Background(), gvk, "ns-1", "throttled")
+		s.Require().NoError(err)
+		s.Equal("throttled", obj.GetName())
+		s.Equal(3, requests["/api/v1/namespaces/ns-1/configmaps/throttled"])
+	})
+	s.Run("does not retry NotFound", func() {
+		_, err := k.ResourcesGet(context.Background(), gvk, "ns-1", "missing")
+		s.True(apierrors.IsNotFound(err))
+		s.Equal(1, requests["/api/v1/namespaces/ns-1/configmaps/missing"])
+	})
+}
+
+func TestRetry(t *testing.T) {
+	suite.Run(t, new(RetryTestSuite))
+}
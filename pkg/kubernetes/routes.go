@@ -0,0 +1,75 @@
+package kubernetes
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var routeGVK = &schema.GroupVersionKind{Group: "route.openshift.io", Version: "v1", Kind: "Route"}
+
+// RoutesTLS inspects the TLS certificates of the edge and reencrypt Routes of the provided namespace (or all namespaces).
+// Embedded certificates are parsed, Routes without an embedded certificate are reported as referencing the
+// external certificate secret or the default ingress serving certificate.
+// Certificates expiring within warnDays are flagged as Expiring.
+func (k *Kubernetes) RoutesTLS(ctx context.Context, namespace string, warnDays int) ([]map[string]any, error) {
+	var routesMap []map[string]any
+	raw, err := k.ResourcesList(ctx, routeGVK, namespace, ResourceListOptions{})
+	if err != nil {
+		return routesMap, err
+	}
+	now := time.Now()
+	for _, item := range raw.(*unstructured.UnstructuredList).Items {
+		termination, _, _ := unstructured.NestedString(item.Object, "spec", "tls", "termination")
+		if termination != "edge" && termination != "reencrypt" {
+			continue
+		}
+		host, _, _ := unstructured.NestedString(item.Object, "spec", "host")
+		route := map[string]any{
+			"Namespace":   item.GetNamespace(),
+			"Name":        item.GetName(),
+			"Host":        host,
+			"Termination": termination,
+		}
+		routesMap = append(routesMap, route)
+		certificate, _, _ := unstructured.NestedString(item.Object, "spec", "tls", "certificate")
+		if certificate == "" {
+			if secret, _, _ := unstructured.NestedString(item.Object, "spec", "tls", "externalCertificate", "name"); secret != "" {
+				route["Certificate"] = "external certificate from secret " + secret
+			} else {
+				route["Certificate"] = "default ingress serving certificate"
+			}
+			continue
+		}
+		cert, err := parseCertificate(certificate)
+		if err != nil {
+			route["Certificate"] = "invalid embedded certificate: " + err.Error()
+			continue
+		}
+		sans := append([]string{}, cert.DNSNames...)
+		for _, ip := range cert.IPAddresses {
+			sans = append(sans, ip.String())
+		}
+		route["Certificate"] = "embedded"
+		route["Subject"] = cert.Subject.String()
+		route["SANs"] = sans
+		route["Issuer"] = cert.Issuer.String()
+		route["NotAfter"] = cert.NotAfter.UTC().Format(time.RFC3339)
+		route["Expiring"] = cert.NotAfter.Before(now.AddDate(0, 0, warnDays))
+	}
+	return routesMap, nil
+}
+
+// parseCertificate parses the first certificate of the provided PEM bundle (the leaf certificate)
+func parseCertificate(data string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("no PEM certificate found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
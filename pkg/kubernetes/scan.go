@@ -0,0 +1,115 @@
+package kubernetes
+
+import (
+	"context"
+	"slices"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ScanWarning reports a namespace that was skipped by a scan across multiple namespaces and why
+type ScanWarning struct {
+	Namespace string
+	Reason    string
+}
+
+// scanNamespaces calls scan for each of the provided namespaces (or all the namespaces if none is provided).
+// Namespaces whose scan fails with a Forbidden or a transient error (timeouts, throttling, unavailable server) are
+// skipped and reported as warnings so that partial results are returned, any other error aborts the scan.
+// If the namespaces can't be listed (forbidden), only the configured namespace is scanned.
+func (k *Kubernetes) scanNamespaces(ctx context.Context, namespaces []string, scan func(namespace string) error) ([]ScanWarning, error) {
+	var warnings []ScanWarning
+	if len(namespaces) == 0 {
+		namespaceInterface, err := k.manager.accessControlClientSet.Namespaces()
+		if err != nil {
+			return nil, err
+		}
+		var namespaceList *v1.NamespaceList
+		err = withRetry(ctx, func() error {
+			namespaceList, err = namespaceInterface.List(ctx, metav1.ListOptions{})
+			return err
+		})
+		if apierrors.IsForbidden(err) {
+			configured := k.manager.configuredNamespace()
+			warnings = append(warnings, ScanWarning{Namespace: "*", Reason: "cannot list namespaces (forbidden), only namespace " + configured + " was scanned"})
+			namespaces = append(namespaces, configured)
+		} else if err != nil {
+			return nil, err
+		}
+		if namespaceList != nil {
+			for _, ns := range namespaceList.Items {
+				namespaces = append(namespaces, ns.Name)
+			}
+		}
+	}
+	for _, namespace := range namespaces {
+		err := scan(namespace)
+		switch {
+		case err == nil:
+		case apierrors.IsForbidden(err):
+			warnings = append(warnings, ScanWarning{Namespace: namespace, Reason: "forbidden"})
+		case isTransientError(err):
+			warnings = append(warnings, ScanWarning{Namespace: namespace, Reason: err.Error()})
+		default:
+			return nil, err
+		}
+	}
+	return warnings, nil
+}
+
+// resourcesScan lists the provided resources from the provided namespace or all namespaces.
+// If the resources can't be listed across all namespaces at once, each namespace is listed individually and the
+// namespaces that can't be listed are reported as warnings (see scanNamespaces).
+func (k *Kubernetes) resourcesScan(ctx context.Context, gvk *schema.GroupVersionKind, namespace string, options metav1.ListOptions) ([]unstructured.Unstructured, []ScanWarning, error) {
+	gvr, err := k.resourceFor(gvk)
+	if err != nil {
+		return nil, nil, err
+	}
+	list := func(namespace string) (list *unstructured.UnstructuredList, err error) {
+		err = withRetry(ctx, func() error {
+			list, err = k.manager.dynamicClient.Resource(*gvr).Namespace(namespace).List(ctx, options)
+			return err
+		})
+		return list, err
+	}
+	if namespaced, _ := k.isNamespaced(gvk); namespace != "" || !namespaced || k.canIUse(ctx, gvr, "", "list") {
+		namespaceList, err := list(namespace)
+		if err != nil {
+			return nil, nil, err
+		}
+		return namespaceList.Items, nil, nil
+	}
+	var items []unstructured.Unstructured
+	warnings, err := k.scanNamespaces(ctx, nil, func(namespace string) error {
+		namespaceList, err := list(namespace)
+		if err != nil {
+			return err
+		}
+		items = append(items, namespaceList.Items...)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return items, warnings, nil
+}
+
+// appendScanWarnings appends the provided warnings that are not reported yet (e.g. the same namespace skipped by the
+// scans of different resources)
+func appendScanWarnings(warnings []ScanWarning, more ...ScanWarning) []ScanWarning {
+	for _, warning := range more {
+		if !slices.Contains(warnings, warning) {
+			warnings = append(warnings, warning)
+		}
+	}
+	return warnings
+}
+
+// isTransientError returns true if the provided error is likely to succeed if retried later
+func isTransientError(err error) bool {
+	return isRetryableError(err) || apierrors.IsInternalError(err)
+}
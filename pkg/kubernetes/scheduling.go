@@ -0,0 +1,363 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+// schedulingBlocker is a constraint preventing a Pod from being scheduled on a Node
+type schedulingBlocker struct {
+	// constraint groups the blockers of the different Nodes (e.g. "insufficient cpu")
+	constraint string
+	// detail describes the blocker for a specific Node (e.g. "insufficient cpu (requested: 2, available: 500m)")
+	detail     string
+	suggestion string
+}
+
+// PodsWhyPending explains why the provided Pending Pod can't be scheduled.
+// The scheduler's reasons (PodScheduled condition and latest FailedScheduling event) are cross-referenced with the
+// Nodes: for each Node, the constraints preventing the Pod from being scheduled (cordoned or not ready Node,
+// untolerated taints, node selector, required node affinity, insufficient resources) are reported, and the blockers
+// are summarized by constraint along with a suggestion of which constraint to relax.
+func (k *Kubernetes) PodsWhyPending(ctx context.Context, namespace, name string) (map[string]any, error) {
+	namespace = k.NamespaceOrDefault(namespace)
+	pods, err := k.manager.accessControlClientSet.Pods(namespace)
+	if err != nil {
+		return nil, err
+	}
+	pod, err := pods.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	ret := map[string]any{
+		"Namespace": pod.Namespace,
+		"Name":      pod.Name,
+		"Phase":     string(pod.Status.Phase),
+	}
+	if pod.Status.Phase != v1.PodPending {
+		ret["Explanation"] = fmt.Sprintf("The Pod is not Pending (phase %s)", pod.Status.Phase)
+		return ret, nil
+	}
+	if pod.Spec.NodeName != "" {
+		ret["Node"] = pod.Spec.NodeName
+		ret["Explanation"] = fmt.Sprintf("The Pod is already scheduled on node %s, it's Pending because its containers can't start (use pods_diagnose)", pod.Spec.NodeName)
+		return ret, nil
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodScheduled && condition.Status == v1.ConditionFalse {
+			ret["Reason"] = condition.Reason
+			ret["Message"] = condition.Message
+		}
+	}
+	var unavailable []string
+	if event, err := k.podsLatestFailedScheduling(ctx, pod); err != nil {
+		unavailable = append(unavailable, fmt.Sprintf("events: %v", err))
+	} else if event != nil {
+		ret["FailedScheduling"] = map[string]any{
+			"Timestamp": eventTimestamp(event).UTC().Format(time.RFC3339),
+			"Count":     eventCount(event),
+			"Message":   strings.TrimSpace(event.Message),
+		}
+	}
+	nodes, err := k.manager.accessControlClientSet.Nodes()
+	if err != nil {
+		return nil, err
+	}
+	nodeList, err := nodes.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		unavailable = append(unavailable, fmt.Sprintf("nodes: %v", err))
+		ret["Unavailable"] = unavailable
+		return ret, nil
+	}
+	// Requests of the Pods already running on each Node (unknown if Pods can't be listed across all namespaces)
+	var allocated map[string]v1.ResourceList
+	var allocatedPods map[string]int64
+	if allPods, err := k.manager.accessControlClientSet.Pods(""); err == nil {
+		podList, err := allPods.List(ctx, metav1.ListOptions{FieldSelector: "status.phase!=Succeeded,status.phase!=Failed"})
+		if err != nil {
+			unavailable = append(unavailable, fmt.Sprintf("pods (node usage not accounted): %v", err))
+		} else {
+			allocated = make(map[string]v1.ResourceList)
+			allocatedPods = make(map[string]int64)
+			for _, p := range podList.Items {
+				if p.Spec.NodeName == "" {
+					continue
+				}
+				allocatedPods[p.Spec.NodeName]++
+				if allocated[p.Spec.NodeName] == nil {
+					allocated[p.Spec.NodeName] = v1.ResourceList{}
+				}
+				for resourceName, quantity := range podRequests(&p) {
+					total := allocated[p.Spec.NodeName][resourceName]
+					total.Add(quantity)
+					allocated[p.Spec.NodeName][resourceName] = total
+				}
+			}
+		}
+	}
+	requests := podRequests(pod)
+	sort.Slice(nodeList.Items, func(i, j int) bool {
+		return nodeList.Items[i].Name < nodeList.Items[j].Name
+	})
+	type summary struct {
+		nodes      []string
+		suggestion string
+	}
+	summaries := make(map[string]*summary)
+	nodesBlockers := make([]map[string]any, 0, len(nodeList.Items))
+	fits := make([]string, 0)
+	for _, node := range nodeList.Items {
+		blockers := nodeSchedulingBlockers(pod, &node, requests, allocated[node.Name], allocatedPods[node.Name])
+		if len(blockers) == 0 {
+			fits = append(fits, node.Name)
+			continue
+		}
+		details := make([]string, 0, len(blockers))
+		for _, blocker := range blockers {
+			details = append(details, blocker.detail)
+			if summaries[blocker.constraint] == nil {
+				summaries[blocker.constraint] = &summary{suggestion: blocker.suggestion}
+			}
+			summaries[blocker.constraint].nodes = append(summaries[blocker.constraint].nodes, node.Name)
+		}
+		nodesBlockers = append(nodesBlockers, map[string]any{"Name": node.Name, "Blockers": details})
+	}
+	constraints := make([]string, 0, len(summaries))
+	for constraint := range summaries {
+		constraints = append(constraints, constraint)
+	}
+	sort.Slice(constraints, func(i, j int) bool {
+		if len(summaries[constraints[i]].nodes) != len(summaries[constraints[j]].nodes) {
+			return len(summaries[constraints[i]].nodes) > len(summaries[constraints[j]].nodes)
+		}
+		return constraints[i] < constraints[j]
+	})
+	blockers := make([]map[string]any, 0, len(constraints))
+	for _, constraint := range constraints {
+		blockers = append(blockers, map[string]any{
+			"Constraint": constraint,
+			"Nodes":      summaries[constraint].nodes,
+			"Suggestion": summaries[constraint].suggestion,
+		})
+	}
+	ret["Blockers"] = blockers
+	ret["Nodes"] = nodesBlockers
+	if len(fits) > 0 {
+		ret["FittingNodes"] = fits
+	}
+	if len(unavailable) > 0 {
+		ret["Unavailable"] = unavailable
+	}
+	return ret, nil
+}
+
+// podsLatestFailedScheduling returns the latest FailedScheduling event of the provided Pod (nil if none)
+func (k *Kubernetes) podsLatestFailedScheduling(ctx context.Context, pod *v1.Pod) (*v1.Event, error) {
+	events, err := k.manager.accessControlClientSet.Events(pod.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	eventList, err := events.List(ctx, metav1.ListOptions{FieldSelector: fields.SelectorFromSet(fields.Set{
+		"involvedObject.kind": "Pod",
+		"involvedObject.name": pod.Name,
+		"reason":              "FailedScheduling",
+	}).String()})
+	if err != nil {
+		return nil, err
+	}
+	var latest *v1.Event
+	for i, event := range eventList.Items {
+		// Events of a previous Pod with the same name are not relevant
+		if event.Reason != "FailedScheduling" || event.InvolvedObject.Name != pod.Name ||
+			(event.InvolvedObject.UID != "" && event.InvolvedObject.UID != pod.UID) {
+			continue
+		}
+		if latest == nil || eventTimestamp(&event).After(eventTimestamp(latest)) {
+			latest = &eventList.Items[i]
+		}
+	}
+	return latest, nil
+}
+
+// nodeSchedulingBlockers returns the constraints preventing the provided Pod (with the provided requests) from being
+// scheduled on the provided Node, given the requests and number of the Pods already allocated to the Node
+// (allocated is nil if unknown)
+func nodeSchedulingBlockers(pod *v1.Pod, node *v1.Node, requests, allocated v1.ResourceList, allocatedPods int64) []schedulingBlocker {
+	var blockers []schedulingBlocker
+	if node.Spec.Unschedulable {
+		blockers = append(blockers, schedulingBlocker{
+			constraint: "node cordoned",
+			detail:     "node cordoned (unschedulable)",
+			suggestion: "uncordon the nodes",
+		})
+	}
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == v1.NodeReady && condition.Status != v1.ConditionTrue {
+			blockers = append(blockers, schedulingBlocker{
+				constraint: "node not ready",
+				detail:     "node not ready",
+				suggestion: "fix the nodes or wait for them to become Ready",
+			})
+		}
+	}
+	for _, taint := range untoleratedTaints(pod.Spec.Tolerations, node.Spec.Taints) {
+		blockers = append(blockers, schedulingBlocker{
+			constraint: "untolerated taint " + taint.ToString(),
+			detail:     "untolerated taint " + taint.ToString(),
+			suggestion: fmt.Sprintf("add a toleration for the taint %s to the Pod or remove the taint from the nodes", taint.ToString()),
+		})
+	}
+	selectorKeys := make([]string, 0, len(pod.Spec.NodeSelector))
+	for key := range pod.Spec.NodeSelector {
+		selectorKeys = append(selectorKeys, key)
+	}
+	sort.Strings(selectorKeys)
+	for _, key := range selectorKeys {
+		if value, ok := node.Labels[key]; !ok || value != pod.Spec.NodeSelector[key] {
+			selector := key + "=" + pod.Spec.NodeSelector[key]
+			blockers = append(blockers, schedulingBlocker{
+				constraint: "node selector " + selector + " not matched",
+				detail:     "node selector " + selector + " not matched",
+				suggestion: fmt.Sprintf("relax the Pod nodeSelector %s or label the nodes", selector),
+			})
+		}
+	}
+	if affinity := pod.Spec.Affinity; affinity != nil && affinity.NodeAffinity != nil &&
+		affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil &&
+		!nodeMatchesSelectorTerms(node, affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms) {
+		blockers = append(blockers, schedulingBlocker{
+			constraint: "required node affinity not matched",
+			detail:     "required node affinity not matched",
+			suggestion: "relax the Pod required node affinity or label the nodes",
+		})
+	}
+	resourceNames := make([]string, 0, len(requests))
+	for resourceName := range requests {
+		resourceNames = append(resourceNames, string(resourceName))
+	}
+	sort.Strings(resourceNames)
+	for _, resourceName := range resourceNames {
+		requested := requests[v1.ResourceName(resourceName)]
+		available := node.Status.Allocatable[v1.ResourceName(resourceName)]
+		available.Sub(allocated[v1.ResourceName(resourceName)])
+		if requested.Cmp(available) > 0 {
+			blockers = append(blockers, schedulingBlocker{
+				constraint: "insufficient " + resourceName,
+				detail:     fmt.Sprintf("insufficient %s (requested: %s, available: %s)", resourceName, requested.String(), available.String()),
+				suggestion: fmt.Sprintf("reduce the Pod %s requests or add %s capacity to the cluster", resourceName, resourceName),
+			})
+		}
+	}
+	if maxPods, ok := node.Status.Allocatable[v1.ResourcePods]; ok && allocated != nil && allocatedPods >= maxPods.Value() {
+		blockers = append(blockers, schedulingBlocker{
+			constraint: "too many pods",
+			detail:     fmt.Sprintf("too many pods (%d of %d)", allocatedPods, maxPods.Value()),
+			suggestion: "add nodes to the cluster or increase their maximum number of pods",
+		})
+	}
+	return blockers
+}
+
+// untoleratedTaints returns the provided taints preventing scheduling (NoSchedule and NoExecute effects) that are not
+// tolerated by any of the provided tolerations
+func untoleratedTaints(tolerations []v1.Toleration, taints []v1.Taint) []v1.Taint {
+	var ret []v1.Taint
+	for _, taint := range taints {
+		if taint.Effect != v1.TaintEffectNoSchedule && taint.Effect != v1.TaintEffectNoExecute {
+			continue
+		}
+		tolerated := false
+		for _, toleration := range tolerations {
+			if toleration.ToleratesTaint(&taint) {
+				tolerated = true
+				break
+			}
+		}
+		if !tolerated {
+			ret = append(ret, taint)
+		}
+	}
+	return ret
+}
+
+// podRequests returns the effective resource requests of the provided Pod: the maximum of the sum of the container
+// requests and of each init container requests, plus the Pod overhead
+func podRequests(pod *v1.Pod) v1.ResourceList {
+	ret := v1.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		for resourceName, quantity := range container.Resources.Requests {
+			total := ret[resourceName]
+			total.Add(quantity)
+			ret[resourceName] = total
+		}
+	}
+	for _, container := range pod.Spec.InitContainers {
+		for resourceName, quantity := range container.Resources.Requests {
+			if current, ok := ret[resourceName]; !ok || quantity.Cmp(current) > 0 {
+				ret[resourceName] = quantity.DeepCopy()
+			}
+		}
+	}
+	for resourceName, quantity := range pod.Spec.Overhead {
+		total := ret[resourceName]
+		total.Add(quantity)
+		ret[resourceName] = total
+	}
+	for resourceName, quantity := range ret {
+		if quantity.IsZero() {
+			delete(ret, resourceName)
+		}
+	}
+	return ret
+}
+
+// nodeMatchesSelectorTerms returns true if the provided Node matches any of the provided (ORed) node selector terms
+func nodeMatchesSelectorTerms(node *v1.Node, terms []v1.NodeSelectorTerm) bool {
+	for _, term := range terms {
+		if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+			continue
+		}
+		matches := true
+		for _, requirement := range term.MatchExpressions {
+			matches = matches && nodeSelectorRequirementMatches(requirement, node.Labels)
+		}
+		for _, requirement := range term.MatchFields {
+			matches = matches && nodeSelectorRequirementMatches(requirement, map[string]string{"metadata.name": node.Name})
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeSelectorRequirementMatches returns true if the provided values (Node labels or fields) match the provided
+// node selector requirement
+func nodeSelectorRequirementMatches(requirement v1.NodeSelectorRequirement, values map[string]string) bool {
+	operators := map[v1.NodeSelectorOperator]selection.Operator{
+		v1.NodeSelectorOpIn:           selection.In,
+		v1.NodeSelectorOpNotIn:        selection.NotIn,
+		v1.NodeSelectorOpExists:       selection.Exists,
+		v1.NodeSelectorOpDoesNotExist: selection.DoesNotExist,
+		v1.NodeSelectorOpGt:           selection.GreaterThan,
+		v1.NodeSelectorOpLt:           selection.LessThan,
+	}
+	operator, ok := operators[requirement.Operator]
+	if !ok {
+		return false
+	}
+	labelRequirement, err := labels.NewRequirement(requirement.Key, operator, requirement.Values)
+	if err != nil {
+		return false
+	}
+	return labelRequirement.Matches(labels.Set(values))
+}
@@ -0,0 +1,50 @@
+package kubernetes
+
+import (
+	"context"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// SecretsList summarizes the Secrets in the provided namespace (or all namespaces), optionally only those of the
+// provided type (e.g. kubernetes.io/tls): their type and the names of their keys.
+// Secret values are never returned.
+func (k *Kubernetes) SecretsList(ctx context.Context, namespace, secretType string) ([]map[string]any, error) {
+	options := ResourceListOptions{}
+	if secretType != "" {
+		options.ListOptions = metav1.ListOptions{FieldSelector: fields.OneTermEqualSelector("type", secretType).String()}
+	}
+	raw, err := k.ResourcesList(ctx, &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Secret"}, namespace, options)
+	if err != nil {
+		return nil, err
+	}
+	var ret []map[string]any
+	for _, item := range raw.(*unstructured.UnstructuredList).Items {
+		secret := &v1.Secret{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, secret); err != nil {
+			return nil, err
+		}
+		keys := make([]string, 0, len(secret.Data))
+		for key := range secret.Data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		current := map[string]any{
+			"Namespace": secret.Namespace,
+			"Name":      secret.Name,
+			"Type":      string(secret.Type),
+			"Keys":      keys,
+		}
+		if serviceAccount, ok := secret.Annotations[v1.ServiceAccountNameKey]; ok {
+			current["ServiceAccount"] = serviceAccount
+		}
+		ret = append(ret, current)
+	}
+	return ret, nil
+}
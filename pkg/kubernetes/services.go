@@ -0,0 +1,116 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ServicesList summarizes the Services in the provided namespace (or all namespaces) along with the health of their
+// backing endpoints (from EndpointSlices).
+// Services with no ready endpoints (a common cause of "connection refused") are flagged with NoReadyEndpoints,
+// ExternalName Services are never flagged since they don't have endpoints.
+// Namespaces that can't be scanned (Forbidden or transient errors) are skipped and reported as warnings.
+func (k *Kubernetes) ServicesList(ctx context.Context, namespace string) ([]map[string]any, []ScanWarning, error) {
+	services, skipped, err := k.resourcesScan(ctx, &schema.GroupVersionKind{
+		Group: "", Version: "v1", Kind: "Service",
+	}, namespace, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	endpointSlices, endpointSlicesSkipped, err := k.resourcesScan(ctx, &schema.GroupVersionKind{
+		Group: "discovery.k8s.io", Version: "v1", Kind: "EndpointSlice",
+	}, namespace, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	skipped = appendScanWarnings(skipped, endpointSlicesSkipped...)
+	// Endpoint readiness by Service (namespace/name) and endpoint target (the same Pod is listed in a slice per address family)
+	endpointsByService := make(map[string]map[string]bool)
+	for _, item := range endpointSlices {
+		slice := &discoveryv1.EndpointSlice{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, slice); err != nil {
+			return nil, nil, err
+		}
+		serviceName := slice.Labels[discoveryv1.LabelServiceName]
+		if serviceName == "" {
+			continue
+		}
+		serviceKey := slice.Namespace + "/" + serviceName
+		if endpointsByService[serviceKey] == nil {
+			endpointsByService[serviceKey] = make(map[string]bool)
+		}
+		for _, endpoint := range slice.Endpoints {
+			key := strings.Join(endpoint.Addresses, ",")
+			if endpoint.TargetRef != nil {
+				key = endpoint.TargetRef.Kind + "/" + endpoint.TargetRef.Name
+			}
+			// A nil ready condition must be interpreted as ready
+			ready := endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready
+			endpointsByService[serviceKey][key] = endpointsByService[serviceKey][key] || ready
+		}
+	}
+	var ret []map[string]any
+	for _, item := range services {
+		service := &v1.Service{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, service); err != nil {
+			return nil, nil, err
+		}
+		current := map[string]any{
+			"Namespace": service.Namespace,
+			"Name":      service.Name,
+			"Type":      string(service.Spec.Type),
+			"Ports":     servicePorts(service.Spec.Ports),
+		}
+		if service.Spec.ClusterIP != "" {
+			current["ClusterIP"] = service.Spec.ClusterIP
+		}
+		if len(service.Spec.Selector) > 0 {
+			current["Selector"] = labels.Set(service.Spec.Selector).String()
+		}
+		if service.Spec.Type == v1.ServiceTypeExternalName {
+			current["ExternalName"] = service.Spec.ExternalName
+			ret = append(ret, current)
+			continue
+		}
+		endpoints := endpointsByService[service.Namespace+"/"+service.Name]
+		ready := 0
+		for _, isReady := range endpoints {
+			if isReady {
+				ready++
+			}
+		}
+		current["ReadyEndpoints"] = ready
+		current["TotalEndpoints"] = len(endpoints)
+		current["NoReadyEndpoints"] = ready == 0
+		ret = append(ret, current)
+	}
+	return ret, skipped, nil
+}
+
+// servicePorts returns a human-readable description of the provided Service ports (e.g. http 80->8080/TCP)
+func servicePorts(ports []v1.ServicePort) []string {
+	ret := make([]string, 0, len(ports))
+	for _, port := range ports {
+		description := fmt.Sprintf("%d", port.Port)
+		if port.TargetPort.String() != "" && port.TargetPort.String() != "0" {
+			description += "->" + port.TargetPort.String()
+		}
+		description += "/" + string(port.Protocol)
+		if port.NodePort != 0 {
+			description += fmt.Sprintf(" (nodePort %d)", port.NodePort)
+		}
+		if port.Name != "" {
+			description = port.Name + " " + description
+		}
+		ret = append(ret, description)
+	}
+	return ret
+}
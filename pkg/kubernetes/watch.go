@@ -0,0 +1,198 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// Default duration of the watch performed by ResourcesWatch
+const DefaultWatchTimeout = 30 * time.Second
+
+// Default maximum number of events returned by ResourcesWatch
+const DefaultWatchMaxEvents = 50
+
+type ResourcesWatchOptions struct {
+	// Name of the resource to watch (all the resources of the namespace if not provided)
+	Name          string
+	LabelSelector string
+	// Timeout is the maximum duration of the watch (DefaultWatchTimeout if not provided)
+	Timeout time.Duration
+	// MaxEvents is the maximum number of events to observe (DefaultWatchMaxEvents if not provided)
+	MaxEvents int
+	// Until stops the watch once a watched resource meets the condition, same syntax as `kubectl wait --for`:
+	// delete, create, condition=Ready, condition=Ready=False, jsonpath={.status.phase}=Running
+	Until string
+}
+
+// watchCondition evaluates whether the object of the provided watch event meets a condition
+type watchCondition func(eventType watch.EventType, obj *unstructured.Unstructured) bool
+
+// ResourcesWatch watches the resources of the provided kind for changes during a bounded duration.
+// The current state of the resources is listed first, so only the changes after the call are reported.
+// Returns the observed Added/Modified/Deleted events and the reason why the watch stopped.
+func (k *Kubernetes) ResourcesWatch(ctx context.Context, gvk *schema.GroupVersionKind, namespace string, options ResourcesWatchOptions) (map[string]any, error) {
+	until, err := parseWatchCondition(options.Until)
+	if err != nil {
+		return nil, err
+	}
+	if options.Timeout <= 0 {
+		options.Timeout = DefaultWatchTimeout
+	}
+	if options.MaxEvents <= 0 {
+		options.MaxEvents = DefaultWatchMaxEvents
+	}
+	gvr, err := k.resourceFor(gvk)
+	if err != nil {
+		return nil, err
+	}
+	if isNamespaced, _ := k.isNamespaced(gvk); isNamespaced && options.Name != "" {
+		// If a specific resource is watched and namespace wasn't provided, try to use the default configured one
+		namespace = k.NamespaceOrDefault(namespace)
+	} else if isNamespaced && namespace == "" && !k.canIUse(ctx, gvr, namespace, "watch") {
+		// Check if operation is allowed for all namespaces
+		namespace = k.manager.configuredNamespace()
+	}
+	listOptions := metav1.ListOptions{LabelSelector: options.LabelSelector}
+	if options.Name != "" {
+		listOptions.FieldSelector = fields.OneTermEqualSelector("metadata.name", options.Name).String()
+	}
+	client := k.manager.dynamicClient.Resource(*gvr).Namespace(namespace)
+	current, err := client.List(ctx, listOptions)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]map[string]any, 0)
+	if until != nil {
+		for i := range current.Items {
+			if until(watch.Added, &current.Items[i]) {
+				return map[string]any{"Events": events, "StopReason": "condition already met before watching"}, nil
+			}
+		}
+	}
+	watchCtx, cancel := context.WithTimeout(ctx, options.Timeout)
+	defer cancel()
+	listOptions.ResourceVersion = current.GetResourceVersion()
+	listOptions.AllowWatchBookmarks = false
+	watcher, err := client.Watch(watchCtx, listOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer watcher.Stop()
+	stopReason := fmt.Sprintf("timed out after %s", options.Timeout)
+	for stop := false; !stop; {
+		select {
+		case <-watchCtx.Done():
+			// The request was cancelled (or its deadline exceeded) before the watch timeout, abort instead of
+			// reporting a partial result
+			if err = ctx.Err(); err != nil {
+				return nil, err
+			}
+			stop = true
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				if err = ctx.Err(); err != nil {
+					return nil, err
+				}
+				if watchCtx.Err() == nil {
+					stopReason = "watch closed by the server"
+				}
+				stop = true
+				break
+			}
+			if event.Type == watch.Error {
+				return nil, fmt.Errorf("watch failed: %v", event.Object)
+			}
+			obj, isUnstructured := event.Object.(*unstructured.Unstructured)
+			if !isUnstructured || event.Type == watch.Bookmark {
+				continue
+			}
+			events = append(events, map[string]any{
+				"Type":            string(event.Type),
+				"Namespace":       obj.GetNamespace(),
+				"Name":            obj.GetName(),
+				"ResourceVersion": obj.GetResourceVersion(),
+				"ObservedAt":      time.Now().UTC().Format(time.RFC3339),
+			})
+			if until != nil && until(event.Type, obj) {
+				stopReason = "condition met"
+				stop = true
+			} else if len(events) >= options.MaxEvents {
+				stopReason = fmt.Sprintf("reached the maximum of %d events", options.MaxEvents)
+				stop = true
+			}
+		}
+	}
+	return map[string]any{"Events": events, "StopReason": stopReason}, nil
+}
+
+// parseWatchCondition parses the provided condition (`kubectl wait --for` syntax), returns nil if no condition is provided
+func parseWatchCondition(until string) (watchCondition, error) {
+	until = strings.TrimSpace(until)
+	switch {
+	case until == "":
+		return nil, nil
+	case strings.EqualFold(until, "delete"):
+		return func(eventType watch.EventType, _ *unstructured.Unstructured) bool {
+			return eventType == watch.Deleted
+		}, nil
+	case strings.EqualFold(until, "create"):
+		return func(eventType watch.EventType, _ *unstructured.Unstructured) bool {
+			return eventType == watch.Added
+		}, nil
+	case strings.HasPrefix(until, "condition="):
+		conditionType, conditionStatus, _ := strings.Cut(strings.TrimPrefix(until, "condition="), "=")
+		if conditionType == "" {
+			return nil, fmt.Errorf("invalid condition %s, expected condition=<type>[=<status>]", until)
+		}
+		if conditionStatus == "" {
+			conditionStatus = "True"
+		}
+		return func(eventType watch.EventType, obj *unstructured.Unstructured) bool {
+			if eventType == watch.Deleted {
+				return false
+			}
+			conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+			for _, c := range conditions {
+				condition, ok := c.(map[string]any)
+				if ok && strings.EqualFold(fmt.Sprint(condition["type"]), conditionType) &&
+					strings.EqualFold(fmt.Sprint(condition["status"]), conditionStatus) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	case strings.HasPrefix(until, "jsonpath="):
+		expression, value, hasValue := strings.Cut(strings.TrimPrefix(until, "jsonpath="), "}=")
+		if hasValue {
+			expression += "}"
+		}
+		parser := jsonpath.New("until").AllowMissingKeys(true)
+		if err := parser.Parse(expression); err != nil {
+			return nil, fmt.Errorf("invalid condition %s: %v", until, err)
+		}
+		return func(eventType watch.EventType, obj *unstructured.Unstructured) bool {
+			if eventType == watch.Deleted {
+				return false
+			}
+			results, err := parser.FindResults(obj.Object)
+			if err != nil || len(results) == 0 || len(results[0]) == 0 {
+				return false
+			}
+			if !hasValue {
+				return true
+			}
+			return fmt.Sprint(results[0][0].Interface()) == value
+		}, nil
+	}
+	return nil, errors.New("invalid condition " + until + ", expected delete, create, condition=<type>[=<status>], or jsonpath={<expression>}[=<value>]")
+}
@@ -0,0 +1,140 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// WebhooksList summarizes the admission webhooks of the ValidatingWebhookConfigurations and
+// MutatingWebhookConfigurations of the cluster: their rules, failure policy, and target service (or URL).
+// If a resource (e.g. pods, deployments, or pods/exec for a subresource) is provided, only the webhooks with a rule
+// matching it are returned.
+// Webhooks with a Fail failure policy block the matching operations when their backend is unavailable.
+func (k *Kubernetes) WebhooksList(ctx context.Context, resource string) ([]map[string]any, error) {
+	ret := make([]map[string]any, 0)
+	for _, webhookType := range []string{"Validating", "Mutating"} {
+		raw, err := k.ResourcesList(ctx, &schema.GroupVersionKind{
+			Group: "admissionregistration.k8s.io", Version: "v1", Kind: webhookType + "WebhookConfiguration",
+		}, "", ResourceListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range raw.(*unstructured.UnstructuredList).Items {
+			var webhooks []map[string]any
+			if webhookType == "Validating" {
+				configuration := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+				if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, configuration); err != nil {
+					return nil, err
+				}
+				for _, webhook := range configuration.Webhooks {
+					if webhookMatches(webhook.Rules, resource) {
+						webhooks = append(webhooks, webhookSummary(webhook.Name, webhook.Rules, webhook.FailurePolicy, webhook.ClientConfig, webhook.TimeoutSeconds))
+					}
+				}
+			} else {
+				configuration := &admissionregistrationv1.MutatingWebhookConfiguration{}
+				if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, configuration); err != nil {
+					return nil, err
+				}
+				for _, webhook := range configuration.Webhooks {
+					if webhookMatches(webhook.Rules, resource) {
+						webhooks = append(webhooks, webhookSummary(webhook.Name, webhook.Rules, webhook.FailurePolicy, webhook.ClientConfig, webhook.TimeoutSeconds))
+					}
+				}
+			}
+			for _, webhook := range webhooks {
+				webhook["Type"] = webhookType
+				webhook["Configuration"] = item.GetName()
+				ret = append(ret, webhook)
+			}
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		if ret[i]["Configuration"] != ret[j]["Configuration"] {
+			return ret[i]["Configuration"].(string) < ret[j]["Configuration"].(string)
+		}
+		return ret[i]["Name"].(string) < ret[j]["Name"].(string)
+	})
+	return ret, nil
+}
+
+// webhookSummary returns the summary of the provided admission webhook fields (shared by validating and mutating webhooks)
+func webhookSummary(name string, rules []admissionregistrationv1.RuleWithOperations, failurePolicy *admissionregistrationv1.FailurePolicyType,
+	clientConfig admissionregistrationv1.WebhookClientConfig, timeoutSeconds *int32) map[string]any {
+	policy := admissionregistrationv1.Fail
+	if failurePolicy != nil {
+		policy = *failurePolicy
+	}
+	descriptions := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		operations := make([]string, 0, len(rule.Operations))
+		for _, operation := range rule.Operations {
+			operations = append(operations, string(operation))
+		}
+		groups := make([]string, 0, len(rule.APIGroups))
+		for _, group := range rule.APIGroups {
+			if group == "" {
+				group = "core"
+			}
+			groups = append(groups, group)
+		}
+		description := fmt.Sprintf("%s %s (groups: %s, versions: %s)", strings.Join(operations, ","),
+			strings.Join(rule.Resources, ","), strings.Join(groups, ","), strings.Join(rule.APIVersions, ","))
+		if rule.Scope != nil && *rule.Scope != admissionregistrationv1.AllScopes {
+			description += fmt.Sprintf(" scope: %s", *rule.Scope)
+		}
+		descriptions = append(descriptions, description)
+	}
+	summary := map[string]any{
+		"Name":          name,
+		"FailurePolicy": string(policy),
+		"Rules":         descriptions,
+	}
+	switch {
+	case clientConfig.Service != nil:
+		target := fmt.Sprintf("service %s/%s", clientConfig.Service.Namespace, clientConfig.Service.Name)
+		if clientConfig.Service.Port != nil {
+			target += fmt.Sprintf(":%d", *clientConfig.Service.Port)
+		}
+		if clientConfig.Service.Path != nil {
+			target += *clientConfig.Service.Path
+		}
+		summary["Target"] = target
+	case clientConfig.URL != nil:
+		summary["Target"] = *clientConfig.URL
+	}
+	if timeoutSeconds != nil {
+		summary["TimeoutSeconds"] = *timeoutSeconds
+	}
+	return summary
+}
+
+// webhookMatches returns true if any of the provided webhook rules matches the provided resource (or subresource
+// in resource/subresource format), or if no resource is provided
+func webhookMatches(rules []admissionregistrationv1.RuleWithOperations, resource string) bool {
+	if resource == "" {
+		return true
+	}
+	name, subresource, _ := strings.Cut(strings.ToLower(resource), "/")
+	for _, rule := range rules {
+		for _, ruleResource := range rule.Resources {
+			ruleName, ruleSubresource, _ := strings.Cut(ruleResource, "/")
+			if ruleName != "*" && ruleName != name {
+				continue
+			}
+			// '*' matches all resources but not subresources, 'pods/*' matches all subresources of pods but not pods,
+			// '*/*' matches all resources and subresources
+			if ruleSubresource == subresource || (ruleSubresource == "*" && (subresource != "" || ruleName == "*")) {
+				return true
+			}
+		}
+	}
+	return false
+}
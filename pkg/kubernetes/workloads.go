@@ -0,0 +1,295 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+)
+
+// WorkloadKinds are the kinds of the workloads supported by WorkloadsLogs
+var WorkloadKinds = []string{"Deployment", "StatefulSet", "DaemonSet", "Job"}
+
+type WorkloadsLogsOptions struct {
+	Container string
+	// Since returns the logs newer than the provided duration (all the logs if zero)
+	Since time.Duration
+	// TailLines is the number of lines to retrieve from the end of the logs of each Pod (DefaultTailLines if zero)
+	TailLines int64
+}
+
+// WorkloadsLogs returns the recent logs of all the Pods of the provided workload (Deployment, StatefulSet, DaemonSet,
+// or Job), resolved with the workload's Pod selector.
+// Each log line is prefixed with the Pod name, Pods whose logs can't be retrieved (e.g. still pending) report the
+// reason instead.
+func (k *Kubernetes) WorkloadsLogs(ctx context.Context, kind, namespace, name string, options WorkloadsLogsOptions) (string, error) {
+	gvk, err := workloadGVK(kind)
+	if err != nil {
+		return "", err
+	}
+	namespace = k.NamespaceOrDefault(namespace)
+	workload, err := k.ResourcesGet(ctx, gvk, namespace, name)
+	if err != nil {
+		return "", err
+	}
+	rawSelector, found, _ := unstructured.NestedMap(workload.Object, "spec", "selector")
+	if !found {
+		return "", fmt.Errorf("%s %s has no pod selector", kind, name)
+	}
+	labelSelector := &metav1.LabelSelector{}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(rawSelector, labelSelector); err != nil {
+		return "", err
+	}
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return "", err
+	}
+	pods, err := k.manager.accessControlClientSet.Pods(namespace)
+	if err != nil {
+		return "", err
+	}
+	podList, err := pods.List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return "", err
+	}
+	if len(podList.Items) == 0 {
+		return "", fmt.Errorf("no pods found for %s %s (selector %s)", kind, name, selector.String())
+	}
+	sort.Slice(podList.Items, func(i, j int) bool {
+		return podList.Items[i].Name < podList.Items[j].Name
+	})
+	logOptions := &v1.PodLogOptions{
+		Container: options.Container,
+		TailLines: ptr.To(DefaultTailLines),
+	}
+	if options.TailLines > 0 {
+		logOptions.TailLines = ptr.To(options.TailLines)
+	}
+	if options.Since > 0 {
+		logOptions.SinceSeconds = ptr.To(int64(options.Since.Seconds()))
+	}
+	var ret strings.Builder
+	for _, pod := range podList.Items {
+		rawData, err := pods.GetLogs(pod.Name, logOptions).Do(ctx).Raw()
+		if err != nil {
+			_, _ = fmt.Fprintf(&ret, "[%s] failed to get logs: %v\n", pod.Name, err)
+			continue
+		}
+		logs := strings.TrimSuffix(string(rawData), "\n")
+		if logs == "" {
+			continue
+		}
+		for _, line := range strings.Split(logs, "\n") {
+			_, _ = fmt.Fprintf(&ret, "[%s] %s\n", pod.Name, line)
+		}
+	}
+	return ret.String(), nil
+}
+
+// workloadGVK returns the GroupVersionKind of the provided workload kind (see WorkloadKinds)
+func workloadGVK(kind string) (*schema.GroupVersionKind, error) {
+	switch kind {
+	case "Deployment", "StatefulSet", "DaemonSet":
+		return &schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: kind}, nil
+	case "Job":
+		return &schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: kind}, nil
+	}
+	return nil, fmt.Errorf("unsupported workload kind %s, supported kinds are: %s", kind, strings.Join(WorkloadKinds, ", "))
+}
+
+// PodDisruptionBudgetWorkloadKinds are the kinds of the workloads supported by WorkloadsPodDisruptionBudgets
+var PodDisruptionBudgetWorkloadKinds = []string{"Deployment", "StatefulSet"}
+
+// WorkloadsPodDisruptionBudgets reports the PodDisruptionBudgets whose selector matches the Pods of the provided
+// workload (Deployment or StatefulSet): their minAvailable/maxUnavailable, current healthy Pods, and disruptions allowed.
+// The workload is reported as Blocking when its Pods can't be evicted right now (no disruptions allowed, or more than
+// one PodDisruptionBudget which the Eviction API rejects), meaning that node drains would be stuck on them.
+func (k *Kubernetes) WorkloadsPodDisruptionBudgets(ctx context.Context, kind, namespace, name string) (map[string]any, error) {
+	if !slices.Contains(PodDisruptionBudgetWorkloadKinds, kind) {
+		return nil, fmt.Errorf("unsupported workload kind %s, supported kinds are: %s", kind, strings.Join(PodDisruptionBudgetWorkloadKinds, ", "))
+	}
+	namespace = k.NamespaceOrDefault(namespace)
+	workload, err := k.ResourcesGet(ctx, &schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: kind}, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	podLabels, _, _ := unstructured.NestedStringMap(workload.Object, "spec", "template", "metadata", "labels")
+	budgets, err := k.ResourcesList(ctx, &schema.GroupVersionKind{
+		Group: "policy", Version: "v1", Kind: "PodDisruptionBudget",
+	}, namespace, ResourceListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	ret := map[string]any{"Kind": kind, "Namespace": namespace, "Name": name}
+	if replicas, found, _ := unstructured.NestedInt64(workload.Object, "spec", "replicas"); found {
+		ret["Replicas"] = replicas
+	}
+	matching := make([]map[string]any, 0)
+	blocking := false
+	for _, item := range budgets.(*unstructured.UnstructuredList).Items {
+		budget := &policyv1.PodDisruptionBudget{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, budget); err != nil {
+			return nil, err
+		}
+		selector, err := metav1.LabelSelectorAsSelector(budget.Spec.Selector)
+		if err != nil || !selector.Matches(labels.Set(podLabels)) {
+			continue
+		}
+		current := map[string]any{
+			"Name":               budget.Name,
+			"CurrentHealthy":     budget.Status.CurrentHealthy,
+			"DesiredHealthy":     budget.Status.DesiredHealthy,
+			"ExpectedPods":       budget.Status.ExpectedPods,
+			"DisruptionsAllowed": budget.Status.DisruptionsAllowed,
+		}
+		if budget.Spec.MinAvailable != nil {
+			current["MinAvailable"] = budget.Spec.MinAvailable.String()
+		}
+		if budget.Spec.MaxUnavailable != nil {
+			current["MaxUnavailable"] = budget.Spec.MaxUnavailable.String()
+		}
+		if budget.Status.DisruptionsAllowed <= 0 {
+			blocking = true
+		}
+		matching = append(matching, current)
+	}
+	ret["PodDisruptionBudgets"] = matching
+	switch {
+	case len(matching) == 0:
+		ret["Explanation"] = fmt.Sprintf("No PodDisruptionBudget covers the Pods of %s %s, evictions (e.g. node drains) are not restricted and may evict all of its Pods at once", kind, name)
+	case len(matching) > 1:
+		blocking = true
+		ret["Explanation"] = fmt.Sprintf("The Pods of %s %s are covered by more than one PodDisruptionBudget, the Eviction API rejects their evictions so node drains will be blocked", kind, name)
+	case blocking:
+		ret["Explanation"] = fmt.Sprintf("PodDisruptionBudget %s allows no disruptions, evictions of the Pods of %s %s (e.g. node drains) will be blocked until more Pods are healthy or the budget is relaxed", matching[0]["Name"], kind, name)
+	default:
+		ret["Explanation"] = fmt.Sprintf("PodDisruptionBudget %s allows %d disruption(s), evictions of the Pods of %s %s beyond that will be blocked until the evicted Pods are replaced", matching[0]["Name"], matching[0]["DisruptionsAllowed"], kind, name)
+	}
+	ret["Blocking"] = blocking
+	return ret, nil
+}
+
+// resourceAuditWorkloads are the kinds of the workloads scanned by WorkloadsResourceAudit along with the path of their
+// Pod template
+var resourceAuditWorkloads = []struct {
+	gvk          schema.GroupVersionKind
+	templatePath []string
+}{
+	{schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, []string{"spec", "template"}},
+	{schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "StatefulSet"}, []string{"spec", "template"}},
+	{schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "DaemonSet"}, []string{"spec", "template"}},
+	{schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "CronJob"}, []string{"spec", "jobTemplate", "spec", "template"}},
+}
+
+// WorkloadsResourceAudit scans the Pod templates of the workloads (Deployments, StatefulSets, DaemonSets, and CronJobs)
+// in the provided namespace and reports the containers with misconfigured resources: no requests nor limits, missing
+// CPU or memory requests, missing memory limit, memory limit without request, or requests exceeding limits.
+// These commonly cause OOM kills, evictions under node pressure, and poor scheduling decisions.
+// Kinds not served by the cluster are skipped, kinds that can't be listed (Forbidden or transient errors) are skipped
+// and reported as warnings.
+func (k *Kubernetes) WorkloadsResourceAudit(ctx context.Context, namespace string) ([]map[string]any, []ScanWarning, error) {
+	namespace = k.NamespaceOrDefault(namespace)
+	ret := make([]map[string]any, 0)
+	var skipped []ScanWarning
+	for _, workload := range resourceAuditWorkloads {
+		if _, err := k.resourceFor(&workload.gvk); err != nil {
+			continue
+		}
+		items, _, err := k.resourcesScan(ctx, &workload.gvk, namespace, metav1.ListOptions{})
+		switch {
+		case err == nil:
+		case apierrors.IsForbidden(err):
+			skipped = append(skipped, ScanWarning{Namespace: namespace, Reason: "cannot list " + workload.gvk.Kind + "s (forbidden)"})
+			continue
+		case isTransientError(err):
+			skipped = append(skipped, ScanWarning{Namespace: namespace, Reason: "cannot list " + workload.gvk.Kind + "s: " + err.Error()})
+			continue
+		default:
+			return nil, nil, err
+		}
+		sort.Slice(items, func(i, j int) bool {
+			return items[i].GetName() < items[j].GetName()
+		})
+		for _, item := range items {
+			rawTemplate, _, _ := unstructured.NestedMap(item.Object, workload.templatePath...)
+			template := &v1.PodTemplateSpec{}
+			if err = runtime.DefaultUnstructuredConverter.FromUnstructured(rawTemplate, template); err != nil {
+				return nil, nil, err
+			}
+			containers := slices.Concat(template.Spec.InitContainers, template.Spec.Containers)
+			for _, container := range containers {
+				issues := containerResourceIssues(container.Resources)
+				if len(issues) == 0 {
+					continue
+				}
+				ret = append(ret, map[string]any{
+					"Kind":      workload.gvk.Kind,
+					"Name":      item.GetName(),
+					"Container": container.Name,
+					"Requests":  resourceListString(container.Resources.Requests),
+					"Limits":    resourceListString(container.Resources.Limits),
+					"Issues":    issues,
+				})
+			}
+		}
+	}
+	return ret, skipped, nil
+}
+
+// containerResourceIssues returns the advisories for the provided container resource requirements
+func containerResourceIssues(resources v1.ResourceRequirements) []string {
+	if len(resources.Requests) == 0 && len(resources.Limits) == 0 {
+		return []string{"no resource requests nor limits (BestEffort), the container is the first to be evicted under node pressure and is scheduled without accounting for its usage"}
+	}
+	var issues []string
+	if _, ok := resources.Requests[v1.ResourceCPU]; !ok {
+		if _, limited := resources.Limits[v1.ResourceCPU]; !limited {
+			issues = append(issues, "missing cpu request, the container is scheduled without accounting for its CPU usage")
+		}
+	}
+	_, memoryRequest := resources.Requests[v1.ResourceMemory]
+	_, memoryLimit := resources.Limits[v1.ResourceMemory]
+	switch {
+	case !memoryRequest && memoryLimit:
+		issues = append(issues, "memory limit without request, the request defaults to the limit which may reserve more memory than needed")
+	case !memoryRequest:
+		issues = append(issues, "missing memory request, the container is scheduled without accounting for its memory usage and is evicted first under memory pressure")
+	}
+	if !memoryLimit {
+		issues = append(issues, "missing memory limit, the container memory usage is unbounded and may exhaust the node memory")
+	}
+	for _, name := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+		request, requested := resources.Requests[name]
+		limit, limited := resources.Limits[name]
+		if requested && limited && request.Cmp(limit) > 0 {
+			issues = append(issues, fmt.Sprintf("%s request %s exceeds limit %s", name, request.String(), limit.String()))
+		}
+	}
+	return issues
+}
+
+// resourceListString formats the CPU and memory of the provided resource list (e.g. cpu=100m, memory=128Mi)
+func resourceListString(resources v1.ResourceList) string {
+	var values []string
+	for _, name := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+		if quantity, ok := resources[name]; ok {
+			values = append(values, fmt.Sprintf("%s=%s", name, quantity.String()))
+		}
+	}
+	if len(values) == 0 {
+		return "none"
+	}
+	return strings.Join(values, ", ")
+}
@@ -0,0 +1,122 @@
+package mcp
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type APIResourcesSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *APIResourcesSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(
+		metav1.APIResourceList{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", ShortNames: []string{"po"}, Kind: "Pod", Namespaced: true, Verbs: []string{"get", "list", "create", "delete"}},
+				{Name: "pods/log", Kind: "Pod", Namespaced: true, Verbs: []string{"get"}},
+				{Name: "nodes", ShortNames: []string{"no"}, Kind: "Node", Namespaced: false, Verbs: []string{"get", "list"}},
+				{Name: "secrets", Kind: "Secret", Namespaced: true, Verbs: []string{"get", "list"}},
+			},
+		},
+		metav1.APIResourceList{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "deployments", ShortNames: []string{"deploy"}, Kind: "Deployment", Namespaced: true, Verbs: []string{"get", "list", "patch"}},
+			},
+		},
+	))
+}
+
+func (s *APIResourcesSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *APIResourcesSuite) TestAPIResources() {
+	s.InitMcpClient()
+	s.Run("api_resources", func() {
+		toolResult, err := s.CallTool("api_resources", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		out := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns header", func() {
+			s.Regexp(regexp.MustCompile(`^NAME\s+SHORTNAMES\s+APIVERSION\s+NAMESPACED\s+KIND\s+VERBS\n`), out)
+		})
+		s.Run("returns namespaced core resources", func() {
+			s.Regexp(regexp.MustCompile(`(?m)^pods\s+po\s+v1\s+true\s+Pod\s+get,list,create,delete$`), out)
+		})
+		s.Run("returns cluster scoped core resources", func() {
+			s.Regexp(regexp.MustCompile(`(?m)^nodes\s+no\s+v1\s+false\s+Node\s+get,list$`), out)
+		})
+		s.Run("returns group resources", func() {
+			s.Regexp(regexp.MustCompile(`(?m)^deployments\s+deploy\s+apps/v1\s+true\s+Deployment\s+get,list,patch$`), out)
+		})
+		s.Run("omits subresources", func() {
+			s.NotContains(out, "pods/log")
+		})
+	})
+	s.Run("api_resources(group=apps)", func() {
+		toolResult, err := s.CallTool("api_resources", map[string]interface{}{"group": "apps"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		out := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns only resources in group", func() {
+			s.Contains(out, "deployments")
+			s.NotContains(out, "pods")
+			s.NotContains(out, "nodes")
+		})
+	})
+	s.Run("api_resources(group=core)", func() {
+		toolResult, err := s.CallTool("api_resources", map[string]interface{}{"group": "core"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		out := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns only core resources", func() {
+			s.Contains(out, "pods")
+			s.NotContains(out, "deployments")
+		})
+	})
+	s.Run("api_resources(group=non-existent)", func() {
+		toolResult, err := s.CallTool("api_resources", map[string]interface{}{"group": "non-existent.example.com"})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Equal("# No API resources found", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func (s *APIResourcesSuite) TestAPIResourcesDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { version = "v1", kind = "Secret" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("api_resources (denied)", func() {
+		toolResult, err := s.CallTool("api_resources", map[string]interface{}{})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed")
+		s.NotContains(toolResult.Content[0].(mcp.TextContent).Text, "secrets")
+	})
+}
+
+func TestAPIResources(t *testing.T) {
+	suite.Run(t, new(APIResourcesSuite))
+}
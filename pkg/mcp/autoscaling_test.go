@@ -0,0 +1,120 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type AutoscalingSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *AutoscalingSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "autoscaling/v2",
+		APIResources: []metav1.APIResource{
+			{Name: "horizontalpodautoscalers", Kind: "HorizontalPodAutoscaler", Namespaced: true, Verbs: []string{"get", "list"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/apis/autoscaling/v2/namespaces/ns-1/horizontalpodautoscalers":
+			_, _ = w.Write([]byte(`{"apiVersion": "autoscaling/v2", "kind": "HorizontalPodAutoscalerList", "items": [
+				{"apiVersion": "autoscaling/v2", "kind": "HorizontalPodAutoscaler", "metadata": {"name": "web", "namespace": "ns-1"},
+				 "spec": {"scaleTargetRef": {"apiVersion": "apps/v1", "kind": "Deployment", "name": "web"}, "minReplicas": 2, "maxReplicas": 5,
+				  "metrics": [
+				   {"type": "Resource", "resource": {"name": "cpu", "target": {"type": "Utilization", "averageUtilization": 50}}},
+				   {"type": "Pods", "pods": {"metric": {"name": "http_requests"}, "target": {"type": "AverageValue", "averageValue": "100"}}}
+				  ]},
+				 "status": {"currentReplicas": 5, "desiredReplicas": 5, "lastScaleTime": "2025-01-02T03:04:05Z",
+				  "currentMetrics": [
+				   {"type": "Pods", "pods": {"metric": {"name": "http_requests"}, "current": {"averageValue": "250"}}},
+				   {"type": "Resource", "resource": {"name": "cpu", "current": {"averageUtilization": 95, "averageValue": "950m"}}}
+				  ],
+				  "conditions": [
+				   {"type": "AbleToScale", "status": "True", "reason": "ReadyForNewScale", "message": "recommended size matches current size"},
+				   {"type": "ScalingActive", "status": "True", "reason": "ValidMetricFound", "message": "the HPA was able to successfully calculate a replica count from cpu resource utilization (percentage of request)"},
+				   {"type": "ScalingLimited", "status": "True", "reason": "TooManyReplicas", "message": "the desired replica count is more than the maximum replica count"}
+				  ]}}
+			]}`))
+		case "/apis/autoscaling/v2/namespaces/empty/horizontalpodautoscalers":
+			_, _ = w.Write([]byte(`{"apiVersion": "autoscaling/v2", "kind": "HorizontalPodAutoscalerList", "items": []}`))
+		}
+	}))
+}
+
+func (s *AutoscalingSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *AutoscalingSuite) TestHPAList() {
+	s.InitMcpClient()
+	s.Run("hpa_list(namespace=ns-1)", func() {
+		toolResult, err := s.CallTool("hpa_list", map[string]interface{}{"namespace": "ns-1"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+			s.Require().Len(decoded, 1)
+		})
+		s.Run("returns the target and replicas", func() {
+			s.Equal("Deployment/web", decoded[0]["ScaleTargetRef"])
+			s.Equal(float64(2), decoded[0]["MinReplicas"])
+			s.Equal(float64(5), decoded[0]["MaxReplicas"])
+			s.Equal(float64(5), decoded[0]["CurrentReplicas"])
+			s.Equal(float64(5), decoded[0]["DesiredReplicas"])
+			s.Equal("2025-01-02T03:04:05Z", decoded[0]["LastScaleTime"])
+		})
+		s.Run("returns the current metric values vs targets", func() {
+			s.Equal([]interface{}{
+				map[string]interface{}{"Type": "Resource", "Name": "cpu", "Target": "50% average utilization", "Current": "95% average utilization"},
+				map[string]interface{}{"Type": "Pods", "Name": "http_requests", "Target": "100 average value", "Current": "250 average value"},
+			}, decoded[0]["Metrics"])
+		})
+		s.Run("returns the ScalingLimited condition", func() {
+			conditions, ok := decoded[0]["Conditions"].([]interface{})
+			s.Require().Truef(ok, "expected conditions, got %v", decoded[0]["Conditions"])
+			s.Contains(conditions, map[string]interface{}{
+				"Type":    "ScalingLimited",
+				"Status":  "True",
+				"Reason":  "TooManyReplicas",
+				"Message": "the desired replica count is more than the maximum replica count",
+			})
+			s.Contains(conditions, map[string]interface{}{
+				"Type":    "AbleToScale",
+				"Status":  "True",
+				"Reason":  "ReadyForNewScale",
+				"Message": "recommended size matches current size",
+			})
+		})
+	})
+	s.Run("hpa_list(namespace=empty)", func() {
+		toolResult, err := s.CallTool("hpa_list", map[string]interface{}{"namespace": "empty"})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Equal("# No horizontal pod autoscalers found", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func TestAutoscaling(t *testing.T) {
+	suite.Run(t, new(AutoscalingSuite))
+}
@@ -0,0 +1,259 @@
+package mcp
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type BuildsSuite struct {
+	BaseMcpSuite
+	mockServer      *test.MockServer
+	buildRequests   map[string]*unstructured.Unstructured
+	buildLogQueries []string
+}
+
+func (s *BuildsSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.Cfg.Toolsets = []string{"builds"}
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.buildRequests = map[string]*unstructured.Unstructured{}
+	s.buildLogQueries = nil
+}
+
+func (s *BuildsSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *BuildsSuite) handleBuilds() {
+	s.mockServer.Handle(test.NewInOpenShiftDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "build.openshift.io/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "buildconfigs", Kind: "BuildConfig", Namespaced: true, Verbs: []string{"get", "list"}},
+			{Name: "buildconfigs/instantiate", Kind: "BuildRequest", Namespaced: true, Verbs: []string{"create"}},
+			{Name: "builds", Kind: "Build", Namespaced: true, Verbs: []string{"get", "list"}},
+			{Name: "builds/log", Kind: "BuildLog", Namespaced: true, Verbs: []string{"get"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/apis/build.openshift.io/v1/namespaces/ns-1/builds/bc-1-1/log":
+			s.buildLogQueries = append(s.buildLogQueries, req.URL.RawQuery)
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte("Cloning source...\nerror: build error: failed to push image\n"))
+		case "/apis/build.openshift.io/v1/namespaces/ns-1/builds/bc-1-2/log":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","message":"pods \"bc-1-2-build\" not found","reason":"NotFound","details":{"name":"bc-1-2-build","kind":"pods"},"code":404}`))
+		case "/apis/build.openshift.io/v1/namespaces/ns-1/buildconfigs":
+			test.WriteObject(w, &unstructured.UnstructuredList{
+				Object: map[string]interface{}{"apiVersion": "build.openshift.io/v1", "kind": "BuildConfigList"},
+				Items: []unstructured.Unstructured{
+					{Object: map[string]interface{}{
+						"apiVersion": "build.openshift.io/v1",
+						"kind":       "BuildConfig",
+						"metadata":   map[string]interface{}{"name": "bc-1", "namespace": "ns-1"},
+					}},
+				},
+			})
+		case "/apis/build.openshift.io/v1/namespaces/ns-1/buildconfigs/bc-1/instantiate":
+			if req.Method != http.MethodPost {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			buildRequest := &unstructured.Unstructured{}
+			if err = buildRequest.UnmarshalJSON(body); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			s.buildRequests["bc-1"] = buildRequest
+			test.WriteObject(w, &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "build.openshift.io/v1",
+				"kind":       "Build",
+				"metadata":   map[string]interface{}{"name": "bc-1-7", "namespace": "ns-1"},
+				"status":     map[string]interface{}{"phase": "Pending"},
+			}})
+		}
+	}))
+}
+
+func (s *BuildsSuite) TestBuildsNotInOpenShift() {
+	s.InitMcpClient()
+	s.Run("ListTools does not return builds tools", func() {
+		tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+		s.Require().NoError(err, "Expected no error from ListTools")
+		s.Empty(tools.Tools, "Expected no tools when not running in OpenShift")
+	})
+}
+
+func (s *BuildsSuite) TestBuildConfigsList() {
+	s.handleBuilds()
+	s.InitMcpClient()
+	s.Run("buildconfigs_list(namespace=ns-1)", func() {
+		toolResult, err := s.CallTool("buildconfigs_list", map[string]interface{}{
+			"namespace": "ns-1",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		var decoded []unstructured.Unstructured
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("returns build configs", func() {
+			s.Require().Len(decoded, 1)
+			s.Equal("bc-1", decoded[0].GetName())
+			s.Equal("BuildConfig", decoded[0].GetKind())
+		})
+	})
+}
+
+func (s *BuildsSuite) TestBuildsStart() {
+	s.handleBuilds()
+	s.InitMcpClient()
+	s.Run("builds_start(name=nil)", func() {
+		toolResult, err := s.CallTool("builds_start", map[string]interface{}{})
+		s.Require().NotNil(toolResult, "toolResult should not be nil")
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes missing name", func() {
+			s.Equal("failed to start build, missing argument name", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+	s.Run("builds_start(namespace=ns-1, name=not-found)", func() {
+		toolResult, _ := s.CallTool("builds_start", map[string]interface{}{
+			"namespace": "ns-1",
+			"name":      "not-found",
+		})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "failed to start build from build config not-found")
+		})
+	})
+	s.Run("builds_start(namespace=ns-1, name=bc-1)", func() {
+		toolResult, err := s.CallTool("builds_start", map[string]interface{}{
+			"namespace": "ns-1",
+			"name":      "bc-1",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		s.Run("instantiates the provided build config", func() {
+			s.Require().Contains(s.buildRequests, "bc-1")
+			s.Equal("BuildRequest", s.buildRequests["bc-1"].GetKind())
+			s.Equal("bc-1", s.buildRequests["bc-1"].GetName())
+		})
+		s.Run("returns created build name and phase", func() {
+			s.Equal("Build ns-1/bc-1-7 started from BuildConfig bc-1, phase: Pending", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+	s.Run("builds_start(namespace=ns-1, name=bc-1, commit=abc123, env={FOO: bar})", func() {
+		toolResult, err := s.CallTool("builds_start", map[string]interface{}{
+			"namespace": "ns-1",
+			"name":      "bc-1",
+			"commit":    "abc123",
+			"env":       map[string]interface{}{"FOO": "bar"},
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		s.Run("overrides commit", func() {
+			commit, _, _ := unstructured.NestedString(s.buildRequests["bc-1"].Object, "revision", "git", "commit")
+			s.Equal("abc123", commit)
+			revisionType, _, _ := unstructured.NestedString(s.buildRequests["bc-1"].Object, "revision", "type")
+			s.Equal("Git", revisionType)
+		})
+		s.Run("overrides env", func() {
+			env, _, _ := unstructured.NestedSlice(s.buildRequests["bc-1"].Object, "env")
+			s.Equal([]interface{}{map[string]interface{}{"name": "FOO", "value": "bar"}}, env)
+		})
+	})
+}
+
+func (s *BuildsSuite) TestBuildsLog() {
+	s.handleBuilds()
+	s.InitMcpClient()
+	s.Run("builds_log(name=nil)", func() {
+		toolResult, err := s.CallTool("builds_log", map[string]interface{}{})
+		s.Require().NotNil(toolResult, "toolResult should not be nil")
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes missing name", func() {
+			s.Equal("failed to get build log, missing argument name", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+	s.Run("builds_log(namespace=ns-1, name=bc-1-1)", func() {
+		toolResult, err := s.CallTool("builds_log", map[string]interface{}{
+			"namespace": "ns-1",
+			"name":      "bc-1-1",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		s.Run("returns build log", func() {
+			s.Equal("Cloning source...\nerror: build error: failed to push image\n", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+		s.Run("does not follow logs", func() {
+			s.Require().NotEmpty(s.buildLogQueries)
+			s.Equal("follow=false", s.buildLogQueries[len(s.buildLogQueries)-1])
+		})
+	})
+	s.Run("builds_log(namespace=ns-1, name=bc-1-1, tail_lines=10)", func() {
+		toolResult, err := s.CallTool("builds_log", map[string]interface{}{
+			"namespace":  "ns-1",
+			"name":       "bc-1-1",
+			"tail_lines": 10,
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		s.Run("sets tailLines", func() {
+			s.Require().NotEmpty(s.buildLogQueries)
+			s.Equal("follow=false&tailLines=10", s.buildLogQueries[len(s.buildLogQueries)-1])
+		})
+	})
+	s.Run("builds_log(namespace=ns-1, name=bc-1-2) with build pod no longer available", func() {
+		toolResult, _ := s.CallTool("builds_log", map[string]interface{}{
+			"namespace": "ns-1",
+			"name":      "bc-1-2",
+		})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+		})
+		s.Run("describes missing build pod", func() {
+			s.Contains(toolResult.Content[0].(mcp.TextContent).Text,
+				"failed to get build bc-1-2 log: the pod for build bc-1-2 no longer exists, its logs are no longer available")
+		})
+	})
+}
+
+func TestBuilds(t *testing.T) {
+	suite.Run(t, new(BuildsSuite))
+}
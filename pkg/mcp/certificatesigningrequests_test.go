@@ -0,0 +1,276 @@
+package mcp
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type CertificateSigningRequestsSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+	approved   map[string]*certificatesv1.CertificateSigningRequest
+}
+
+func (s *CertificateSigningRequestsSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.approved = map[string]*certificatesv1.CertificateSigningRequest{}
+	now := time.Now()
+	csr := func(name string, request []byte, signerName, username string, created time.Time, conditions ...certificatesv1.CertificateSigningRequestCondition) certificatesv1.CertificateSigningRequest {
+		usages := []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageKeyEncipherment, certificatesv1.UsageClientAuth}
+		if signerName == certificatesv1.KubeletServingSignerName {
+			usages = []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageKeyEncipherment, certificatesv1.UsageServerAuth}
+		}
+		return certificatesv1.CertificateSigningRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(created)},
+			Spec: certificatesv1.CertificateSigningRequestSpec{
+				Request:    request,
+				SignerName: signerName,
+				Username:   username,
+				Usages:     usages,
+			},
+			Status: certificatesv1.CertificateSigningRequestStatus{Conditions: conditions},
+		}
+	}
+	// Kubelet client certificate request, or serving certificate request if DNS names are provided
+	nodeRequest := func(node string, dnsNames ...string) []byte {
+		var ips []net.IP
+		if len(dnsNames) > 0 {
+			ips = []net.IP{net.ParseIP("10.0.0.1")}
+		}
+		return s.certificateRequest("system:node:"+node, "system:nodes", dnsNames, ips)
+	}
+	csrList := &certificatesv1.CertificateSigningRequestList{
+		TypeMeta: metav1.TypeMeta{APIVersion: "certificates.k8s.io/v1", Kind: "CertificateSigningRequestList"},
+		Items: []certificatesv1.CertificateSigningRequest{
+			csr("csr-serving-worker-1", nodeRequest("worker-1", "worker-1"), certificatesv1.KubeletServingSignerName, "system:node:worker-1", now.Add(-5*time.Minute)),
+			csr("csr-client-worker-1", nodeRequest("worker-1"), certificatesv1.KubeAPIServerClientKubeletSignerName,
+				"system:serviceaccount:openshift-machine-config-operator:node-bootstrapper", now.Add(-10*time.Minute)),
+			csr("csr-client-worker-2", nodeRequest("worker-2"), certificatesv1.KubeAPIServerClientKubeletSignerName,
+				"system:serviceaccount:openshift-machine-config-operator:node-bootstrapper", now.Add(-3*time.Minute)),
+			csr("csr-spoofed-worker-2", nodeRequest("worker-2", "worker-2"), certificatesv1.KubeletServingSignerName, "system:node:worker-3", now.Add(-2*time.Minute)),
+			csr("csr-user", s.certificateRequest("developer", "developers", nil, nil), certificatesv1.KubeAPIServerClientSignerName, "kube:admin", now.Add(-time.Minute)),
+			csr("csr-serving-worker-3", nodeRequest("worker-3", "worker-3", "evil.example.com"), certificatesv1.KubeletServingSignerName, "system:node:worker-3", now.Add(-50*time.Second)),
+			csr("csr-masters-worker-4", s.certificateRequest("system:node:worker-4", "system:masters", nil, nil), certificatesv1.KubeAPIServerClientKubeletSignerName,
+				"system:serviceaccount:openshift-machine-config-operator:node-bootstrapper", now.Add(-40*time.Second)),
+			csr("csr-user-worker-1", nodeRequest("worker-1"), certificatesv1.KubeAPIServerClientKubeletSignerName, "developer", now.Add(-30*time.Second)),
+			csr("csr-approved", nodeRequest("worker-0", "worker-0"), certificatesv1.KubeletServingSignerName, "system:node:worker-0", now.Add(-time.Hour),
+				certificatesv1.CertificateSigningRequestCondition{Type: certificatesv1.CertificateApproved, Status: "True"}),
+		},
+	}
+	// worker-1 and worker-3 joined the cluster, worker-2 and worker-4 are being provisioned (only worker-2 has a Machine)
+	nodes := map[string]*v1.Node{}
+	for _, name := range []string{"worker-1", "worker-3"} {
+		nodes[name] = &v1.Node{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Node"},
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: v1.NodeStatus{Addresses: []v1.NodeAddress{
+				{Type: v1.NodeInternalIP, Address: "10.0.0.1"}, {Type: v1.NodeHostName, Address: name},
+			}},
+		}
+	}
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "machine.openshift.io/v1beta1",
+		APIResources: []metav1.APIResource{
+			{Name: "machines", Kind: "Machine", Namespaced: true, Verbs: []string{"get", "list"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/apis/certificates.k8s.io/v1/certificatesigningrequests" && req.Method == http.MethodGet {
+			test.WriteObject(w, csrList)
+			return
+		}
+		if req.URL.Path == "/apis/machine.openshift.io/v1beta1/namespaces/openshift-machine-api/machines" {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "machine.openshift.io/v1beta1", "kind": "MachineList", "items": [
+				{"apiVersion": "machine.openshift.io/v1beta1", "kind": "Machine", "metadata": {"name": "cluster-worker-a", "namespace": "openshift-machine-api"},
+				 "status": {"addresses": [{"type": "InternalIP", "address": "10.0.0.2"}, {"type": "Hostname", "address": "worker-2"}]}}
+			]}`))
+			return
+		}
+		if nodeName, ok := strings.CutPrefix(req.URL.Path, "/api/v1/nodes/"); ok {
+			if node, found := nodes[nodeName]; found {
+				test.WriteObject(w, node)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Status", "status": "Failure", "reason": "NotFound", "code": 404}`))
+			return
+		}
+		name, ok := strings.CutPrefix(req.URL.Path, "/apis/certificates.k8s.io/v1/certificatesigningrequests/")
+		if !ok || !strings.HasSuffix(name, "/approval") || req.Method != http.MethodPut {
+			return
+		}
+		body, _ := io.ReadAll(req.Body)
+		// Typed clients may send protobuf encoded objects
+		obj, _, _ := scheme.Codecs.UniversalDeserializer().Decode(body, nil, &certificatesv1.CertificateSigningRequest{})
+		approved, _ := obj.(*certificatesv1.CertificateSigningRequest)
+		s.approved[strings.TrimSuffix(name, "/approval")] = approved
+		test.WriteObject(w, approved)
+	}))
+}
+
+func (s *CertificateSigningRequestsSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *CertificateSigningRequestsSuite) certificateRequest(commonName, organization string, dnsNames []string, ips []net.IP) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:     pkix.Name{CommonName: commonName, Organization: []string{organization}},
+		DNSNames:    dnsNames,
+		IPAddresses: ips,
+	}, key)
+	s.Require().NoError(err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})
+}
+
+func (s *CertificateSigningRequestsSuite) TestCsrList() {
+	s.InitMcpClient()
+	s.Run("csr_list", func() {
+		toolResult, err := s.CallTool("csr_list", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("returns pending CSRs oldest first", func() {
+			s.Require().Len(decoded, 8)
+			names := make([]interface{}, 0, len(decoded))
+			for _, csr := range decoded {
+				names = append(names, csr["Name"])
+			}
+			s.Equal([]interface{}{"csr-client-worker-1", "csr-serving-worker-1", "csr-client-worker-2", "csr-spoofed-worker-2", "csr-user",
+				"csr-serving-worker-3", "csr-masters-worker-4", "csr-user-worker-1"}, names)
+		})
+		s.Run("returns requestor, signer name, node, and age", func() {
+			s.Equal(map[string]interface{}{
+				"Name":       "csr-client-worker-1",
+				"Requestor":  "system:serviceaccount:openshift-machine-config-operator:node-bootstrapper",
+				"SignerName": "kubernetes.io/kube-apiserver-client-kubelet",
+				"Node":       "worker-1",
+				"Status":     "Pending",
+				"Age":        "10m",
+			}, decoded[0])
+			s.NotContains(decoded[4], "Node")
+		})
+	})
+}
+
+func (s *CertificateSigningRequestsSuite) TestCsrApprove() {
+	s.InitMcpClient()
+	s.Run("csr_approve(node=worker-1)", func() {
+		toolResult, err := s.CallTool("csr_approve", map[string]interface{}{"node": "worker-1"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		s.Run("approves the node CSRs", func() {
+			s.Len(s.approved, 2)
+			s.Contains(s.approved, "csr-client-worker-1")
+			s.Contains(s.approved, "csr-serving-worker-1")
+		})
+		s.Run("updates the approval condition", func() {
+			conditions := s.approved["csr-serving-worker-1"].Status.Conditions
+			s.Require().Len(conditions, 1)
+			s.Equal(certificatesv1.CertificateApproved, conditions[0].Type)
+			s.Equal("True", string(conditions[0].Status))
+		})
+		s.Run("returns the approved CSRs", func() {
+			var decoded []map[string]interface{}
+			s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded))
+			s.Require().Len(decoded, 2)
+			s.Equal("Approved", decoded[0]["Status"])
+		})
+	})
+	s.Run("csr_approve(node=worker-2) approves the client CSR of a node with a Machine only", func() {
+		s.approved = map[string]*certificatesv1.CertificateSigningRequest{}
+		toolResult, err := s.CallTool("csr_approve", map[string]interface{}{"node": "worker-2"})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Len(s.approved, 1)
+		s.Contains(s.approved, "csr-client-worker-2")
+		s.NotContains(s.approved, "csr-spoofed-worker-2")
+	})
+	s.Run("csr_approve() without name nor node returns error", func() {
+		s.approved = map[string]*certificatesv1.CertificateSigningRequest{}
+		toolResult, _ := s.CallTool("csr_approve", map[string]interface{}{})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to approve certificate signing requests, missing argument name or node", toolResult.Content[0].(mcp.TextContent).Text)
+		s.Empty(s.approved)
+	})
+	s.Run("csr_approve(name=csr-user) does not approve CSRs other than the kubelet ones", func() {
+		s.approved = map[string]*certificatesv1.CertificateSigningRequest{}
+		toolResult, _ := s.CallTool("csr_approve", map[string]interface{}{"name": "csr-user"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to approve certificate signing requests: CSR csr-user is not a kubelet client or serving certificate request of a node",
+			toolResult.Content[0].(mcp.TextContent).Text)
+		s.Empty(s.approved)
+	})
+	s.Run("csr_approve(node=worker-3) does not approve a serving CSR with names that are not addresses of the Node", func() {
+		s.approved = map[string]*certificatesv1.CertificateSigningRequest{}
+		toolResult, _ := s.CallTool("csr_approve", map[string]interface{}{"node": "worker-3"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to approve certificate signing requests: CSR csr-serving-worker-3 can't be approved: DNS name evil.example.com is not an address of node worker-3",
+			toolResult.Content[0].(mcp.TextContent).Text)
+		s.Empty(s.approved)
+	})
+	s.Run("csr_approve(name=csr-masters-worker-4) does not approve a CSR with an organization other than system:nodes", func() {
+		s.approved = map[string]*certificatesv1.CertificateSigningRequest{}
+		toolResult, _ := s.CallTool("csr_approve", map[string]interface{}{"name": "csr-masters-worker-4"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to approve certificate signing requests: CSR csr-masters-worker-4 can't be approved: organization [system:masters], expected system:nodes",
+			toolResult.Content[0].(mcp.TextContent).Text)
+		s.Empty(s.approved)
+	})
+	s.Run("csr_approve(name=csr-user-worker-1) does not approve a kubelet CSR requested by a regular user", func() {
+		s.approved = map[string]*certificatesv1.CertificateSigningRequest{}
+		toolResult, _ := s.CallTool("csr_approve", map[string]interface{}{"name": "csr-user-worker-1"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to approve certificate signing requests: CSR csr-user-worker-1 can't be approved: requested by developer, not by the node-bootstrapper service account or node worker-1",
+			toolResult.Content[0].(mcp.TextContent).Text)
+		s.Empty(s.approved)
+	})
+	s.Run("csr_approve(node=worker-9) with no matching CSRs", func() {
+		s.approved = map[string]*certificatesv1.CertificateSigningRequest{}
+		toolResult, err := s.CallTool("csr_approve", map[string]interface{}{"node": "worker-9"})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Equal("# No matching pending certificate signing requests found", toolResult.Content[0].(mcp.TextContent).Text)
+		s.Empty(s.approved)
+	})
+}
+
+func TestCertificateSigningRequests(t *testing.T) {
+	suite.Run(t, new(CertificateSigningRequestsSuite))
+}
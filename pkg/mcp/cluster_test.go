@@ -0,0 +1,634 @@
+package mcp
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type ClusterSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *ClusterSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(test.NewInOpenShiftDiscoveryClientHandler(
+		metav1.APIResourceList{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"get", "list"}},
+			},
+		},
+		metav1.APIResourceList{
+			GroupVersion: "route.openshift.io/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "routes", Kind: "Route", Namespaced: true, Verbs: []string{"get", "list"}},
+			},
+		},
+		metav1.APIResourceList{
+			GroupVersion: "config.openshift.io/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "clusteroperators", Kind: "ClusterOperator", Namespaced: false, Verbs: []string{"get", "list"}},
+				{Name: "clusterversions", Kind: "ClusterVersion", Namespaced: false, Verbs: []string{"get", "list"}},
+				{Name: "infrastructures", Kind: "Infrastructure", Namespaced: false, Verbs: []string{"get", "list"}},
+				{Name: "images", Kind: "Image", Namespaced: false, Verbs: []string{"get", "list"}},
+				{Name: "imagedigestmirrorsets", Kind: "ImageDigestMirrorSet", Namespaced: false, Verbs: []string{"get", "list"}},
+			},
+		},
+		metav1.APIResourceList{
+			GroupVersion: "operator.openshift.io/v1alpha1",
+			APIResources: []metav1.APIResource{
+				{Name: "imagecontentsourcepolicies", Kind: "ImageContentSourcePolicy", Namespaced: false, Verbs: []string{"get", "list"}},
+			},
+		},
+	))
+}
+
+func (s *ClusterSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *ClusterSuite) TestClusterInfo() {
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/apis/route.openshift.io/v1/namespaces/openshift-console/routes/console":
+			test.WriteObject(w, &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "route.openshift.io/v1",
+				"kind":       "Route",
+				"metadata":   map[string]interface{}{"name": "console", "namespace": "openshift-console"},
+				"spec":       map[string]interface{}{"host": "console-openshift-console.apps.example.com"},
+			}})
+		case "/apis/config.openshift.io/v1/clusterversions/version":
+			test.WriteObject(w, &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "config.openshift.io/v1",
+				"kind":       "ClusterVersion",
+				"metadata":   map[string]interface{}{"name": "version"},
+				"spec":       map[string]interface{}{"clusterID": "3a0f0e4f-1c4e-4b5e-9d0a-0123456789ab"},
+				"status":     map[string]interface{}{"desired": map[string]interface{}{"version": "4.19.3"}},
+			}})
+		case "/apis/config.openshift.io/v1/infrastructures/cluster":
+			test.WriteObject(w, &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "config.openshift.io/v1",
+				"kind":       "Infrastructure",
+				"metadata":   map[string]interface{}{"name": "cluster"},
+				"status": map[string]interface{}{
+					"apiServerURL":   "https://api.example.com:6443",
+					"platformStatus": map[string]interface{}{"type": "AWS"},
+				},
+			}})
+		}
+	}))
+	s.InitMcpClient()
+	s.Run("cluster_info", func() {
+		toolResult, err := s.CallTool("cluster_info", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		var decoded map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("returns assembled info", func() {
+			s.Equal(map[string]interface{}{
+				"APIServerURL": "https://api.example.com:6443",
+				"ClusterID":    "3a0f0e4f-1c4e-4b5e-9d0a-0123456789ab",
+				"ConsoleURL":   "https://console-openshift-console.apps.example.com",
+				"Platform":     "AWS",
+				"Version":      "4.19.3",
+			}, decoded)
+		})
+	})
+}
+
+func (s *ClusterSuite) TestClusterInfoUnavailable() {
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/apis/route.openshift.io/v1/namespaces/openshift-console/routes/console",
+			"/apis/config.openshift.io/v1/clusterversions/version",
+			"/apis/config.openshift.io/v1/infrastructures/cluster":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","message":"forbidden","reason":"Forbidden","code":403}`))
+		}
+	}))
+	s.InitMcpClient()
+	s.Run("cluster_info with forbidden config objects", func() {
+		toolResult, err := s.CallTool("cluster_info", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		var decoded map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("falls back to the configured API server URL", func() {
+			s.Equal(s.mockServer.Config().Host, decoded["APIServerURL"])
+		})
+		s.Run("reports unavailable information", func() {
+			s.Equal([]interface{}{"ConsoleURL: forbidden", "ClusterID: forbidden", "Platform: forbidden"}, decoded["Unavailable"])
+		})
+	})
+}
+
+func (s *ClusterSuite) TestPullSecretStatus() {
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/namespaces/openshift-config/secrets/pull-secret" {
+			return
+		}
+		dockerConfig := `{"auths": {
+			"cloud.openshift.com": {"auth": "b3BlbnNoaWZ0OnRvcC1zZWNyZXQ=", "email": "user@example.com"},
+			"quay.io": {"auth": "cXVheTpxdWF5LXNlY3JldA=="},
+			"registry.redhat.io": {"auth": "cmg6cmgtc2VjcmV0"},
+			"https://index.docker.io/v1/": {"username": "docker", "password": "docker-secret"}
+		}}`
+		test.WriteObject(w, &corev1.Secret{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{Name: "pull-secret", Namespace: "openshift-config"},
+			Type:       corev1.SecretTypeDockerConfigJson,
+			Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(dockerConfig)},
+		})
+	}))
+	s.InitMcpClient()
+	s.Run("pullsecret_status", func() {
+		toolResult, err := s.CallTool("pullsecret_status", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		var decoded map[string]interface{}
+		err = yaml.Unmarshal([]byte(text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("lists registry hostnames", func() {
+			s.Equal([]interface{}{"cloud.openshift.com", "index.docker.io", "quay.io", "registry.redhat.io"}, decoded["Registries"])
+		})
+		s.Run("reports Red Hat registries", func() {
+			s.Equal(map[string]interface{}{"registry.redhat.io": true, "registry.connect.redhat.com": false}, decoded["RedHatRegistries"])
+			s.Equal([]interface{}{"registry.connect.redhat.com"}, decoded["MissingRedHatRegistries"])
+		})
+		s.Run("redacts credentials", func() {
+			for _, secret := range []string{"b3BlbnNoaWZ0OnRvcC1zZWNyZXQ=", "cXVheTpxdWF5LXNlY3JldA==", "cmg6cmgtc2VjcmV0", "docker-secret", "user@example.com"} {
+				s.NotContains(text, secret)
+			}
+		})
+	})
+}
+
+func (s *ClusterSuite) TestImageConfig() {
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/apis/config.openshift.io/v1/images/cluster":
+			_, _ = w.Write([]byte(`{"apiVersion": "config.openshift.io/v1", "kind": "Image", "metadata": {"name": "cluster"},
+				"spec": {"registrySources": {"allowedRegistries": ["quay.io", "registry.redhat.io", "mirror.example.com:5000"], "insecureRegistries": ["mirror.example.com:5000"]}},
+				"status": {"internalRegistryHostname": "image-registry.openshift-image-registry.svc:5000"}}`))
+		case "/apis/config.openshift.io/v1/imagedigestmirrorsets":
+			_, _ = w.Write([]byte(`{"apiVersion": "config.openshift.io/v1", "kind": "ImageDigestMirrorSetList", "items": [
+				{"metadata": {"name": "redhat-mirrors"}, "spec": {"imageDigestMirrors": [
+					{"source": "registry.redhat.io", "mirrors": ["mirror.example.com:5000/redhat"], "mirrorSourcePolicy": "NeverContactSource"}
+				]}}
+			]}`))
+		case "/apis/operator.openshift.io/v1alpha1/imagecontentsourcepolicies":
+			_, _ = w.Write([]byte(`{"apiVersion": "operator.openshift.io/v1alpha1", "kind": "ImageContentSourcePolicyList", "items": [
+				{"metadata": {"name": "quay-mirrors"}, "spec": {"repositoryDigestMirrors": [
+					{"source": "quay.io/openshift-release-dev", "mirrors": ["mirror.example.com:5000/ocp", "backup.example.com/ocp"]}
+				]}}
+			]}`))
+		}
+	}))
+	s.InitMcpClient()
+	s.Run("image_config", func() {
+		toolResult, err := s.CallTool("image_config", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		var decoded map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("reports the registry sources", func() {
+			s.Equal([]interface{}{"quay.io", "registry.redhat.io", "mirror.example.com:5000"}, decoded["AllowedRegistries"])
+			s.Equal([]interface{}{"mirror.example.com:5000"}, decoded["InsecureRegistries"])
+			s.NotContains(decoded, "BlockedRegistries")
+			s.Equal("image-registry.openshift-image-registry.svc:5000", decoded["InternalRegistryHostname"])
+		})
+		s.Run("reports the mirrors", func() {
+			s.Equal([]interface{}{
+				map[string]interface{}{
+					"Origin":  "ImageContentSourcePolicy/quay-mirrors",
+					"Source":  "quay.io/openshift-release-dev",
+					"Mirrors": []interface{}{"mirror.example.com:5000/ocp", "backup.example.com/ocp"},
+				},
+				map[string]interface{}{
+					"Origin":             "ImageDigestMirrorSet/redhat-mirrors",
+					"Source":             "registry.redhat.io",
+					"Mirrors":            []interface{}{"mirror.example.com:5000/redhat"},
+					"MirrorSourcePolicy": "NeverContactSource",
+				},
+			}, decoded["Mirrors"])
+			s.NotContains(decoded, "Unavailable")
+		})
+	})
+}
+
+func (s *ClusterSuite) TestClusterOperatorsDiagnose() {
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/apis/config.openshift.io/v1/clusteroperators":
+			_, _ = w.Write([]byte(`{"apiVersion": "config.openshift.io/v1", "kind": "ClusterOperatorList", "items": [
+				{"apiVersion": "config.openshift.io/v1", "kind": "ClusterOperator", "metadata": {"name": "dns"},
+				 "status": {"conditions": [
+				  {"type": "Available", "status": "True"}, {"type": "Progressing", "status": "False"}, {"type": "Degraded", "status": "False"}
+				 ]}},
+				{"apiVersion": "config.openshift.io/v1", "kind": "ClusterOperator", "metadata": {"name": "authentication"},
+				 "status": {"conditions": [
+				  {"type": "Available", "status": "False"}, {"type": "Progressing", "status": "False"},
+				  {"type": "Degraded", "status": "True", "reason": "OAuthServerDeployment_UnavailablePod", "lastTransitionTime": "2025-01-02T03:04:05Z",
+				   "message": "OAuthServerDeploymentDegraded: 1 of 3 requested instances are unavailable for oauth-openshift.openshift-authentication ()"}
+				 ],
+				 "relatedObjects": [
+				  {"group": "", "resource": "namespaces", "name": "openshift-authentication"},
+				  {"group": "apps", "resource": "deployments", "namespace": "openshift-authentication-operator", "name": "authentication-operator"},
+				  {"group": "config.openshift.io", "resource": "oauths", "name": "cluster"}
+				 ]}}
+			]}`))
+		case "/api/v1/namespaces/openshift-authentication/pods":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "PodList", "items": [
+				{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "oauth-openshift-1", "namespace": "openshift-authentication"},
+				 "status": {"phase": "Running", "containerStatuses": [{"name": "oauth-openshift", "restartCount": 12,
+				  "state": {"waiting": {"reason": "CrashLoopBackOff"}}}]}},
+				{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "oauth-openshift-2", "namespace": "openshift-authentication"},
+				 "status": {"phase": "Running", "containerStatuses": [{"name": "oauth-openshift", "state": {"running": {}}}]}}
+			]}`))
+		case "/api/v1/namespaces/openshift-authentication/events":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "EventList", "items": [
+				{"metadata": {"name": "oauth-openshift-1.1", "namespace": "openshift-authentication"},
+				 "involvedObject": {"apiVersion": "v1", "kind": "Pod", "name": "oauth-openshift-1"},
+				 "type": "Warning", "reason": "BackOff", "count": 42, "message": "Back-off restarting failed container oauth-openshift"}
+			]}`))
+		case "/api/v1/namespaces/openshift-authentication-operator/pods":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "PodList", "items": []}`))
+		case "/api/v1/namespaces/openshift-authentication-operator/events":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "EventList", "items": []}`))
+		}
+	}))
+	s.InitMcpClient()
+	s.Run("clusteroperators_diagnose", func() {
+		toolResult, err := s.CallTool("clusteroperators_diagnose", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Require().Len(decoded, 1, "only degraded operators should be reported")
+		s.Run("reports the full Degraded condition", func() {
+			s.Equal("authentication", decoded[0]["Name"])
+			s.Equal("OAuthServerDeployment_UnavailablePod", decoded[0]["Reason"])
+			s.Equal("OAuthServerDeploymentDegraded: 1 of 3 requested instances are unavailable for oauth-openshift.openshift-authentication ()", decoded[0]["Message"])
+			s.Equal("False", decoded[0]["Available"])
+		})
+		s.Run("derives namespaces from relatedObjects", func() {
+			s.Equal([]interface{}{"openshift-authentication", "openshift-authentication-operator"}, decoded[0]["Namespaces"])
+		})
+		s.Run("correlates crash-looping pods", func() {
+			s.Equal([]interface{}{"openshift-authentication/oauth-openshift-1: CrashLoopBackOff"}, decoded[0]["FailingPods"])
+		})
+		s.Run("correlates warning events", func() {
+			s.Equal([]interface{}{map[string]interface{}{
+				"Namespace": "openshift-authentication",
+				"Count":     float64(42),
+				"Events": []interface{}{map[string]interface{}{
+					"Count":          float64(42),
+					"Reason":         "BackOff",
+					"InvolvedObject": map[string]interface{}{"apiVersion": "v1", "Kind": "Pod", "Name": "oauth-openshift-1"},
+					"Message":        "Back-off restarting failed container oauth-openshift",
+				}},
+			}}, decoded[0]["WarningEvents"])
+		})
+	})
+}
+
+func (s *ClusterSuite) TestControlPlaneHealth() {
+	healthyOperator := func(name string) string {
+		return `{"apiVersion": "config.openshift.io/v1", "kind": "ClusterOperator", "metadata": {"name": "` + name + `"},
+			"status": {"conditions": [
+			 {"type": "Available", "status": "True"}, {"type": "Progressing", "status": "False"}, {"type": "Degraded", "status": "False"}
+			]}}`
+	}
+	readyPod := func(name, namespace string) string {
+		return `{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "` + name + `", "namespace": "` + namespace + `"},
+			"status": {"phase": "Running", "conditions": [{"type": "Ready", "status": "True"}]}}`
+	}
+	labelSelectors := map[string]string{}
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/apis/config.openshift.io/v1/clusteroperators/etcd":
+			_, _ = w.Write([]byte(healthyOperator("etcd")))
+		case "/apis/config.openshift.io/v1/clusteroperators/kube-apiserver":
+			_, _ = w.Write([]byte(healthyOperator("kube-apiserver")))
+		case "/apis/config.openshift.io/v1/clusteroperators/kube-controller-manager":
+			_, _ = w.Write([]byte(`{"apiVersion": "config.openshift.io/v1", "kind": "ClusterOperator", "metadata": {"name": "kube-controller-manager"},
+				"status": {"conditions": [
+				 {"type": "Available", "status": "True"}, {"type": "Progressing", "status": "False"},
+				 {"type": "Degraded", "status": "True", "reason": "StaticPods_Error",
+				  "message": "StaticPodsDegraded: pod/kube-controller-manager-master-1 container \"kube-controller-manager\" is waiting: CrashLoopBackOff"}
+				]}}`))
+		case "/api/v1/namespaces/openshift-etcd/pods":
+			labelSelectors["openshift-etcd"] = req.URL.Query().Get("labelSelector")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "PodList", "items": [` +
+				readyPod("etcd-master-0", "openshift-etcd") + `,` + readyPod("etcd-master-1", "openshift-etcd") + `]}`))
+		case "/api/v1/namespaces/openshift-kube-apiserver/pods":
+			labelSelectors["openshift-kube-apiserver"] = req.URL.Query().Get("labelSelector")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "PodList", "items": [` +
+				readyPod("kube-apiserver-master-0", "openshift-kube-apiserver") + `,` + readyPod("kube-apiserver-master-1", "openshift-kube-apiserver") + `]}`))
+		case "/api/v1/namespaces/openshift-kube-controller-manager/pods":
+			labelSelectors["openshift-kube-controller-manager"] = req.URL.Query().Get("labelSelector")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "PodList", "items": [` +
+				readyPod("kube-controller-manager-master-0", "openshift-kube-controller-manager") + `,
+				{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "kube-controller-manager-master-1", "namespace": "openshift-kube-controller-manager"},
+				 "status": {"phase": "Running", "conditions": [{"type": "Ready", "status": "False", "reason": "ContainersNotReady"}],
+				  "containerStatuses": [{"name": "kube-controller-manager", "restartCount": 7, "state": {"waiting": {"reason": "CrashLoopBackOff"}}}]}}
+			]}`))
+		}
+	}))
+	s.InitMcpClient()
+	s.Run("controlplane_health", func() {
+		toolResult, err := s.CallTool("controlplane_health", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("reports the control plane as unhealthy", func() {
+			s.Equal(false, decoded["Healthy"])
+		})
+		components, _ := decoded["Components"].([]interface{})
+		s.Require().Len(components, 3)
+		s.Run("reports healthy components", func() {
+			for i, name := range []string{"etcd", "kube-apiserver"} {
+				component := components[i].(map[string]interface{})
+				s.Equal(name, component["Name"])
+				s.Equal(true, component["Healthy"])
+				s.Equal("True", component["Available"])
+				s.Equal("False", component["Degraded"])
+				s.Equal("2/2", component["ReadyPods"])
+				s.NotContains(component, "NotReadyPods")
+			}
+		})
+		s.Run("reports degraded component", func() {
+			component := components[2].(map[string]interface{})
+			s.Equal("kube-controller-manager", component["Name"])
+			s.Equal("openshift-kube-controller-manager", component["Namespace"])
+			s.Equal(false, component["Healthy"])
+			s.Equal("True", component["Degraded"])
+			s.Equal("StaticPods_Error", component["DegradedReason"])
+			s.Contains(component["DegradedMessage"], "StaticPodsDegraded")
+			s.Equal("1/2", component["ReadyPods"])
+			s.Equal([]interface{}{"kube-controller-manager-master-1: CrashLoopBackOff"}, component["NotReadyPods"])
+		})
+		s.Run("lists static pods by label", func() {
+			s.Equal(map[string]string{
+				"openshift-etcd":                    "etcd=true",
+				"openshift-kube-apiserver":          "apiserver=true",
+				"openshift-kube-controller-manager": "kube-controller-manager=true",
+			}, labelSelectors)
+		})
+	})
+}
+
+func (s *ClusterSuite) certificate(commonName string, notAfter time.Time) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err, "Expected no error generating key")
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	s.Require().NoError(err, "Expected no error creating certificate")
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func (s *ClusterSuite) TestCertificatesExpiring() {
+	soon := time.Now().Add(5*24*time.Hour + time.Hour).UTC().Truncate(time.Second)
+	later := time.Now().Add(365 * 24 * time.Hour).UTC().Truncate(time.Second)
+	servingCert := base64.StdEncoding.EncodeToString([]byte(s.certificate("localhost", soon)))
+	caBundle := strings.ReplaceAll(s.certificate("kube-apiserver-lb-signer", later)+s.certificate("kube-apiserver-localhost-signer", soon), "\n", "\\n")
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/api/v1/namespaces/openshift-kube-apiserver/secrets":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "SecretList", "items": [
+				{"metadata": {"name": "localhost-serving-cert-certkey", "namespace": "openshift-kube-apiserver"}, "type": "kubernetes.io/tls",
+				 "data": {"tls.crt": "` + servingCert + `", "tls.key": "c2VjcmV0"}}
+			]}`))
+		case "/api/v1/namespaces/openshift-config-managed/configmaps":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "ConfigMapList", "items": [
+				{"metadata": {"name": "kube-apiserver-server-ca", "namespace": "openshift-config-managed"}, "data": {"ca-bundle.crt": "` + caBundle + `"}},
+				{"metadata": {"name": "trusted-ca-bundle", "namespace": "openshift-config-managed"}, "data": {"ca-bundle.crt": "` + caBundle + `"}}
+			]}`))
+		case "/api/v1/namespaces/openshift-etcd/secrets":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Status", "status": "Failure", "reason": "Forbidden", "code": 403}`))
+		default:
+			if strings.HasSuffix(req.URL.Path, "/secrets") || strings.HasSuffix(req.URL.Path, "/configmaps") {
+				_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "List", "items": []}`))
+			}
+		}
+	}))
+	s.InitMcpClient()
+	s.Run("certificates_expiring", func() {
+		toolResult, err := s.CallTool("certificates_expiring", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+			s.True(strings.HasPrefix(text, "# The following certificates (YAML format) expiring within 30 days were found:\n"))
+		})
+		s.Require().Len(decoded, 2, "only certificates expiring within warn_days should be reported")
+		s.Run("flags soon to expire certificates with the expiry date", func() {
+			s.Equal("openshift-config-managed", decoded[0]["Namespace"])
+			s.Equal("ConfigMap", decoded[0]["Kind"])
+			s.Equal("kube-apiserver-server-ca", decoded[0]["Name"])
+			s.Equal("ca-bundle.crt", decoded[0]["Key"])
+			s.Equal("CN=kube-apiserver-localhost-signer", decoded[0]["Subject"])
+			s.Equal(soon.Format(time.RFC3339), decoded[0]["NotAfter"])
+			s.Equal(float64(5), decoded[0]["DaysRemaining"])
+			s.Equal(false, decoded[0]["Expired"])
+			s.Equal("openshift-kube-apiserver", decoded[1]["Namespace"])
+			s.Equal("Secret", decoded[1]["Kind"])
+			s.Equal("localhost-serving-cert-certkey", decoded[1]["Name"])
+			s.Equal("tls.crt", decoded[1]["Key"])
+			s.Equal("CN=localhost", decoded[1]["Subject"])
+			s.Equal(soon.Format(time.RFC3339), decoded[1]["NotAfter"])
+		})
+		s.Run("reports skipped namespaces", func() {
+			s.True(strings.HasSuffix(text, "\n# Warnings, the following namespaces were skipped (partial results):\n# - openshift-etcd: forbidden"))
+		})
+	})
+	s.Run("certificates_expiring(warn_days=1)", func() {
+		toolResult, err := s.CallTool("certificates_expiring", map[string]interface{}{"warn_days": 1})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		s.Run("reports no certificates", func() {
+			s.True(strings.HasPrefix(toolResult.Content[0].(mcp.TextContent).Text, "# No certificates expiring within 1 days found"))
+		})
+	})
+}
+
+func TestCluster(t *testing.T) {
+	suite.Run(t, new(ClusterSuite))
+}
+
+type WhoAmISuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+	// selfSubjectReviews counts the SelfSubjectReview requests
+	selfSubjectReviews int
+}
+
+func (s *WhoAmISuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.selfSubjectReviews = 0
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost || req.URL.Path != "/apis/authentication.k8s.io/v1/selfsubjectreviews" {
+			return
+		}
+		s.selfSubjectReviews++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"apiVersion": "authentication.k8s.io/v1", "kind": "SelfSubjectReview",
+			"status": {"userInfo": {"username": "kubernetes-admin", "groups": ["kubeadm:cluster-admins", "system:authenticated"]}}}`))
+	}))
+}
+
+func (s *WhoAmISuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *WhoAmISuite) TestWhoAmIInOpenShift() {
+	s.mockServer.Handle(test.NewInOpenShiftDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "user.openshift.io/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "users", Kind: "User", Namespaced: false, Verbs: []string{"get", "list"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/apis/user.openshift.io/v1/users/~" {
+			return
+		}
+		test.WriteObject(w, &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "user.openshift.io/v1",
+			"kind":       "User",
+			"metadata":   map[string]interface{}{"name": "developer", "uid": "b5f5a7c2-1f4e-4c57-9a56-0d4e2f4a1c11"},
+			"groups":     []interface{}{"developers", "system:authenticated:oauth"},
+		}})
+	}))
+	s.InitMcpClient()
+	s.Run("whoami in OpenShift", func() {
+		toolResult, err := s.CallTool("whoami", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		var decoded map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("returns identity from the OpenShift user endpoint", func() {
+			s.Equal("developer", decoded["Username"])
+			s.Equal("b5f5a7c2-1f4e-4c57-9a56-0d4e2f4a1c11", decoded["UID"])
+			s.Equal([]interface{}{"developers", "system:authenticated:oauth"}, decoded["Groups"])
+			s.Equal("users.openshift.io/~", decoded["Source"])
+			s.Zero(s.selfSubjectReviews, "SelfSubjectReview should not be performed")
+		})
+		s.Run("returns active context", func() {
+			s.Equal("fake-context", decoded["Context"])
+		})
+	})
+}
+
+func (s *WhoAmISuite) TestWhoAmIInKubernetes() {
+	s.mockServer.Handle(test.NewDiscoveryClientHandler())
+	s.InitMcpClient()
+	s.Run("whoami in Kubernetes", func() {
+		toolResult, err := s.CallTool("whoami", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		var decoded map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("returns identity from SelfSubjectReview", func() {
+			s.Equal("kubernetes-admin", decoded["Username"])
+			s.Equal([]interface{}{"kubeadm:cluster-admins", "system:authenticated"}, decoded["Groups"])
+			s.Equal("SelfSubjectReview", decoded["Source"])
+			s.Equal(1, s.selfSubjectReviews)
+		})
+		s.Run("returns active context", func() {
+			s.Equal("fake-context", decoded["Context"])
+		})
+	})
+}
+
+func TestWhoAmI(t *testing.T) {
+	suite.Run(t, new(WhoAmISuite))
+}
@@ -0,0 +1,122 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type CRDsSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *CRDsSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "apiextensions.k8s.io/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "customresourcedefinitions", Kind: "CustomResourceDefinition", Namespaced: false, Verbs: []string{"get", "list"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/apis/apiextensions.k8s.io/v1/customresourcedefinitions" {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"apiVersion": "apiextensions.k8s.io/v1", "kind": "CustomResourceDefinitionList", "items": [
+			{"apiVersion": "apiextensions.k8s.io/v1", "kind": "CustomResourceDefinition",
+			 "metadata": {"name": "routes.route.openshift.io"},
+			 "spec": {"group": "route.openshift.io", "scope": "Namespaced",
+			  "names": {"plural": "routes", "kind": "Route"},
+			  "versions": [{"name": "v1", "served": true, "storage": true}]}},
+			{"apiVersion": "apiextensions.k8s.io/v1", "kind": "CustomResourceDefinition",
+			 "metadata": {"name": "certificates.cert-manager.io"},
+			 "spec": {"group": "cert-manager.io", "scope": "Namespaced",
+			  "names": {"plural": "certificates", "kind": "Certificate", "shortNames": ["cert", "certs"]},
+			  "versions": [{"name": "v1alpha2", "served": false, "storage": false}, {"name": "v1", "served": true, "storage": true}]}},
+			{"apiVersion": "apiextensions.k8s.io/v1", "kind": "CustomResourceDefinition",
+			 "metadata": {"name": "clusterissuers.cert-manager.io"},
+			 "spec": {"group": "cert-manager.io", "scope": "Cluster",
+			  "names": {"plural": "clusterissuers", "kind": "ClusterIssuer"},
+			  "versions": [{"name": "v1", "served": true, "storage": true}]}}
+		]}`))
+	}))
+}
+
+func (s *CRDsSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *CRDsSuite) TestCRDsList() {
+	s.InitMcpClient()
+	s.Run("crds_list()", func() {
+		toolResult, err := s.CallTool("crds_list", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("returns all CRDs sorted by group and name", func() {
+			s.Require().Len(decoded, 3)
+			s.Equal("certificates.cert-manager.io", decoded[0]["Name"])
+			s.Equal("clusterissuers.cert-manager.io", decoded[1]["Name"])
+			s.Equal("routes.route.openshift.io", decoded[2]["Name"])
+		})
+	})
+	s.Run("crds_list(group=Cert-Manager)", func() {
+		toolResult, err := s.CallTool("crds_list", map[string]interface{}{
+			"group": "Cert-Manager",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		var decoded []map[string]interface{}
+		s.Nilf(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "unmarshal failed")
+		s.Run("returns only the CRDs of the matching groups", func() {
+			s.Equal([]map[string]interface{}{
+				{
+					"Name":       "certificates.cert-manager.io",
+					"Group":      "cert-manager.io",
+					"Kind":       "Certificate",
+					"Versions":   []interface{}{"v1"},
+					"Scope":      "Namespaced",
+					"ShortNames": []interface{}{"cert", "certs"},
+				},
+				{
+					"Name":     "clusterissuers.cert-manager.io",
+					"Group":    "cert-manager.io",
+					"Kind":     "ClusterIssuer",
+					"Versions": []interface{}{"v1"},
+					"Scope":    "Cluster",
+				},
+			}, decoded)
+		})
+	})
+	s.Run("crds_list(group=example.com) not matching any CRD", func() {
+		toolResult, err := s.CallTool("crds_list", map[string]interface{}{
+			"group": "example.com",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Equal("# No CRDs found", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func TestCRDs(t *testing.T) {
+	suite.Run(t, new(CRDsSuite))
+}
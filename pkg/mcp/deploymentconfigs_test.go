@@ -0,0 +1,187 @@
+package mcp
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type DeploymentConfigsSuite struct {
+	BaseMcpSuite
+	mockServer        *test.MockServer
+	deploymentConfigs map[string]*unstructured.Unstructured
+}
+
+func (s *DeploymentConfigsSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.Cfg.Toolsets = []string{"deploymentconfigs"}
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.deploymentConfigs = map[string]*unstructured.Unstructured{}
+	for _, name := range []string{"dc-1", "dc-2"} {
+		s.deploymentConfigs[name] = &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "apps.openshift.io/v1",
+			"kind":       "DeploymentConfig",
+			"metadata":   map[string]interface{}{"name": name, "namespace": "ns-1"},
+			"spec":       map[string]interface{}{"replicas": int64(1)},
+			"status":     map[string]interface{}{"latestVersion": int64(3)},
+		}}
+	}
+}
+
+func (s *DeploymentConfigsSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *DeploymentConfigsSuite) handleDeploymentConfigs() {
+	s.mockServer.Handle(test.NewInOpenShiftDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "apps.openshift.io/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "deploymentconfigs", Kind: "DeploymentConfig", Namespaced: true, Verbs: []string{"get", "list", "update"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/apis/apps.openshift.io/v1/namespaces/ns-1/deploymentconfigs" {
+			list := &unstructured.UnstructuredList{Object: map[string]interface{}{"apiVersion": "apps.openshift.io/v1", "kind": "DeploymentConfigList"}}
+			for _, name := range []string{"dc-1", "dc-2"} {
+				list.Items = append(list.Items, *s.deploymentConfigs[name])
+			}
+			test.WriteObject(w, list)
+			return
+		}
+		for name, deploymentConfig := range s.deploymentConfigs {
+			if req.URL.Path != "/apis/apps.openshift.io/v1/namespaces/ns-1/deploymentconfigs/"+name {
+				continue
+			}
+			if req.Method == http.MethodPut {
+				body, err := io.ReadAll(req.Body)
+				if err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				updated := &unstructured.Unstructured{}
+				if err = updated.UnmarshalJSON(body); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				s.deploymentConfigs[name] = updated
+				deploymentConfig = updated
+			}
+			test.WriteObject(w, deploymentConfig)
+			return
+		}
+	}))
+}
+
+func (s *DeploymentConfigsSuite) TestDeploymentConfigsNotInOpenShift() {
+	s.InitMcpClient()
+	s.Run("ListTools does not return deploymentconfigs tools", func() {
+		tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+		s.Require().NoError(err, "Expected no error from ListTools")
+		s.Empty(tools.Tools, "Expected no tools when not running in OpenShift")
+	})
+}
+
+func (s *DeploymentConfigsSuite) TestDeploymentConfigsDeprecated() {
+	s.handleDeploymentConfigs()
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/apis/config.openshift.io/v1/clusterversions/version" {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "config.openshift.io/v1", "kind": "ClusterVersion",
+				"metadata": {"name": "version"}, "status": {"desired": {"version": "4.16.0"}}}`))
+		}
+	}))
+	s.InitMcpClient()
+	s.Run("ListTools in OpenShift 4.16 flags deploymentconfigs tools as deprecated", func() {
+		tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+		s.Require().NoError(err, "Expected no error from ListTools")
+		s.Require().NotEmpty(tools.Tools)
+		for _, tool := range tools.Tools {
+			s.Containsf(tool.Description, "DeploymentConfigs are deprecated since OpenShift 4.14", "Expected %s to be flagged as deprecated", tool.Name)
+		}
+	})
+}
+
+func (s *DeploymentConfigsSuite) TestDeploymentConfigsList() {
+	s.handleDeploymentConfigs()
+	s.InitMcpClient()
+	s.Run("ListTools with unknown OpenShift version does not flag deploymentconfigs tools as deprecated", func() {
+		tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+		s.Require().NoError(err, "Expected no error from ListTools")
+		for _, tool := range tools.Tools {
+			s.NotContains(tool.Description, "deprecated")
+		}
+	})
+	s.Run("deploymentconfigs_list(namespace=ns-1)", func() {
+		toolResult, err := s.CallTool("deploymentconfigs_list", map[string]interface{}{
+			"namespace": "ns-1",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		var decoded []unstructured.Unstructured
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("returns all deployment configs", func() {
+			s.Require().Len(decoded, 2)
+			s.Equal("dc-1", decoded[0].GetName())
+			s.Equal("DeploymentConfig", decoded[0].GetKind())
+			s.Equal("dc-2", decoded[1].GetName())
+		})
+	})
+}
+
+func (s *DeploymentConfigsSuite) TestDeploymentConfigsRollout() {
+	s.handleDeploymentConfigs()
+	s.InitMcpClient()
+	s.Run("deploymentconfigs_rollout(name=nil)", func() {
+		toolResult, err := s.CallTool("deploymentconfigs_rollout", map[string]interface{}{})
+		s.Require().NotNil(toolResult, "toolResult should not be nil")
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes missing name", func() {
+			s.Equal("failed to roll out deployment config, missing argument name", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+	s.Run("deploymentconfigs_rollout(namespace=ns-1, name=dc-1)", func() {
+		toolResult, err := s.CallTool("deploymentconfigs_rollout", map[string]interface{}{
+			"namespace": "ns-1",
+			"name":      "dc-1",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		s.Run("returns new version", func() {
+			s.Equal("DeploymentConfig ns-1/dc-1 rolled out, new version: 4", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+		s.Run("increments latestVersion", func() {
+			latestVersion, _, _ := unstructured.NestedInt64(s.deploymentConfigs["dc-1"].Object, "status", "latestVersion")
+			s.Equal(int64(4), latestVersion)
+		})
+		s.Run("does not modify other deployment configs", func() {
+			latestVersion, _, _ := unstructured.NestedInt64(s.deploymentConfigs["dc-2"].Object, "status", "latestVersion")
+			s.Equal(int64(3), latestVersion)
+		})
+	})
+}
+
+func TestDeploymentConfigs(t *testing.T) {
+	suite.Run(t, new(DeploymentConfigsSuite))
+}
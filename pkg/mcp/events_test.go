@@ -1,6 +1,7 @@
 package mcp
 
 import (
+	"net/http"
 	"strings"
 	"testing"
 
@@ -11,6 +12,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
 )
 
 type EventsSuite struct {
@@ -142,3 +145,132 @@ func (s *EventsSuite) TestEventsListDenied() {
 func TestEvents(t *testing.T) {
 	suite.Run(t, new(EventsSuite))
 }
+
+type EventsWarningsSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *EventsWarningsSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+}
+
+func (s *EventsWarningsSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *EventsWarningsSuite) TestEventsWarnings() {
+	warning := func(namespace, name string, count int32) v1.Event {
+		return v1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: namespace},
+			InvolvedObject: v1.ObjectReference{APIVersion: "v1", Kind: "Pod", Name: "a-pod", Namespace: namespace},
+			Type:           v1.EventTypeWarning,
+			Reason:         "BackOff",
+			Message:        "Back-off restarting failed container",
+			Count:          count,
+		}
+	}
+	events := map[string][]v1.Event{
+		"ns-a": {warning("ns-a", "warning-1", 1), warning("ns-a", "warning-2", 5)},
+		"ns-b": {warning("ns-b", "warning-1", 2)},
+		"ns-c": {warning("ns-c", "warning-1", 10)},
+	}
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/api/v1/namespaces" {
+			namespaces := &v1.NamespaceList{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "NamespaceList"}}
+			for _, ns := range []string{"ns-a", "ns-b", "ns-c", "ns-forbidden", "ns-unavailable"} {
+				namespaces.Items = append(namespaces.Items, v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}})
+			}
+			test.WriteObject(w, namespaces)
+			return
+		}
+		if req.URL.Path == "/api/v1/namespaces/ns-forbidden/events" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Forbidden","code":403}`))
+			return
+		}
+		if req.URL.Path == "/api/v1/namespaces/ns-unavailable/events" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"ServiceUnavailable","message":"etcd leader changed","code":503}`))
+			return
+		}
+		for ns, nsEvents := range events {
+			if req.URL.Path == "/api/v1/namespaces/"+ns+"/events" {
+				s.Equal("type=Warning", req.URL.Query().Get("fieldSelector"), "Expected only Warning events to be requested")
+				test.WriteObject(w, &v1.EventList{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "EventList"}, Items: nsEvents})
+				return
+			}
+		}
+	}))
+	s.InitMcpClient()
+	s.Run("events_warnings (all namespaces)", func() {
+		toolResult, err := s.CallTool("events_warnings", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		var decoded []map[string]any
+		err = yaml.Unmarshal([]byte(text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("returns namespaces sorted by count", func() {
+			s.Require().Len(decoded, 3)
+			s.Equal("ns-c", decoded[0]["Namespace"])
+			s.EqualValues(10, decoded[0]["Count"])
+			s.Equal("ns-a", decoded[1]["Namespace"])
+			s.EqualValues(6, decoded[1]["Count"])
+			s.Equal("ns-b", decoded[2]["Namespace"])
+			s.EqualValues(2, decoded[2]["Count"])
+		})
+		s.Run("returns events sorted by count within namespace", func() {
+			s.Require().Len(decoded, 3)
+			nsAEvents := decoded[1]["Events"].([]interface{})
+			s.Require().Len(nsAEvents, 2)
+			s.EqualValues(5, nsAEvents[0].(map[string]interface{})["Count"])
+			s.EqualValues(1, nsAEvents[1].(map[string]interface{})["Count"])
+		})
+		s.Run("reports skipped forbidden and unavailable namespaces as warnings", func() {
+			s.True(strings.HasSuffix(text, "\n# Warnings, the following namespaces were skipped (partial results):"+
+				"\n# - ns-forbidden: forbidden"+
+				"\n# - ns-unavailable: etcd leader changed"),
+				"unexpected result %v", text)
+		})
+	})
+	s.Run("events_warnings(namespaces=[ns-b])", func() {
+		toolResult, err := s.CallTool("events_warnings", map[string]interface{}{
+			"namespaces": []interface{}{"ns-b"},
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		s.Run("returns warnings from namespace", func() {
+			s.YAMLEqf(""+
+				"- Count: 2\n"+
+				"  Events:\n"+
+				"  - Count: 2\n"+
+				"    InvolvedObject:\n"+
+				"      Kind: Pod\n"+
+				"      Name: a-pod\n"+
+				"      apiVersion: v1\n"+
+				"    Message: Back-off restarting failed container\n"+
+				"    Reason: BackOff\n"+
+				"  Namespace: ns-b\n",
+				toolResult.Content[0].(mcp.TextContent).Text,
+				"unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+}
+
+func TestEventsWarnings(t *testing.T) {
+	suite.Run(t, new(EventsWarningsSuite))
+}
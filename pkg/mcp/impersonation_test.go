@@ -0,0 +1,120 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type ImpersonationSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+	user       string
+	groups     []string
+}
+
+func (s *ImpersonationSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		impersonation = true
+	`), s.Cfg), "Expected to parse impersonation config")
+	s.user = ""
+	s.groups = nil
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "configmaps", Kind: "ConfigMap", Namespaced: true, Verbs: []string{"get", "list"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/namespaces/ns-1/configmaps/settings" {
+			return
+		}
+		s.user = req.Header.Get("Impersonate-User")
+		s.groups = req.Header.Values("Impersonate-Group")
+		w.Header().Set("Content-Type", "application/json")
+		if s.user == "intruder" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"kind": "Status", "apiVersion": "v1", "status": "Failure", "reason": "Forbidden", "code": 403,
+				"message": "users \"intruder\" is forbidden: User \"system:serviceaccount:mcp:server\" cannot impersonate resource \"users\" in API group \"\" at the cluster scope"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "settings", "namespace": "ns-1"}}`))
+	}))
+}
+
+func (s *ImpersonationSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *ImpersonationSuite) TestToolsExposeImpersonationParameters() {
+	s.InitMcpClient()
+	tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+	s.Require().NoError(err)
+	for _, tool := range tools.Tools {
+		if tool.Name == "resources_get" {
+			s.Contains(tool.InputSchema.Properties, "as_user")
+			s.Contains(tool.InputSchema.Properties, "as_groups")
+			return
+		}
+	}
+	s.Fail("resources_get tool not found")
+}
+
+func (s *ImpersonationSuite) TestImpersonation() {
+	s.InitMcpClient()
+	s.Run("resources_get without as_user uses the server identity", func() {
+		toolResult, err := s.CallTool("resources_get", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "namespace": "ns-1", "name": "settings",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Empty(s.user)
+		s.Empty(s.groups)
+	})
+	s.Run("resources_get(as_user=developer, as_groups=[team-a, team-b])", func() {
+		toolResult, err := s.CallTool("resources_get", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "namespace": "ns-1", "name": "settings",
+			"as_user": "developer", "as_groups": []interface{}{"team-a", "team-b"},
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		s.Run("sets the impersonation headers", func() {
+			s.Equal("developer", s.user)
+			s.Equal([]string{"team-a", "team-b"}, s.groups)
+		})
+	})
+	s.Run("resources_get(as_user=intruder) surfaces the Forbidden error", func() {
+		toolResult, err := s.CallTool("resources_get", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "namespace": "ns-1", "name": "settings", "as_user": "intruder",
+		})
+		s.Nilf(err, "call tool should not return error object")
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "cannot impersonate resource \"users\"")
+	})
+	s.Run("resources_get(as_groups=[team-a]) without as_user returns error", func() {
+		toolResult, err := s.CallTool("resources_get", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "namespace": "ns-1", "name": "settings", "as_groups": []interface{}{"team-a"},
+		})
+		s.Nilf(err, "call tool should not return error object")
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("as_groups requires as_user", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func TestImpersonation(t *testing.T) {
+	suite.Run(t, new(ImpersonationSuite))
+}
@@ -0,0 +1,128 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type IngressesSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *IngressesSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "networking.k8s.io/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "ingresses", Kind: "Ingress", Namespaced: true, Verbs: []string{"get", "list"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/apis/authorization.k8s.io/v1/selfsubjectaccessreviews":
+			// Allow listing ingresses in all namespaces
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "authorization.k8s.io/v1", "kind": "SelfSubjectAccessReview", "status": {"allowed": true}}`))
+		case "/apis/networking.k8s.io/v1/ingresses":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "networking.k8s.io/v1", "kind": "IngressList", "items": [
+				{"apiVersion": "networking.k8s.io/v1", "kind": "Ingress",
+				 "metadata": {"name": "shop", "namespace": "ns-1"},
+				 "spec": {"ingressClassName": "nginx",
+				  "tls": [{"hosts": ["shop.example.com", "www.shop.example.com"], "secretName": "shop-tls"}],
+				  "rules": [
+				   {"host": "shop.example.com", "http": {"paths": [
+				    {"path": "/", "pathType": "Prefix", "backend": {"service": {"name": "web", "port": {"number": 80}}}},
+				    {"path": "/api", "pathType": "Prefix", "backend": {"service": {"name": "api", "port": {"name": "http"}}}}
+				   ]}},
+				   {"host": "www.shop.example.com", "http": {"paths": [
+				    {"path": "/", "pathType": "Prefix", "backend": {"service": {"name": "web", "port": {"number": 80}}}}
+				   ]}}
+				  ]},
+				 "status": {"loadBalancer": {"ingress": [{"ip": "203.0.113.10"}]}}},
+				{"apiVersion": "networking.k8s.io/v1", "kind": "Ingress",
+				 "metadata": {"name": "legacy", "namespace": "ns-2", "annotations": {"kubernetes.io/ingress.class": "haproxy"}},
+				 "spec": {"defaultBackend": {"service": {"name": "fallback", "port": {"number": 8080}}}}}
+			]}`))
+		case "/apis/networking.k8s.io/v1/namespaces/empty/ingresses":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "networking.k8s.io/v1", "kind": "IngressList", "items": []}`))
+		}
+	}))
+}
+
+func (s *IngressesSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *IngressesSuite) TestIngressList() {
+	s.InitMcpClient()
+	s.Run("ingress_list()", func() {
+		toolResult, err := s.CallTool("ingress_list", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Require().Len(decoded, 2)
+		s.Run("reports hosts, backends, TLS secrets and ingress class", func() {
+			s.Equal(map[string]interface{}{
+				"Namespace":    "ns-1",
+				"Name":         "shop",
+				"IngressClass": "nginx",
+				"Hosts":        []interface{}{"shop.example.com", "www.shop.example.com"},
+				"Rules": []interface{}{
+					"shop.example.com/ (Prefix) -> web:80",
+					"shop.example.com/api (Prefix) -> api:http",
+					"www.shop.example.com/ (Prefix) -> web:80",
+				},
+				"Services": []interface{}{"api", "web"},
+				"TLS": []interface{}{map[string]interface{}{
+					"SecretName": "shop-tls",
+					"Hosts":      []interface{}{"shop.example.com", "www.shop.example.com"},
+				}},
+				"Addresses": []interface{}{"203.0.113.10"},
+			}, decoded[0])
+		})
+		s.Run("reports default backend and legacy ingress class annotation", func() {
+			s.Equal(map[string]interface{}{
+				"Namespace":      "ns-2",
+				"Name":           "legacy",
+				"IngressClass":   "haproxy",
+				"DefaultBackend": "fallback:8080",
+				"Hosts":          []interface{}{},
+				"Rules":          []interface{}{},
+				"Services":       []interface{}{"fallback"},
+			}, decoded[1])
+		})
+	})
+	s.Run("ingress_list(namespace=empty)", func() {
+		toolResult, err := s.CallTool("ingress_list", map[string]interface{}{
+			"namespace": "empty",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Equal("# No ingresses found", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func TestIngresses(t *testing.T) {
+	suite.Run(t, new(IngressesSuite))
+}
@@ -0,0 +1,193 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type JobsSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *JobsSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "batch/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "jobs", Kind: "Job", Namespaced: true, Verbs: []string{"get", "list"}},
+			{Name: "cronjobs", Kind: "CronJob", Namespaced: true, Verbs: []string{"get", "list"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/apis/authorization.k8s.io/v1/selfsubjectaccessreviews":
+			// Allow listing jobs in all namespaces
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "authorization.k8s.io/v1", "kind": "SelfSubjectAccessReview", "status": {"allowed": true}}`))
+		case "/apis/batch/v1/jobs":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "batch/v1", "kind": "JobList", "items": [
+				{"apiVersion": "batch/v1", "kind": "Job",
+				 "metadata": {"name": "backup-28000000", "namespace": "ns-1", "ownerReferences": [{"apiVersion": "batch/v1", "kind": "CronJob", "name": "backup", "uid": "cj-uid"}]},
+				 "spec": {"completions": 1},
+				 "status": {"succeeded": 1, "startTime": "2025-01-02T03:00:00Z", "completionTime": "2025-01-02T03:01:30Z",
+				  "conditions": [{"type": "Complete", "status": "True"}]}},
+				{"apiVersion": "batch/v1", "kind": "Job",
+				 "metadata": {"name": "migrate", "namespace": "ns-2"},
+				 "spec": {"completions": 3},
+				 "status": {"succeeded": 1, "failed": 6, "startTime": "2025-01-02T04:00:00Z",
+				  "conditions": [{"type": "Failed", "status": "True", "reason": "BackoffLimitExceeded"}]}},
+				{"apiVersion": "batch/v1", "kind": "Job",
+				 "metadata": {"name": "report", "namespace": "ns-2"},
+				 "status": {"active": 1, "startTime": "2025-01-02T05:00:00Z"}}
+			]}`))
+		case "/apis/batch/v1/namespaces/ns-1/cronjobs":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "batch/v1", "kind": "CronJobList", "items": [
+				{"apiVersion": "batch/v1", "kind": "CronJob",
+				 "metadata": {"name": "backup", "namespace": "ns-1"},
+				 "spec": {"schedule": "0 3 * * *", "timeZone": "Etc/UTC", "jobTemplate": {"spec": {}}},
+				 "status": {"lastScheduleTime": "2025-01-02T03:00:00Z", "lastSuccessfulTime": "2025-01-02T03:01:30Z",
+				  "active": [{"kind": "Job", "namespace": "ns-1", "name": "backup-28000060"}]}},
+				{"apiVersion": "batch/v1", "kind": "CronJob",
+				 "metadata": {"name": "cleanup", "namespace": "ns-1"},
+				 "spec": {"schedule": "*/15 * * * *", "suspend": true, "jobTemplate": {"spec": {}}}}
+			]}`))
+		case "/apis/batch/v1/namespaces/empty/cronjobs":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "batch/v1", "kind": "CronJobList", "items": []}`))
+		}
+	}))
+}
+
+func (s *JobsSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *JobsSuite) TestJobsList() {
+	s.InitMcpClient()
+	s.Run("jobs_list()", func() {
+		toolResult, err := s.CallTool("jobs_list", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Require().Len(decoded, 3)
+		s.Run("returns completed job", func() {
+			s.Equal(map[string]interface{}{
+				"Namespace":      "ns-1",
+				"Name":           "backup-28000000",
+				"Status":         "Complete",
+				"Completions":    "1/1",
+				"Active":         float64(0),
+				"Succeeded":      float64(1),
+				"Failed":         float64(0),
+				"StartTime":      "2025-01-02T03:00:00Z",
+				"CompletionTime": "2025-01-02T03:01:30Z",
+				"CronJob":        "backup",
+			}, decoded[0])
+		})
+		s.Run("returns failed job with reason", func() {
+			s.Equal(map[string]interface{}{
+				"Namespace":   "ns-2",
+				"Name":        "migrate",
+				"Status":      "Failed",
+				"Reason":      "BackoffLimitExceeded",
+				"Completions": "1/3",
+				"Active":      float64(0),
+				"Succeeded":   float64(1),
+				"Failed":      float64(6),
+				"StartTime":   "2025-01-02T04:00:00Z",
+			}, decoded[1])
+		})
+		s.Run("returns running job", func() {
+			s.Equal("report", decoded[2]["Name"])
+			s.Equal("Running", decoded[2]["Status"])
+			s.Equal(float64(1), decoded[2]["Active"])
+			s.NotContains(decoded[2], "CompletionTime")
+		})
+	})
+	s.Run("jobs_list(failed_only=true)", func() {
+		toolResult, err := s.CallTool("jobs_list", map[string]interface{}{
+			"failed_only": true,
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		var decoded []map[string]interface{}
+		s.Nilf(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "unmarshal failed")
+		s.Run("returns only failed jobs", func() {
+			s.Require().Len(decoded, 1)
+			s.Equal("migrate", decoded[0]["Name"])
+		})
+	})
+}
+
+func (s *JobsSuite) TestCronJobsList() {
+	s.InitMcpClient()
+	s.Run("cronjobs_list(namespace=ns-1)", func() {
+		toolResult, err := s.CallTool("cronjobs_list", map[string]interface{}{
+			"namespace": "ns-1",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Require().Len(decoded, 2)
+		s.Run("returns scheduled cronjob with active jobs", func() {
+			s.Equal(map[string]interface{}{
+				"Namespace":          "ns-1",
+				"Name":               "backup",
+				"Schedule":           "0 3 * * *",
+				"TimeZone":           "Etc/UTC",
+				"Suspend":            false,
+				"LastScheduleTime":   "2025-01-02T03:00:00Z",
+				"LastSuccessfulTime": "2025-01-02T03:01:30Z",
+				"ActiveJobs":         []interface{}{"backup-28000060"},
+			}, decoded[0])
+		})
+		s.Run("returns suspended cronjob", func() {
+			s.Equal(map[string]interface{}{
+				"Namespace":  "ns-1",
+				"Name":       "cleanup",
+				"Schedule":   "*/15 * * * *",
+				"Suspend":    true,
+				"ActiveJobs": []interface{}{},
+			}, decoded[1])
+		})
+	})
+	s.Run("cronjobs_list(namespace=empty)", func() {
+		toolResult, err := s.CallTool("cronjobs_list", map[string]interface{}{
+			"namespace": "empty",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Equal("# No cronjobs found", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func TestJobs(t *testing.T) {
+	suite.Run(t, new(JobsSuite))
+}
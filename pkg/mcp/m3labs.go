@@ -9,6 +9,7 @@ import (
 	"github.com/mark3labs/mcp-go/server"
 
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	internalk8s "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
 )
 
 func ServerToolToM3LabsServerTool(s *Server, tools []api.ServerTool) ([]server.ServerTool, error) {
@@ -45,19 +46,50 @@ func ServerToolToM3LabsServerTool(s *Server, tools []api.ServerTool) ([]server.S
 			if err != nil {
 				return nil, err
 			}
+			if s.configuration.Impersonation && tool.IsClusterAware() {
+				if k, err = impersonated(k, request); err != nil {
+					return NewTextResult("", err), nil
+				}
+			}
 
 			result, err := tool.Handler(api.ToolHandlerParams{
 				Context:         ctx,
 				Kubernetes:      k,
 				ToolCallRequest: request,
 				ListOutput:      s.configuration.ListOutput(),
+				MaxOutputBytes:  s.configuration.MaxOutputBytes(),
+				MustGatherDir:   s.configuration.MustGatherDir,
 			})
 			if err != nil {
 				return nil, err
 			}
-			return NewTextResult(result.Content, result.Error), nil
+			callToolResult := NewTextResult(result.Content, result.Error)
+			if result.Error == nil {
+				for _, notice := range result.Notices {
+					callToolResult.Content = append(callToolResult.Content, mcp.NewTextContent(notice))
+				}
+			}
+			return callToolResult, nil
 		}
 		m3labTools = append(m3labTools, server.ServerTool{Tool: m3labTool, Handler: m3labHandler})
 	}
 	return m3labTools, nil
 }
+
+// impersonated returns a Kubernetes client impersonating the user and groups selected by the as_user and as_groups
+// parameters of the request, or the provided client if no user is selected
+func impersonated(k *internalk8s.Kubernetes, request mcp.CallToolRequest) (*internalk8s.Kubernetes, error) {
+	user := request.GetString(impersonateUserParameterName, "")
+	groups := request.GetStringSlice(impersonateGroupsParameterName, nil)
+	if user == "" {
+		if len(groups) > 0 {
+			return nil, fmt.Errorf("%s requires %s", impersonateGroupsParameterName, impersonateUserParameterName)
+		}
+		return k, nil
+	}
+	impersonatedK, err := k.Impersonate(user, groups)
+	if err != nil {
+		return nil, fmt.Errorf("failed to impersonate user %s: %v", user, err)
+	}
+	return impersonatedK, nil
+}
@@ -47,6 +47,14 @@ func (c *Configuration) ListOutput() output.Output {
 	return c.listOutput
 }
 
+// MaxOutputBytes returns the maximum size of the list tool results (no limit if <= 0)
+func (c *Configuration) MaxOutputBytes() int {
+	if c.StaticConfig.MaxOutputBytes == 0 {
+		return output.DefaultMaxBytes
+	}
+	return c.StaticConfig.MaxOutputBytes
+}
+
 func (c *Configuration) isToolApplicable(tool api.ServerTool) bool {
 	if c.ReadOnly && !ptr.Deref(tool.Tool.Annotations.ReadOnlyHint, false) {
 		return false
@@ -129,10 +137,12 @@ func (s *Server) reloadKubernetesClusterProvider() error {
 		targets,
 	)
 
+	impersonationMutator := WithImpersonationParameters(s.configuration.Impersonation)
+
 	applicableTools := make([]api.ServerTool, 0)
 	for _, toolset := range s.configuration.Toolsets() {
 		for _, tool := range toolset.GetTools(p) {
-			tool := mutator(tool)
+			tool := impersonationMutator(mutator(tool))
 			if !filter(tool) {
 				continue
 			}
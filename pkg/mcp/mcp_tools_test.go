@@ -56,6 +56,12 @@ func (s *McpToolProcessingSuite) TestReadOnly() {
 				"Tool %s is destructive but should not be in read-only mode", tool.Name)
 		}
 	})
+
+	s.Run("ListTools does not return tools executing commands in Pods", func() {
+		for _, tool := range tools.Tools {
+			s.NotContains([]string{"pods_exec", "pods_probe"}, tool.Name, "Tool %s executes commands in Pods", tool.Name)
+		}
+	})
 }
 
 func (s *McpToolProcessingSuite) TestDisableDestructive() {
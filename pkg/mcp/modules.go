@@ -1,5 +1,9 @@
 package mcp
 
+import _ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/builds"
 import _ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/config"
 import _ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/core"
+import _ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/deploymentconfigs"
 import _ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/helm"
+import _ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/mustgather"
+import _ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/routes"
@@ -0,0 +1,207 @@
+package mcp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type MustGatherSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+	path       string
+}
+
+func (s *MustGatherSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.Cfg.Toolsets = []string{"mustgather"}
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(test.NewInOpenShiftDiscoveryClientHandler())
+	s.Cfg.MustGatherDir = s.T().TempDir()
+	s.path = filepath.Join(s.Cfg.MustGatherDir, "must-gather.local.1234")
+	image := filepath.Join(s.path, "quay-io-openshift-release-dev-ocp-v4-0-art-dev-sha256-abcdef")
+	files := map[string]string{
+		"timestamp": "2025-01-02 03:04:05",
+		"namespaces/openshift-etcd/openshift-etcd.yaml": `{"apiVersion": "v1", "kind": "Namespace", "metadata": {"name": "openshift-etcd"}, "status": {"phase": "Active"}}`,
+		"namespaces/openshift-etcd/core/events.yaml": `{"apiVersion": "v1", "kind": "EventList", "items": [
+			{"metadata": {"name": "etcd.2", "namespace": "openshift-etcd"}, "involvedObject": {"kind": "Pod", "name": "etcd-master-0"},
+			 "type": "Warning", "reason": "Unhealthy", "count": 3, "lastTimestamp": "2025-01-02T02:00:00Z", "message": "Readiness probe failed"},
+			{"metadata": {"name": "etcd.1", "namespace": "openshift-etcd"}, "involvedObject": {"kind": "Pod", "name": "etcd-master-0"},
+			 "type": "Normal", "reason": "Started", "lastTimestamp": "2025-01-02T01:00:00Z", "message": "Started container etcd"}
+		]}`,
+		"namespaces/openshift-etcd/pods/etcd-master-0/etcd/etcd/logs/current.log":         "2025-01-02T01:00:00Z starting etcd\n2025-01-02T02:00:00Z ERROR slow fdatasync\n2025-01-02T02:00:01Z ERROR leader changed\n",
+		"namespaces/openshift-etcd/pods/etcd-master-0/etcd/etcd/logs/previous.log":        "2025-01-01T00:00:00Z ERROR database space exceeded\n",
+		"namespaces/openshift-etcd/pods/installer-1/installer/installer/logs/current.log": "2025-01-01T00:00:00Z ERROR not an etcd log\n",
+		"namespaces/openshift-monitoring/core/events.yaml": `{"apiVersion": "v1", "kind": "EventList", "items": [
+			{"metadata": {"name": "prometheus.1", "namespace": "openshift-monitoring"}, "involvedObject": {"kind": "Pod", "name": "prometheus-k8s-0"},
+			 "type": "Warning", "reason": "FailedScheduling", "lastTimestamp": "2025-01-02T00:30:00Z", "message": "0/3 nodes are available"}
+		]}`,
+		"cluster-scoped-resources/config.openshift.io/clusteroperators/etcd.yaml": `{"apiVersion": "config.openshift.io/v1", "kind": "ClusterOperator",
+			"metadata": {"name": "etcd"}, "status": {"versions": [{"name": "operator", "version": "4.16.3"}], "conditions": [
+			 {"type": "Available", "status": "True"}, {"type": "Progressing", "status": "False"}, {"type": "Degraded", "status": "False"}]}}`,
+		"cluster-scoped-resources/config.openshift.io/clusteroperators/monitoring.yaml": `{"apiVersion": "config.openshift.io/v1", "kind": "ClusterOperator",
+			"metadata": {"name": "monitoring"}, "status": {"versions": [{"name": "operator", "version": "4.16.3"}], "conditions": [
+			 {"type": "Available", "status": "False", "reason": "UpdatingPrometheusFailed", "message": "prometheus-k8s is not ready"},
+			 {"type": "Progressing", "status": "False"},
+			 {"type": "Degraded", "status": "True", "reason": "UpdatingPrometheusFailed", "message": "waiting for prometheus-k8s"}]}}`,
+	}
+	for name, content := range files {
+		file := filepath.Join(image, filepath.FromSlash(name))
+		s.Require().NoError(os.MkdirAll(filepath.Dir(file), 0755))
+		s.Require().NoError(os.WriteFile(file, []byte(content), 0644))
+	}
+}
+
+func (s *MustGatherSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *MustGatherSuite) TestMustGatherPath() {
+	s.InitMcpClient()
+	s.Run("mustgather_namespaces_list with missing path returns error", func() {
+		toolResult, _ := s.CallTool("mustgather_namespaces_list", map[string]interface{}{})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to list must-gather namespaces, missing argument path", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("mustgather_namespaces_list with a non must-gather directory returns error", func() {
+		dir := filepath.Join(s.Cfg.MustGatherDir, "empty")
+		s.Require().NoError(os.Mkdir(dir, 0755))
+		toolResult, _ := s.CallTool("mustgather_namespaces_list", map[string]interface{}{"path": dir})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to list must-gather namespaces, "+dir+" is not a must-gather directory, no namespaces or cluster-scoped-resources directories found",
+			toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("mustgather_namespaces_list with a directory outside of must_gather_dir returns error", func() {
+		dir := s.T().TempDir()
+		toolResult, _ := s.CallTool("mustgather_namespaces_list", map[string]interface{}{"path": dir})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to list must-gather namespaces, "+dir+" is outside of the must-gather directory "+s.Cfg.MustGatherDir,
+			toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("mustgather_namespaces_list with a path relative to must_gather_dir", func() {
+		toolResult, err := s.CallTool("mustgather_namespaces_list", map[string]interface{}{"path": "must-gather.local.1234"})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+	})
+}
+
+func (s *MustGatherSuite) TestMustGatherDirNotConfigured() {
+	s.Cfg.MustGatherDir = ""
+	s.InitMcpClient()
+	toolResult, _ := s.CallTool("mustgather_namespaces_list", map[string]interface{}{"path": s.path})
+	s.Truef(toolResult.IsError, "call tool should fail")
+	s.Equal("failed to list must-gather namespaces, must_gather_dir is not configured", toolResult.Content[0].(mcp.TextContent).Text)
+}
+
+func (s *MustGatherSuite) TestMustGatherNamespacesList() {
+	s.InitMcpClient()
+	toolResult, err := s.CallTool("mustgather_namespaces_list", map[string]interface{}{"path": s.path})
+	s.Run("no error", func() {
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+	})
+	s.Run("returns the collected namespaces", func() {
+		var decoded []map[string]interface{}
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded))
+		s.Equal([]map[string]interface{}{
+			{"Name": "openshift-etcd", "Phase": "Active", "Pods": float64(2)},
+			{"Name": "openshift-monitoring", "Pods": float64(0)},
+		}, decoded)
+	})
+}
+
+func (s *MustGatherSuite) TestMustGatherClusterOperators() {
+	s.InitMcpClient()
+	toolResult, err := s.CallTool("mustgather_cluster_operators", map[string]interface{}{"path": s.path})
+	s.Run("no error", func() {
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+	})
+	s.Run("returns the operators reporting a problem first", func() {
+		var decoded []map[string]interface{}
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded))
+		s.Equal([]map[string]interface{}{
+			{"Name": "monitoring", "Version": "4.16.3", "Available": "False", "Progressing": "False", "Degraded": "True",
+				"Messages": map[string]interface{}{
+					"Available": "UpdatingPrometheusFailed: prometheus-k8s is not ready",
+					"Degraded":  "UpdatingPrometheusFailed: waiting for prometheus-k8s",
+				}},
+			{"Name": "etcd", "Version": "4.16.3", "Available": "True", "Progressing": "False", "Degraded": "False"},
+		}, decoded)
+	})
+}
+
+func (s *MustGatherSuite) TestMustGatherEventsList() {
+	s.InitMcpClient()
+	s.Run("mustgather_events_list returns the events of all namespaces oldest first", func() {
+		toolResult, err := s.CallTool("mustgather_events_list", map[string]interface{}{"path": s.path})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		var decoded []map[string]interface{}
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded))
+		s.Require().Len(decoded, 3)
+		s.Equal(map[string]interface{}{
+			"Namespace": "openshift-monitoring", "Type": "Warning", "Reason": "FailedScheduling", "Object": "Pod/prometheus-k8s-0",
+			"Count": float64(1), "Timestamp": "2025-01-02T00:30:00Z", "Message": "0/3 nodes are available",
+		}, decoded[0])
+		s.Equal("Started", decoded[1]["Reason"])
+		s.Equal("Unhealthy", decoded[2]["Reason"])
+	})
+	s.Run("mustgather_events_list(namespace=openshift-etcd, warnings_only=true)", func() {
+		toolResult, err := s.CallTool("mustgather_events_list", map[string]interface{}{
+			"path": s.path, "namespace": "openshift-etcd", "warnings_only": true,
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		var decoded []map[string]interface{}
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded))
+		s.Require().Len(decoded, 1)
+		s.Equal("Unhealthy", decoded[0]["Reason"])
+		s.Equal(float64(3), decoded[0]["Count"])
+	})
+}
+
+func (s *MustGatherSuite) TestMustGatherLogsGrep() {
+	s.InitMcpClient()
+	s.Run("mustgather_logs_grep with missing pattern returns error", func() {
+		toolResult, _ := s.CallTool("mustgather_logs_grep", map[string]interface{}{"path": s.path})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to search must-gather logs, missing argument pattern", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("mustgather_logs_grep(pattern=ERROR, pod=etcd-)", func() {
+		toolResult, err := s.CallTool("mustgather_logs_grep", map[string]interface{}{"path": s.path, "pattern": "ERROR", "pod": "etcd-"})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Equal("# The following 3 log lines match ERROR:\n"+
+			"openshift-etcd/etcd-master-0/etcd:2: 2025-01-02T02:00:00Z ERROR slow fdatasync\n"+
+			"openshift-etcd/etcd-master-0/etcd:3: 2025-01-02T02:00:01Z ERROR leader changed\n"+
+			"openshift-etcd/etcd-master-0/etcd (previous):1: 2025-01-01T00:00:00Z ERROR database space exceeded\n",
+			toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("mustgather_logs_grep(pattern=ERROR, max_matches=2) reports more matches", func() {
+		toolResult, err := s.CallTool("mustgather_logs_grep", map[string]interface{}{"path": s.path, "pattern": "ERROR", "max_matches": 2})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "# The following 2 log lines match ERROR:\n")
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "# More lines match, only the first 2 are shown")
+	})
+	s.Run("mustgather_logs_grep with invalid pattern returns error", func() {
+		toolResult, _ := s.CallTool("mustgather_logs_grep", map[string]interface{}{"path": s.path, "pattern": "("})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "failed to search must-gather logs, invalid pattern")
+	})
+}
+
+func TestMustGather(t *testing.T) {
+	suite.Run(t, new(MustGatherSuite))
+}
@@ -0,0 +1,177 @@
+package mcp
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type NamespacesCompareSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *NamespacesCompareSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "configmaps", Kind: "ConfigMap", Namespaced: true, Verbs: []string{"get", "list"}},
+			{Name: "secrets", Kind: "Secret", Namespaced: true, Verbs: []string{"get", "list"}},
+			{Name: "services", Kind: "Service", Namespaced: true, Verbs: []string{"get", "list"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/api/v1/namespaces/staging/configmaps":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "ConfigMapList", "items": [
+				{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "app-config", "namespace": "staging", "uid": "1", "resourceVersion": "10"},
+				 "data": {"LOG_LEVEL": "debug", "DB_HOST": "db.staging.svc.cluster.local"}},
+				{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "feature-flags", "namespace": "staging"}, "data": {"beta": "true"}},
+				{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "kube-root-ca.crt", "namespace": "staging"}, "data": {"ca.crt": "staging"}}
+			]}`))
+		case "/api/v1/namespaces/production/configmaps":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "ConfigMapList", "items": [
+				{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "app-config", "namespace": "production", "uid": "2", "resourceVersion": "20"},
+				 "data": {"LOG_LEVEL": "info", "DB_HOST": "db.production.svc.cluster.local"}},
+				{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "kube-root-ca.crt", "namespace": "production"}, "data": {"ca.crt": "production"}}
+			]}`))
+		case "/api/v1/namespaces/staging/secrets":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "SecretList", "items": [
+				{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "api-key", "namespace": "staging"}, "type": "Opaque", "data": {"key": "c3RhZ2luZy1rZXk="}},
+				{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "db-credentials", "namespace": "staging"}, "type": "Opaque", "data": {"password": "czNjcjN0"}},
+				{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "default-token", "namespace": "staging", "annotations": {"kubernetes.io/service-account.name": "default"}},
+				 "type": "kubernetes.io/service-account-token", "data": {"token": "c3RhZ2luZw=="}}
+			]}`))
+		case "/api/v1/namespaces/production/secrets":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "SecretList", "items": [
+				{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "api-key", "namespace": "production"}, "type": "Opaque", "data": {"key": "cHJvZHVjdGlvbi1rZXk="}},
+				{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "db-credentials", "namespace": "production"}, "type": "Opaque", "data": {"password": "czNjcjN0"}}
+			]}`))
+		case "/api/v1/namespaces/staging/services":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "ServiceList", "items": [
+				{"apiVersion": "v1", "kind": "Service", "metadata": {"name": "web", "namespace": "staging", "creationTimestamp": "2025-01-01T00:00:00Z"},
+				 "spec": {"clusterIP": "10.0.0.1", "clusterIPs": ["10.0.0.1"], "selector": {"app": "web"}, "ports": [{"port": 80}]},
+				 "status": {"loadBalancer": {}}}
+			]}`))
+		case "/api/v1/namespaces/production/services":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "ServiceList", "items": [
+				{"apiVersion": "v1", "kind": "Service", "metadata": {"name": "web", "namespace": "production", "creationTimestamp": "2025-02-01T00:00:00Z"},
+				 "spec": {"clusterIP": "10.0.0.2", "clusterIPs": ["10.0.0.2"], "selector": {"app": "web"}, "ports": [{"port": 80}]},
+				 "status": {"loadBalancer": {}}}
+			]}`))
+		}
+	}))
+}
+
+func (s *NamespacesCompareSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *NamespacesCompareSuite) TestNamespacesCompare() {
+	s.InitMcpClient()
+	s.Run("namespaces_compare with missing namespace_b returns error", func() {
+		toolResult, _ := s.CallTool("namespaces_compare", map[string]interface{}{"namespace_a": "staging"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to compare namespaces, missing argument namespace_b", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("namespaces_compare(namespace_a=staging, namespace_b=production)", func() {
+		toolResult, err := s.CallTool("namespaces_compare", map[string]interface{}{
+			"namespace_a": "staging",
+			"namespace_b": "production",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("reports the objects that drifted", func() {
+			s.Require().Lenf(decoded, 3, "expected 3 drifted objects, got %v", decoded)
+			s.Equal("ConfigMap", decoded[0]["Kind"])
+			s.Equal("app-config", decoded[0]["Name"])
+			s.Equal("differs", decoded[0]["Drift"])
+			s.Equal(map[string]interface{}{"Kind": "ConfigMap", "Name": "feature-flags", "Drift": "only in staging"}, decoded[1])
+			s.Equal("Secret", decoded[2]["Kind"])
+			s.Equal("api-key", decoded[2]["Name"])
+			s.Equal("differs", decoded[2]["Drift"])
+		})
+		s.Run("reports the changed configmap value only", func() {
+			s.Equal("--- staging/app-config\n"+
+				"+++ production/app-config\n"+
+				"@@ -1,7 +1,7 @@\n"+
+				" apiVersion: v1\n"+
+				" data:\n"+
+				"   DB_HOST: db.<namespace>.svc.cluster.local\n"+
+				"-  LOG_LEVEL: debug\n"+
+				"+  LOG_LEVEL: info\n"+
+				" kind: ConfigMap\n"+
+				" metadata:\n"+
+				"   name: app-config\n", decoded[0]["Diff"])
+		})
+		s.Run("does not reveal secret values", func() {
+			s.Contains(decoded[2]["Diff"], "key: hmac:")
+			s.NotContains(text, "c3RhZ2luZy1rZXk=")
+			s.NotContains(text, "cHJvZHVjdGlvbi1rZXk=")
+		})
+		s.Run("keys the secret hashes with a random key for each comparison", func() {
+			again, err := s.CallTool("namespaces_compare", map[string]interface{}{
+				"namespace_a": "staging",
+				"namespace_b": "production",
+			})
+			s.Require().Nilf(err, "call tool failed %v", err)
+			hashes := regexp.MustCompile(`key: hmac:[0-9a-f]+`)
+			first := hashes.FindAllString(text, -1)
+			second := hashes.FindAllString(again.Content[0].(mcp.TextContent).Text, -1)
+			s.Require().Len(first, 2)
+			s.Require().Len(second, 2)
+			s.NotEqual(first[0], first[1], "different values must have different hashes")
+			s.NotContains(second, first[0])
+			s.NotContains(second, first[1])
+		})
+		s.Run("ignores objects created in every namespace", func() {
+			s.NotContains(text, "kube-root-ca.crt")
+			s.NotContains(text, "default-token")
+		})
+	})
+	s.Run("namespaces_compare(namespace_a=staging, namespace_b=production, kinds=[Service])", func() {
+		toolResult, err := s.CallTool("namespaces_compare", map[string]interface{}{
+			"namespace_a": "staging",
+			"namespace_b": "production",
+			"kinds":       []interface{}{map[string]interface{}{"apiVersion": "v1", "kind": "Service"}},
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Equal("# No drift found between namespaces staging and production", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("namespaces_compare with kind not served returns error", func() {
+		toolResult, _ := s.CallTool("namespaces_compare", map[string]interface{}{
+			"namespace_a": "staging",
+			"namespace_b": "production",
+			"kinds":       []interface{}{map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment"}},
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "failed to compare namespaces staging and production:")
+	})
+}
+
+func TestNamespacesCompare(t *testing.T) {
+	suite.Run(t, new(NamespacesCompareSuite))
+}
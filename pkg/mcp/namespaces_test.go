@@ -1,8 +1,11 @@
 package mcp
 
 import (
+	"io"
+	"net/http"
 	"regexp"
 	"slices"
+	"strings"
 	"testing"
 
 	"github.com/BurntSushi/toml"
@@ -10,8 +13,10 @@ import (
 	"github.com/stretchr/testify/suite"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/yaml"
 
 	"github.com/containers/kubernetes-mcp-server/internal/test"
@@ -112,6 +117,365 @@ func TestNamespaces(t *testing.T) {
 	suite.Run(t, new(NamespacesSuite))
 }
 
+type NamespacesStuckSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *NamespacesStuckSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+}
+
+func (s *NamespacesStuckSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *NamespacesStuckSuite) TestNamespacesStuck() {
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/namespaces" {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "NamespaceList", "items": [
+			{"metadata": {"name": "active"}, "status": {"phase": "Active"}},
+			{"metadata": {"name": "stuck", "deletionTimestamp": "2025-01-02T03:04:05Z", "finalizers": ["example.com/custom-finalizer"]},
+			 "spec": {"finalizers": ["kubernetes"]},
+			 "status": {"phase": "Terminating", "conditions": [
+				{"type": "NamespaceDeletionDiscoveryFailure", "status": "False", "reason": "ResourcesDiscovered", "message": "All resources successfully discovered"},
+				{"type": "NamespaceContentRemaining", "status": "True", "reason": "SomeResourcesRemain", "message": "Some resources are remaining: widgets.example.com has 1 resource instances"},
+				{"type": "NamespaceFinalizersRemaining", "status": "True", "reason": "SomeFinalizersRemain", "message": "Some content in the namespace has finalizers remaining: example.com/widget-protection in 1 resource instances"}
+			 ]}}
+		]}`))
+	}))
+	s.InitMcpClient()
+	s.Run("namespaces_stuck", func() {
+		toolResult, err := s.CallTool("namespaces_stuck", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("reports only terminating namespaces", func() {
+			s.Require().Len(decoded, 1)
+			s.Equal("stuck", decoded[0]["Name"])
+			s.Equal("2025-01-02 03:04:05 +0000 UTC", decoded[0]["TerminatingSince"])
+		})
+		s.Run("reports blocking finalizers", func() {
+			s.Equal([]interface{}{"example.com/custom-finalizer"}, decoded[0]["Finalizers"])
+			s.Equal([]interface{}{"kubernetes"}, decoded[0]["SpecFinalizers"])
+		})
+		s.Run("reports blocking resources", func() {
+			s.Equal([]interface{}{
+				"NamespaceContentRemaining: Some resources are remaining: widgets.example.com has 1 resource instances",
+				"NamespaceFinalizersRemaining: Some content in the namespace has finalizers remaining: example.com/widget-protection in 1 resource instances",
+			}, decoded[0]["BlockingConditions"])
+		})
+		s.Run("provides commands to clear finalizers", func() {
+			s.Equal([]interface{}{
+				`kubectl patch namespace stuck --type=merge -p '{"metadata":{"finalizers":null}}'`,
+				`kubectl get namespace stuck -o json | jq '.spec.finalizers = []' | kubectl replace --raw /api/v1/namespaces/stuck/finalize -f -`,
+			}, decoded[0]["ClearCommands"])
+		})
+	})
+}
+
+func (s *NamespacesStuckSuite) TestNamespacesStuckNone() {
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/namespaces" {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "NamespaceList", "items": [
+			{"metadata": {"name": "active"}, "status": {"phase": "Active"}}
+		]}`))
+	}))
+	s.InitMcpClient()
+	s.Run("namespaces_stuck with no terminating namespaces", func() {
+		toolResult, err := s.CallTool("namespaces_stuck", map[string]interface{}{})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed")
+		s.Equal("# No namespaces stuck in Terminating phase found", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func TestNamespacesStuck(t *testing.T) {
+	suite.Run(t, new(NamespacesStuckSuite))
+}
+
+type NamespacesInventorySuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *NamespacesInventorySuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(
+		metav1.APIResourceList{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "configmaps", Kind: "ConfigMap", Namespaced: true, Verbs: []string{"get", "list"}},
+				{Name: "namespaces", Kind: "Namespace", Namespaced: false, Verbs: []string{"get", "list"}},
+				{Name: "persistentvolumeclaims", Kind: "PersistentVolumeClaim", Namespaced: true, Verbs: []string{"get", "list"}},
+				{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"get", "list"}},
+				{Name: "pods/log", Kind: "Pod", Namespaced: true, Verbs: []string{"get"}},
+				{Name: "secrets", Kind: "Secret", Namespaced: true, Verbs: []string{"get", "list"}},
+				{Name: "services", Kind: "Service", Namespaced: true, Verbs: []string{"get", "list"}},
+			},
+		},
+		metav1.APIResourceList{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "deployments", Kind: "Deployment", Namespaced: true, Verbs: []string{"get", "list"}},
+			},
+		},
+		metav1.APIResourceList{
+			GroupVersion: "batch/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "jobs", Kind: "Job", Namespaced: true, Verbs: []string{"get", "list"}},
+			},
+		},
+	))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/api/v1/namespaces/ns-1/pods":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "PodList", "items": [
+				{"metadata": {"name": "healthy"}, "status": {"phase": "Running", "containerStatuses": [{"name": "app", "state": {"running": {}}}]}},
+				{"metadata": {"name": "crashing"}, "status": {"phase": "Running", "containerStatuses": [{"name": "app", "state": {"waiting": {"reason": "CrashLoopBackOff"}}}]}},
+				{"metadata": {"name": "unschedulable"}, "status": {"phase": "Pending", "conditions": [{"type": "PodScheduled", "status": "False", "reason": "Unschedulable"}]}},
+				{"metadata": {"name": "evicted"}, "status": {"phase": "Failed", "reason": "Evicted"}}
+			]}`))
+		case "/api/v1/namespaces/ns-1/persistentvolumeclaims":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "PersistentVolumeClaimList", "items": [
+				{"metadata": {"name": "bound-pvc"}, "status": {"phase": "Bound"}},
+				{"metadata": {"name": "pending-pvc"}, "status": {"phase": "Pending"}}
+			]}`))
+		case "/api/v1/namespaces/ns-1/configmaps":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "ConfigMapList", "items": [
+				{"metadata": {"name": "cm-1"}}, {"metadata": {"name": "cm-2"}}, {"metadata": {"name": "kube-root-ca.crt"}}
+			]}`))
+		case "/api/v1/namespaces/ns-1/secrets", "/api/v1/namespaces/ns-1/services":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "List", "items": []}`))
+		case "/apis/apps/v1/namespaces/ns-1/deployments":
+			_, _ = w.Write([]byte(`{"apiVersion": "apps/v1", "kind": "DeploymentList", "items": [{"metadata": {"name": "web"}}]}`))
+		case "/apis/batch/v1/namespaces/ns-1/jobs":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","message":"jobs.batch is forbidden","reason":"Forbidden","code":403}`))
+		}
+	}))
+}
+
+func (s *NamespacesInventorySuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *NamespacesInventorySuite) TestNamespacesInventory() {
+	s.InitMcpClient()
+	s.Run("namespaces_inventory(namespace=ns-1)", func() {
+		toolResult, err := s.CallTool("namespaces_inventory", map[string]interface{}{
+			"namespace": "ns-1",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		var decoded map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("returns counts of the present namespaced kinds", func() {
+			s.Equal("ns-1", decoded["Namespace"])
+			s.Equal([]interface{}{
+				map[string]interface{}{"APIVersion": "v1", "Kind": "ConfigMap", "Count": float64(3)},
+				map[string]interface{}{"APIVersion": "apps/v1", "Kind": "Deployment", "Count": float64(1)},
+				map[string]interface{}{"APIVersion": "v1", "Kind": "PersistentVolumeClaim", "Count": float64(2)},
+				map[string]interface{}{"APIVersion": "v1", "Kind": "Pod", "Count": float64(4)},
+			}, decoded["Resources"])
+		})
+		s.Run("returns failing pods", func() {
+			s.Equal([]interface{}{"crashing: CrashLoopBackOff", "unschedulable: Unschedulable", "evicted: Evicted"}, decoded["FailingPods"])
+		})
+		s.Run("returns pending persistent volume claims", func() {
+			s.Equal([]interface{}{"pending-pvc"}, decoded["PendingPersistentVolumeClaims"])
+		})
+		s.Run("reports kinds that can't be listed", func() {
+			s.Equal([]interface{}{"batch/v1/Job: jobs.batch is forbidden"}, decoded["Unavailable"])
+		})
+	})
+}
+
+func (s *NamespacesInventorySuite) TestNamespacesInventoryDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { version = "v1", kind = "Pod" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("namespaces_inventory excludes denied resources", func() {
+		toolResult, err := s.CallTool("namespaces_inventory", map[string]interface{}{
+			"namespace": "ns-1",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed")
+		var decoded map[string]interface{}
+		s.Nilf(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "unmarshal failed")
+		s.NotContains(toolResult.Content[0].(mcp.TextContent).Text, "Kind: Pod\n")
+		s.NotContains(decoded, "FailingPods")
+	})
+}
+
+func TestNamespacesInventory(t *testing.T) {
+	suite.Run(t, new(NamespacesInventorySuite))
+}
+
+type NamespacesCreateSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+	created    map[string]*unstructured.Unstructured
+}
+
+func (s *NamespacesCreateSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.created = make(map[string]*unstructured.Unstructured)
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost || !strings.HasPrefix(req.URL.Path, "/api/v1/") {
+			return
+		}
+		body, _ := io.ReadAll(req.Body)
+		// Typed clients may send protobuf encoded objects
+		obj, _, err := scheme.Codecs.UniversalDeserializer().Decode(body, nil, nil)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		u, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		s.created[req.URL.Path] = &unstructured.Unstructured{Object: u}
+		test.WriteObject(w, obj)
+	}))
+}
+
+func (s *NamespacesCreateSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *NamespacesCreateSuite) TestNamespacesCreate() {
+	s.InitMcpClient()
+	s.Run("namespaces_create with name only", func() {
+		toolResult, err := s.CallTool("namespaces_create", map[string]interface{}{
+			"name": "team-a",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		s.Run("returns created namespace", func() {
+			s.Equal("# The following objects were created:\nNamespace team-a", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+		s.Run("creates only the namespace", func() {
+			s.Len(s.created, 1)
+			s.Require().Contains(s.created, "/api/v1/namespaces")
+			s.Equal("team-a", s.created["/api/v1/namespaces"].GetName())
+		})
+	})
+}
+
+func (s *NamespacesCreateSuite) TestNamespacesCreateWithQuotaAndLimitRange() {
+	s.InitMcpClient()
+	s.Run("namespaces_create with resource_quota and limit_range", func() {
+		toolResult, err := s.CallTool("namespaces_create", map[string]interface{}{
+			"name":        "team-b",
+			"labels":      map[string]interface{}{"team": "payments"},
+			"annotations": map[string]interface{}{"owner": "alice@example.com"},
+			"resource_quota": map[string]interface{}{
+				"cpu":    "4",
+				"memory": "8Gi",
+				"pods":   "20",
+			},
+			"limit_range": map[string]interface{}{
+				"default":         map[string]interface{}{"cpu": "500m", "memory": "512Mi"},
+				"default_request": map[string]interface{}{"cpu": "100m", "memory": "128Mi"},
+			},
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		s.Run("returns all created objects", func() {
+			s.Equal("# The following objects were created:\n"+
+				"Namespace team-b\n"+
+				"ResourceQuota team-b/default-quota\n"+
+				"LimitRange team-b/default-limit-range", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+		s.Run("creates namespace with labels and annotations", func() {
+			namespace := s.created["/api/v1/namespaces"]
+			s.Require().NotNil(namespace)
+			s.Equal(map[string]string{"team": "payments"}, namespace.GetLabels())
+			s.Equal(map[string]string{"owner": "alice@example.com"}, namespace.GetAnnotations())
+		})
+		s.Run("creates resource quota", func() {
+			resourceQuota := s.created["/api/v1/namespaces/team-b/resourcequotas"]
+			s.Require().NotNil(resourceQuota)
+			hard, _, _ := unstructured.NestedStringMap(resourceQuota.Object, "spec", "hard")
+			s.Equal(map[string]string{"cpu": "4", "memory": "8Gi", "pods": "20"}, hard)
+		})
+		s.Run("creates limit range", func() {
+			limitRange := s.created["/api/v1/namespaces/team-b/limitranges"]
+			s.Require().NotNil(limitRange)
+			limits, _, _ := unstructured.NestedSlice(limitRange.Object, "spec", "limits")
+			s.Require().Len(limits, 1)
+			limit := limits[0].(map[string]interface{})
+			s.Equal("Container", limit["type"])
+			s.Equal(map[string]interface{}{"cpu": "500m", "memory": "512Mi"}, limit["default"])
+			s.Equal(map[string]interface{}{"cpu": "100m", "memory": "128Mi"}, limit["defaultRequest"])
+		})
+	})
+}
+
+func (s *NamespacesCreateSuite) TestNamespacesCreateInvalidQuantity() {
+	s.InitMcpClient()
+	s.Run("namespaces_create with invalid quantity", func() {
+		toolResult, err := s.CallTool("namespaces_create", map[string]interface{}{
+			"name":           "team-c",
+			"resource_quota": map[string]interface{}{"memory": "lots"},
+		})
+		s.Run("returns error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "failed to create namespace team-c: invalid resource quota: memory:")
+		})
+		s.Run("creates no objects", func() {
+			s.Empty(s.created)
+		})
+	})
+	s.Run("namespaces_create with missing name", func() {
+		toolResult, _ := s.CallTool("namespaces_create", map[string]interface{}{})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to create namespace, missing argument name", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func TestNamespacesCreate(t *testing.T) {
+	suite.Run(t, new(NamespacesCreateSuite))
+}
+
 func TestProjectsListInOpenShift(t *testing.T) {
 	testCaseWithContext(t, &mcpContext{before: inOpenShift, after: inOpenShiftClear}, func(c *mcpContext) {
 		dynamicClient := dynamic.NewForConfigOrDie(envTestRestConfig)
@@ -0,0 +1,130 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type NetworkPoliciesSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *NetworkPoliciesSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/api/v1/namespaces/ns-1/pods/web":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Pod",
+				"metadata": {"name": "web", "namespace": "ns-1", "labels": {"app": "web", "tier": "frontend"}}}`))
+		case "/apis/networking.k8s.io/v1/namespaces/ns-1/networkpolicies":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "networking.k8s.io/v1", "kind": "NetworkPolicyList", "items": [
+				{"metadata": {"name": "allow-web", "namespace": "ns-1"},
+				 "spec": {"podSelector": {"matchLabels": {"app": "web"}}, "policyTypes": ["Ingress", "Egress"],
+				  "ingress": [
+				   {"from": [{"podSelector": {"matchLabels": {"app": "gateway"}}}, {"namespaceSelector": {"matchLabels": {"team": "ops"}}}],
+				    "ports": [{"protocol": "TCP", "port": 8080}]},
+				   {"from": [{"ipBlock": {"cidr": "10.0.0.0/8", "except": ["10.1.0.0/16"]}}]}
+				  ],
+				  "egress": [{"ports": [{"protocol": "UDP", "port": 53}, {"port": 5432, "endPort": 5440}]}]}},
+				{"metadata": {"name": "deny-db", "namespace": "ns-1"},
+				 "spec": {"podSelector": {"matchLabels": {"app": "db"}}, "policyTypes": ["Ingress"]}}
+			]}`))
+		}
+	}))
+}
+
+func (s *NetworkPoliciesSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *NetworkPoliciesSuite) TestNetworkPoliciesForPod() {
+	s.InitMcpClient()
+	s.Run("networkpolicies_for_pod(namespace=ns-1, name=web)", func() {
+		toolResult, err := s.CallTool("networkpolicies_for_pod", map[string]interface{}{
+			"namespace": "ns-1",
+			"name":      "web",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("reports pod isolation", func() {
+			s.Equal("web", decoded["Pod"])
+			s.Equal(true, decoded["IngressIsolated"])
+			s.Equal(true, decoded["EgressIsolated"])
+		})
+		s.Run("returns only the matching policy with its rules", func() {
+			s.Equal([]interface{}{map[string]interface{}{
+				"Name":        "allow-web",
+				"PodSelector": "app=web",
+				"PolicyTypes": []interface{}{"Ingress", "Egress"},
+				"Ingress": []interface{}{
+					"allow from pods (app=gateway) in the same namespace or pods (all) in namespaces (team=ops) on TCP/8080",
+					"allow from CIDR 10.0.0.0/8 except 10.1.0.0/16 on all ports",
+				},
+				"Egress": []interface{}{
+					"allow to anywhere on UDP/53, TCP/5432-5440",
+				},
+			}}, decoded["NetworkPolicies"])
+		})
+	})
+	s.Run("networkpolicies_for_pod(namespace=ns-1, labels=app=db)", func() {
+		toolResult, err := s.CallTool("networkpolicies_for_pod", map[string]interface{}{
+			"namespace": "ns-1",
+			"labels":    "app=db",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		var decoded map[string]interface{}
+		s.Nilf(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "unmarshal failed")
+		s.Run("matches policies by the provided labels", func() {
+			s.Equal(true, decoded["IngressIsolated"])
+			s.Equal(false, decoded["EgressIsolated"])
+			s.Equal([]interface{}{map[string]interface{}{
+				"Name":        "deny-db",
+				"PodSelector": "app=db",
+				"PolicyTypes": []interface{}{"Ingress"},
+				"Ingress":     []interface{}{"deny all ingress"},
+			}}, decoded["NetworkPolicies"])
+		})
+	})
+	s.Run("networkpolicies_for_pod(namespace=ns-1, labels=app=api) not matching any policy", func() {
+		toolResult, err := s.CallTool("networkpolicies_for_pod", map[string]interface{}{
+			"namespace": "ns-1",
+			"labels":    "app=api",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		var decoded map[string]interface{}
+		s.Nilf(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "unmarshal failed")
+		s.Equal(false, decoded["IngressIsolated"])
+		s.Empty(decoded["NetworkPolicies"])
+	})
+	s.Run("networkpolicies_for_pod() without name or labels", func() {
+		toolResult, _ := s.CallTool("networkpolicies_for_pod", map[string]interface{}{})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to get network policies for pod, missing argument name or labels", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func TestNetworkPolicies(t *testing.T) {
+	suite.Run(t, new(NetworkPoliciesSuite))
+}
@@ -1,14 +1,21 @@
 package mcp
 
 import (
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/BurntSushi/toml"
 	"github.com/containers/kubernetes-mcp-server/internal/test"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/suite"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
 )
 
 type NodesSuite struct {
@@ -331,6 +338,402 @@ func (s *NodesSuite) TestNodesStatsSummaryDenied() {
 	})
 }
 
+func (s *NodesSuite) TestNodesMaintenance() {
+	cordoned := make([]string, 0)
+	evicted := make(map[string]bool)
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case req.URL.Path == "/api/v1/nodes" && req.URL.Query().Get("labelSelector") == "maintenance=true":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "NodeList", "items": [
+				{"metadata": {"name": "node-3", "labels": {"maintenance": "true"}}, "status": {"conditions": [{"type": "Ready", "status": "True"}]}},
+				{"metadata": {"name": "node-1", "labels": {"maintenance": "true"}}, "status": {"conditions": [{"type": "Ready", "status": "True"}]}},
+				{"metadata": {"name": "node-2", "labels": {"maintenance": "true"}}, "status": {"conditions": [{"type": "Ready", "status": "True"}]}}
+			]}`))
+		case req.URL.Path == "/api/v1/nodes":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "NodeList", "items": [
+				{"metadata": {"name": "node-1"}, "status": {"conditions": [{"type": "Ready", "status": "True"}]}},
+				{"metadata": {"name": "node-2"}, "status": {"conditions": [{"type": "Ready", "status": "True"}]}},
+				{"metadata": {"name": "node-3"}, "status": {"conditions": [{"type": "Ready", "status": "True"}]}},
+				{"metadata": {"name": "node-4"}, "status": {"conditions": [{"type": "Ready", "status": "False"}]}}
+			]}`))
+		case req.Method == "PATCH" && strings.HasPrefix(req.URL.Path, "/api/v1/nodes/"):
+			name := strings.TrimPrefix(req.URL.Path, "/api/v1/nodes/")
+			cordoned = append(cordoned, name)
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Node", "metadata": {"name": "` + name + `"}, "spec": {"unschedulable": true}}`))
+		case req.URL.Path == "/api/v1/pods":
+			node := strings.TrimPrefix(req.URL.Query().Get("fieldSelector"), "spec.nodeName=")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "PodList", "items": [
+				{"metadata": {"name": "app-` + node + `", "namespace": "ns-1", "uid": "uid-` + node + `"}, "spec": {"nodeName": "` + node + `"}, "status": {"phase": "Running"}},
+				{"metadata": {"name": "agent-` + node + `", "namespace": "ns-1", "ownerReferences": [{"apiVersion": "apps/v1", "kind": "DaemonSet", "name": "agent", "uid": "ds", "controller": true}]},
+				 "spec": {"nodeName": "` + node + `"}, "status": {"phase": "Running"}}
+			]}`))
+		case req.Method == "POST" && strings.HasSuffix(req.URL.Path, "/eviction"):
+			pod := strings.TrimSuffix(strings.TrimPrefix(req.URL.Path, "/api/v1/namespaces/ns-1/pods/"), "/eviction")
+			if pod == "app-node-2" {
+				w.WriteHeader(http.StatusTooManyRequests)
+				_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Status", "status": "Failure", "reason": "TooManyRequests", "code": 429,
+					"message": "Cannot evict pod as it would violate the pod's disruption budget."}`))
+				return
+			}
+			evicted[pod] = true
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Status", "status": "Success", "code": 201}`))
+		case req.Method == "GET" && strings.HasPrefix(req.URL.Path, "/api/v1/namespaces/ns-1/pods/"):
+			pod := strings.TrimPrefix(req.URL.Path, "/api/v1/namespaces/ns-1/pods/")
+			if evicted[pod] {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Status", "status": "Failure", "reason": "NotFound", "code": 404}`))
+				return
+			}
+			node := strings.TrimPrefix(pod, "app-")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "` + pod + `", "namespace": "ns-1", "uid": "uid-` + node + `"}}`))
+		}
+	}))
+	s.InitMcpClient()
+	s.Run("nodes_maintenance(labelSelector=maintenance=true, max_unavailable=3) exceeding max_unavailable", func() {
+		toolResult, err := s.CallTool("nodes_maintenance", map[string]interface{}{
+			"labelSelector":   "maintenance=true",
+			"max_unavailable": 3,
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Run("refuses to drain", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Equal("failed to put nodes in maintenance: draining the 3 matching nodes (node-1, node-2, node-3) would leave 4 of 4 nodes unavailable, exceeding max_unavailable=3",
+				toolResult.Content[0].(mcp.TextContent).Text)
+		})
+		s.Run("doesn't cordon any node", func() {
+			s.Empty(cordoned)
+		})
+	})
+	s.Run("nodes_maintenance(labelSelector=maintenance=true, max_unavailable=4) with failing drain", func() {
+		toolResult, err := s.CallTool("nodes_maintenance", map[string]interface{}{
+			"labelSelector":   "maintenance=true",
+			"max_unavailable": 4,
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("reports the maintenance stopped", func() {
+			s.True(strings.HasPrefix(text, "# Node maintenance stopped after a failure, the following is the progress (YAML format) of the nodes:\n"))
+		})
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Require().Len(decoded, 3)
+		s.Run("drains the first node skipping DaemonSet pods", func() {
+			s.Equal(map[string]interface{}{
+				"Node":        "node-1",
+				"Cordoned":    true,
+				"EvictedPods": []interface{}{"ns-1/app-node-1"},
+				"Status":      "drained",
+			}, decoded[0])
+		})
+		s.Run("reports the failing drain of the second node", func() {
+			s.Equal("node-2", decoded[1]["Node"])
+			s.Equal(true, decoded[1]["Cordoned"])
+			s.Equal("failed", decoded[1]["Status"])
+			s.Contains(decoded[1]["Error"], "failed to evict pod ns-1/app-node-2")
+			s.Contains(decoded[1]["Error"], "disruption budget")
+		})
+		s.Run("stops before the third node", func() {
+			s.Equal(map[string]interface{}{"Node": "node-3", "Status": "skipped"}, decoded[2])
+			s.Equal([]string{"node-1", "node-2"}, cordoned)
+		})
+	})
+}
+
+func (s *NodesSuite) TestNodesList() {
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/nodes" {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "NodeList", "items": [
+			{"metadata": {"name": "worker-b", "creationTimestamp": "2025-03-01T00:00:00Z", "labels": {"node-role.kubernetes.io/worker": ""}},
+			 "status": {"allocatable": {"cpu": "16", "memory": "64Gi"}, "nodeInfo": {"kubeletVersion": "v1.31.4", "osImage": "Red Hat Enterprise Linux CoreOS 418"},
+			  "conditions": [{"type": "Ready", "status": "False"}]}},
+			{"metadata": {"name": "master-0", "creationTimestamp": "2025-01-01T00:00:00Z", "labels": {"node-role.kubernetes.io/control-plane": "", "node-role.kubernetes.io/master": ""}},
+			 "status": {"allocatable": {"cpu": "4", "memory": "16Gi"}, "nodeInfo": {"kubeletVersion": "v1.31.4", "osImage": "Red Hat Enterprise Linux CoreOS 418"},
+			  "conditions": [{"type": "Ready", "status": "True"}]}},
+			{"metadata": {"name": "worker-a", "creationTimestamp": "2025-02-01T00:00:00Z", "labels": {"node-role.kubernetes.io/worker": "", "node-role.kubernetes.io/infra": ""}},
+			 "spec": {"unschedulable": true},
+			 "status": {"allocatable": {"cpu": "8", "memory": "32Gi"}, "nodeInfo": {"kubeletVersion": "v1.30.8", "osImage": "Red Hat Enterprise Linux CoreOS 417"},
+			  "conditions": [{"type": "Ready", "status": "True"}]}}
+		]}`))
+	}))
+	s.InitMcpClient()
+	nodeNames := func(toolResult *mcp.CallToolResult) []string {
+		var decoded []map[string]interface{}
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded))
+		names := make([]string, 0, len(decoded))
+		for _, node := range decoded {
+			names = append(names, node["Name"].(string))
+		}
+		return names
+	}
+	s.Run("nodes_list()", func() {
+		toolResult, err := s.CallTool("nodes_list", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("sorts by name", func() {
+			s.Equal([]string{"master-0", "worker-a", "worker-b"}, nodeNames(toolResult))
+		})
+		s.Run("returns the node details", func() {
+			s.Require().Len(decoded, 3)
+			s.Equal([]interface{}{"control-plane"}, decoded[0]["Roles"])
+			s.Equal([]interface{}{"infra", "worker"}, decoded[1]["Roles"])
+			s.Equal(true, decoded[1]["Unschedulable"])
+			s.Equal("v1.30.8", decoded[1]["KubeletVersion"])
+			s.Equal("Red Hat Enterprise Linux CoreOS 417", decoded[1]["OSImage"])
+			s.Equal("8", decoded[1]["CPU"])
+			s.Equal("32Gi", decoded[1]["Memory"])
+			s.Equal("True", decoded[1]["Ready"])
+			s.Equal("False", decoded[2]["Ready"])
+			s.NotEmpty(decoded[2]["Age"])
+		})
+	})
+	s.Run("nodes_list(role=worker)", func() {
+		toolResult, err := s.CallTool("nodes_list", map[string]interface{}{"role": "worker"})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Equal([]string{"worker-a", "worker-b"}, nodeNames(toolResult))
+	})
+	s.Run("nodes_list(role=control-plane)", func() {
+		toolResult, err := s.CallTool("nodes_list", map[string]interface{}{"role": "control-plane"})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Equal([]string{"master-0"}, nodeNames(toolResult))
+	})
+	s.Run("nodes_list(sort_by=cpu)", func() {
+		toolResult, err := s.CallTool("nodes_list", map[string]interface{}{"sort_by": "cpu"})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Equal([]string{"worker-b", "worker-a", "master-0"}, nodeNames(toolResult))
+	})
+	s.Run("nodes_list(sort_by=age)", func() {
+		toolResult, err := s.CallTool("nodes_list", map[string]interface{}{"sort_by": "age"})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Equal([]string{"master-0", "worker-a", "worker-b"}, nodeNames(toolResult))
+	})
+	s.Run("nodes_list(role=infra) returns error", func() {
+		toolResult, _ := s.CallTool("nodes_list", map[string]interface{}{"role": "infra"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to list nodes: unsupported role infra, supported values are: control-plane, worker", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func (s *NodesSuite) handleTaintReport() {
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "apps/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "deployments", Kind: "Deployment", Namespaced: true, Verbs: []string{"get", "list"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/api/v1/nodes":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "NodeList", "items": [
+				{"metadata": {"name": "gpu-1"}, "spec": {"taints": [{"key": "nvidia.com/gpu", "value": "present", "effect": "NoSchedule"}]}},
+				{"metadata": {"name": "infra-1"}, "spec": {"taints": [{"key": "node-role.kubernetes.io/infra", "effect": "PreferNoSchedule"}]}},
+				{"metadata": {"name": "worker-1"}}
+			]}`))
+		case "/api/v1/namespaces/ns-1/pods":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "PodList", "items": [
+				{"metadata": {"name": "web", "namespace": "ns-1"}, "spec": {"containers": [{"name": "web", "image": "nginx"}]}, "status": {"phase": "Running"}},
+				{"metadata": {"name": "trainer", "namespace": "ns-1"}, "spec": {"containers": [{"name": "trainer", "image": "trainer"}],
+				 "tolerations": [{"key": "nvidia.com/gpu", "operator": "Exists", "effect": "NoSchedule"}]}, "status": {"phase": "Running"}},
+				{"metadata": {"name": "done", "namespace": "ns-1"}, "spec": {"containers": [{"name": "done", "image": "job"}]}, "status": {"phase": "Succeeded"}}
+			]}`))
+		case "/apis/apps/v1/namespaces/ns-1/deployments/trainer":
+			_, _ = w.Write([]byte(`{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "trainer", "namespace": "ns-1"},
+				"spec": {"template": {"spec": {"containers": [{"name": "trainer", "image": "trainer"}],
+				 "tolerations": [{"key": "nvidia.com/gpu", "operator": "Equal", "value": "present", "effect": "NoSchedule"}]}}}}`))
+		}
+	}))
+}
+
+func (s *NodesSuite) TestNodesTaintReport() {
+	s.handleTaintReport()
+	s.InitMcpClient()
+	s.Run("nodes_taint_report(namespace=ns-1)", func() {
+		toolResult, err := s.CallTool("nodes_taint_report", map[string]interface{}{"namespace": "ns-1"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("lists the node taints", func() {
+			s.Equal([]interface{}{
+				map[string]interface{}{"Node": "gpu-1", "Taints": []interface{}{"nvidia.com/gpu=present:NoSchedule"}},
+				map[string]interface{}{"Node": "infra-1", "Taints": []interface{}{"node-role.kubernetes.io/infra:PreferNoSchedule"}},
+			}, decoded["Taints"])
+		})
+		s.Run("reports the pod lacking the toleration", func() {
+			s.Equal([]interface{}{map[string]interface{}{
+				"Name": "Pod/web",
+				"ExcludedNodes": []interface{}{
+					map[string]interface{}{"Node": "gpu-1", "UntoleratedTaints": []interface{}{"nvidia.com/gpu=present:NoSchedule"}},
+				},
+				"EligibleNodes": float64(2),
+			}}, decoded["Mismatches"])
+		})
+	})
+	s.Run("nodes_taint_report(namespace=ns-1, kind=Deployment, name=trainer)", func() {
+		toolResult, err := s.CallTool("nodes_taint_report", map[string]interface{}{
+			"namespace": "ns-1",
+			"kind":      "Deployment",
+			"name":      "trainer",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		var decoded map[string]interface{}
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded))
+		s.Empty(decoded["Mismatches"], "the workload tolerates all the taints")
+	})
+	s.Run("nodes_taint_report with kind and no name returns error", func() {
+		toolResult, _ := s.CallTool("nodes_taint_report", map[string]interface{}{"kind": "Deployment"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to get node taint report, kind and name must be provided together", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func (s *NodesSuite) TestNodesTaintReportTruncated() {
+	s.handleTaintReport()
+	s.Cfg.MaxOutputBytes = 200
+	s.InitMcpClient()
+	toolResult, err := s.CallTool("nodes_taint_report", map[string]interface{}{"namespace": "ns-1"})
+	s.Run("no error", func() {
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+	})
+	text := toolResult.Content[0].(mcp.TextContent).Text
+	s.Run("truncates the report on line boundaries", func() {
+		s.LessOrEqual(len(text), 200)
+		s.True(strings.HasPrefix(text, "# The following node taint report (YAML format) was found:\nMismatches:\n"), text)
+		s.Regexp(`\n# \.\.\. output truncated, \d+ of \d+ lines shown\n$`, text)
+		s.NotContains(text, "items shown")
+	})
+}
+
+func (s *NodesSuite) TestNodesDebug() {
+	const longNodeName = "ip-10-0-130-12.us-east-2.compute.internal.cluster-a1b2c.example.com"
+	var applied []string
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"get", "list", "create", "patch"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case req.URL.Path == "/api/v1/nodes/node-1":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Node", "metadata": {"name": "node-1"}}`))
+		case req.URL.Path == "/api/v1/nodes/"+longNodeName:
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Node", "metadata": {"name": "` + longNodeName + `"}}`))
+		case req.Method == "PATCH" && strings.HasPrefix(req.URL.Path, "/api/v1/namespaces/ns-1/pods/"):
+			applied = append(applied, strings.TrimPrefix(req.URL.Path, "/api/v1/namespaces/ns-1/pods/"))
+			body, _ := io.ReadAll(req.Body)
+			_, _ = w.Write(body)
+		}
+	}))
+	s.InitMcpClient()
+	s.Run("nodes_debug(name=node-1, namespace=ns-1) generates the pod", func() {
+		toolResult, err := s.CallTool("nodes_debug", map[string]interface{}{"name": "node-1", "namespace": "ns-1"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("does not create the pod", func() {
+			s.True(strings.HasPrefix(text, "# The following node debug Pod (YAML format) was generated, nothing was created.\n"))
+			s.Empty(applied)
+		})
+		var decoded v1.Pod
+		err = yaml.Unmarshal([]byte(text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("pins the pod to the node with host namespaces", func() {
+			s.Equal("ns-1", decoded.Namespace)
+			s.True(strings.HasPrefix(decoded.Name, "node-1-debug-"))
+			s.Equal("node-1", decoded.Spec.NodeName)
+			s.True(decoded.Spec.HostNetwork)
+			s.True(decoded.Spec.HostPID)
+			s.Equal([]v1.Toleration{{Operator: v1.TolerationOpExists}}, decoded.Spec.Tolerations)
+		})
+		s.Run("mounts the host root filesystem", func() {
+			s.Require().Len(decoded.Spec.Volumes, 1)
+			s.Require().NotNil(decoded.Spec.Volumes[0].HostPath)
+			s.Equal("/", decoded.Spec.Volumes[0].HostPath.Path)
+			s.Require().Len(decoded.Spec.Containers, 1)
+			s.Equal([]v1.VolumeMount{{Name: "host", MountPath: "/host"}}, decoded.Spec.Containers[0].VolumeMounts)
+		})
+		s.Run("runs a privileged container", func() {
+			container := decoded.Spec.Containers[0]
+			s.Equal("registry.redhat.io/rhel9/support-tools", container.Image)
+			s.Require().NotNil(container.SecurityContext)
+			s.Equal(ptr.To(true), container.SecurityContext.Privileged)
+			s.Equal(ptr.To(int64(0)), container.SecurityContext.RunAsUser)
+		})
+	})
+	s.Run("nodes_debug(name=node-1, namespace=ns-1, image=custom, live=true) creates the pod", func() {
+		toolResult, err := s.CallTool("nodes_debug", map[string]interface{}{
+			"name":      "node-1",
+			"namespace": "ns-1",
+			"image":     "quay.io/example/tools:latest",
+			"live":      true,
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("creates the pod", func() {
+			s.Require().Len(applied, 1)
+			s.True(strings.HasPrefix(applied[0], "node-1-debug-"))
+		})
+		s.Run("returns the chroot command", func() {
+			s.Contains(text, "oc exec -it -n ns-1 "+applied[0]+" -- chroot /host")
+			s.Contains(text, "image: quay.io/example/tools:latest")
+		})
+	})
+	s.Run("nodes_debug(name=<long FQDN>) generates a pod name that fits in a label value", func() {
+		toolResult, err := s.CallTool("nodes_debug", map[string]interface{}{"name": longNodeName, "namespace": "ns-1"})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		var decoded v1.Pod
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded))
+		s.Equal(longNodeName, decoded.Spec.NodeName)
+		s.Regexp(`^ip-10-0-130-12-us-east-2-compute-internal-cluster-a-debug-[a-z0-9]{5}$`, decoded.Name)
+		s.Empty(validation.IsValidLabelValue(decoded.Labels["app.kubernetes.io/name"]))
+		s.Empty(validation.IsDNS1123Subdomain(decoded.Name))
+	})
+	s.Run("nodes_debug with missing name returns error", func() {
+		toolResult, _ := s.CallTool("nodes_debug", map[string]interface{}{})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to debug node, missing argument name", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
 func TestNodes(t *testing.T) {
 	suite.Run(t, new(NodesSuite))
 }
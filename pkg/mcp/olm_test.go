@@ -0,0 +1,141 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type OLMSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+const etcdOperatorCSV = `{"apiVersion": "operators.coreos.com/v1alpha1", "kind": "ClusterServiceVersion",
+	"metadata": {"name": "etcdoperator.v0.9.4", "namespace": "operators"},
+	"spec": {"displayName": "etcd", "version": "0.9.4", "replaces": "etcdoperator.v0.9.2", "provider": {"name": "CNCF"},
+	 "installModes": [
+	  {"type": "OwnNamespace", "supported": true}, {"type": "SingleNamespace", "supported": true},
+	  {"type": "MultiNamespace", "supported": false}, {"type": "AllNamespaces", "supported": true}
+	 ],
+	 "customresourcedefinitions": {"owned": [
+	  {"name": "etcdclusters.etcd.database.coreos.com", "version": "v1beta2", "kind": "EtcdCluster", "displayName": "etcd Cluster"},
+	  {"name": "etcdbackups.etcd.database.coreos.com", "version": "v1beta2", "kind": "EtcdBackup", "displayName": "etcd Backup"}
+	 ]},
+	 "install": {"strategy": "deployment", "spec": {"deployments": [{"name": "etcd-operator", "spec": {}}]}}},
+	"status": {"phase": "Succeeded", "reason": "InstallSucceeded", "message": "install strategy completed with no errors"}}`
+
+func (s *OLMSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/apis/operators.coreos.com/v1alpha1/namespaces/operators/clusterserviceversions/etcdoperator.v0.9.4":
+			_, _ = w.Write([]byte(etcdOperatorCSV))
+		case "/apis/operators.coreos.com/v1alpha1/namespaces/operators/clusterserviceversions":
+			_, _ = w.Write([]byte(`{"apiVersion": "operators.coreos.com/v1alpha1", "kind": "ClusterServiceVersionList", "items": [` + etcdOperatorCSV + `]}`))
+		case "/apis/operators.coreos.com/v1alpha1/namespaces/operators/clusterserviceversions/missing",
+			"/apis/operators.coreos.com/v1alpha1/namespaces/operators/clusterserviceversions/ETCD":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Status", "status": "Failure", "reason": "NotFound", "code": 404,
+				"message": "clusterserviceversions.operators.coreos.com not found"}`))
+		}
+	}))
+}
+
+func (s *OLMSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *OLMSuite) TestCSVGet() {
+	s.mockServer.Handle(test.NewInOpenShiftDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "operators.coreos.com/v1alpha1",
+		APIResources: []metav1.APIResource{
+			{Name: "clusterserviceversions", Kind: "ClusterServiceVersion", Namespaced: true, Verbs: []string{"get", "list"}},
+		},
+	}))
+	s.InitMcpClient()
+	s.Run("csv_get with missing name returns error", func() {
+		toolResult, _ := s.CallTool("csv_get", map[string]interface{}{"namespace": "operators"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to get cluster service version, missing argument name", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("csv_get(namespace=operators, name=etcdoperator.v0.9.4)", func() {
+		toolResult, err := s.CallTool("csv_get", map[string]interface{}{
+			"namespace": "operators",
+			"name":      "etcdoperator.v0.9.4",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("returns the phase and version", func() {
+			s.Equal("Succeeded", decoded["Phase"])
+			s.Equal("InstallSucceeded", decoded["Reason"])
+			s.Equal("0.9.4", decoded["Version"])
+			s.Equal("etcd", decoded["DisplayName"])
+			s.Equal("etcdoperator.v0.9.2", decoded["Replaces"])
+		})
+		s.Run("returns the supported install modes", func() {
+			s.Equal([]interface{}{"OwnNamespace", "SingleNamespace", "AllNamespaces"}, decoded["InstallModes"])
+		})
+		s.Run("returns the owned CRDs", func() {
+			s.Equal([]interface{}{
+				map[string]interface{}{"Name": "etcdclusters.etcd.database.coreos.com", "Version": "v1beta2", "Kind": "EtcdCluster", "DisplayName": "etcd Cluster"},
+				map[string]interface{}{"Name": "etcdbackups.etcd.database.coreos.com", "Version": "v1beta2", "Kind": "EtcdBackup", "DisplayName": "etcd Backup"},
+			}, decoded["OwnedCRDs"])
+			s.NotContains(decoded, "RequiredCRDs")
+		})
+		s.Run("returns the managed deployments", func() {
+			s.Equal([]interface{}{"etcd-operator"}, decoded["Deployments"])
+		})
+	})
+	s.Run("csv_get(namespace=operators, name=ETCD) matches the display name", func() {
+		toolResult, err := s.CallTool("csv_get", map[string]interface{}{
+			"namespace": "operators",
+			"name":      "ETCD",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "Name: etcdoperator.v0.9.4")
+	})
+	s.Run("csv_get(namespace=operators, name=missing) returns error", func() {
+		toolResult, _ := s.CallTool("csv_get", map[string]interface{}{
+			"namespace": "operators",
+			"name":      "missing",
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "failed to get cluster service version missing:")
+	})
+}
+
+func (s *OLMSuite) TestCSVGetNotInOpenShift() {
+	s.InitMcpClient()
+	s.Run("ListTools does not return csv_get", func() {
+		tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+		s.Require().NoError(err, "Expected no error from ListTools")
+		for _, tool := range tools.Tools {
+			s.NotEqual("csv_get", tool.Name)
+		}
+	})
+}
+
+func TestOLM(t *testing.T) {
+	suite.Run(t, new(OLMSuite))
+}
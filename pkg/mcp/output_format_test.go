@@ -0,0 +1,147 @@
+package mcp
+
+import (
+	"encoding/csv"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type OutputFormatSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *OutputFormatSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "events", Kind: "Event", Namespaced: true, Verbs: []string{"list"}},
+			{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"list"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/api/v1/namespaces/ns-1/events":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "EventList", "items": [
+				{"metadata": {"name": "web.1", "namespace": "ns-1"},
+				 "involvedObject": {"apiVersion": "v1", "kind": "Pod", "name": "web"},
+				 "type": "Warning", "reason": "BackOff", "firstTimestamp": "2025-01-02T03:04:05Z",
+				 "message": "Back-off restarting failed container \"app\", exit code 1\nsee the container logs"}
+			]}`))
+		case "/api/v1/namespaces/ns-2/events":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "EventList", "metadata": {"continue": "token-2"}, "items": [
+				{"metadata": {"name": "web.1", "namespace": "ns-2"}, "involvedObject": {"kind": "Pod", "name": "web-1"},
+				 "type": "Warning", "reason": "BackOff", "message": "Back-off restarting failed container\nsee the container logs"},
+				{"metadata": {"name": "web.2", "namespace": "ns-2"}, "involvedObject": {"kind": "Pod", "name": "web-2"},
+				 "type": "Warning", "reason": "BackOff", "message": "Back-off restarting failed container\nsee the container logs"},
+				{"metadata": {"name": "web.3", "namespace": "ns-2"}, "involvedObject": {"kind": "Pod", "name": "web-3"},
+				 "type": "Warning", "reason": "BackOff", "message": "Back-off restarting failed container\nsee the container logs"}
+			]}`))
+		case "/api/v1/namespaces/ns-1/pods":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "meta.k8s.io/v1", "kind": "Table",
+				"columnDefinitions": [{"name": "Name", "type": "string"}, {"name": "Status", "type": "string"}],
+				"rows": [{"cells": ["web", "Running"],
+				 "object": {"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "web", "namespace": "ns-1", "labels": {"app": "web", "tier": "frontend"}}}}]}`))
+		}
+	}))
+}
+
+func (s *OutputFormatSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *OutputFormatSuite) TestEventsListCsv() {
+	s.InitMcpClient()
+	s.Run("events_list(namespace=ns-1, output_format=csv)", func() {
+		toolResult, err := s.CallTool("events_list", map[string]interface{}{
+			"namespace":     "ns-1",
+			"output_format": "csv",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		s.Run("returns header and quoted rows", func() {
+			s.Equal("InvolvedObject.Kind,InvolvedObject.Name,InvolvedObject.apiVersion,Message,Namespace,Reason,Timestamp,Type\n"+
+				"Pod,web,v1,\"Back-off restarting failed container \"\"app\"\", exit code 1\nsee the container logs\",ns-1,BackOff,2025-01-02 03:04:05 +0000 UTC,Warning\n",
+				toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+}
+
+func (s *OutputFormatSuite) TestEventsListCsvTruncated() {
+	s.Cfg.MaxOutputBytes = 400
+	s.InitMcpClient()
+	s.Run("events_list(namespace=ns-2, output_format=csv) with truncated output and more results", func() {
+		toolResult, err := s.CallTool("events_list", map[string]interface{}{
+			"namespace":     "ns-2",
+			"output_format": "csv",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		s.Run("returns parseable csv with whole records only", func() {
+			s.Require().NotEmpty(toolResult.Content)
+			records, err := csv.NewReader(strings.NewReader(toolResult.Content[0].(mcp.TextContent).Text)).ReadAll()
+			s.Require().NoError(err)
+			s.Require().Len(records, 3, "expected the header and 2 of the 3 events")
+			s.Equal("Message", records[0][3])
+			s.Equal("Back-off restarting failed container\nsee the container logs", records[1][3])
+			s.Equal("web-2", records[2][1])
+		})
+		s.Run("returns truncation notice and continue token apart from the csv", func() {
+			s.Require().Len(toolResult.Content, 3)
+			s.Equal("Output truncated, 2 of 3 rows shown", toolResult.Content[1].(mcp.TextContent).Text)
+			s.Equal("More results are available, use the following continue token to retrieve the next page: token-2",
+				toolResult.Content[2].(mcp.TextContent).Text)
+		})
+	})
+}
+
+func (s *OutputFormatSuite) TestPodsListInNamespaceTsv() {
+	s.InitMcpClient()
+	s.Run("pods_list_in_namespace(namespace=ns-1, output_format=tsv)", func() {
+		toolResult, err := s.CallTool("pods_list_in_namespace", map[string]interface{}{
+			"namespace":     "ns-1",
+			"output_format": "tsv",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		s.Run("returns table rows as delimited rows", func() {
+			s.Equal("NAMESPACE\tAPIVERSION\tKIND\tNAME\tSTATUS\tLABELS\n"+
+				"ns-1\tv1\tPod\tweb\tRunning\tapp=web,tier=frontend\n",
+				toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+	s.Run("pods_list_in_namespace(namespace=ns-1, output_format=json)", func() {
+		toolResult, _ := s.CallTool("pods_list_in_namespace", map[string]interface{}{
+			"namespace":     "ns-1",
+			"output_format": "json",
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to list pods, invalid output_format json, valid formats are: yaml, table, csv, tsv", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func TestOutputFormat(t *testing.T) {
+	suite.Run(t, new(OutputFormatSuite))
+}
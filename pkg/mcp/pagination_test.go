@@ -0,0 +1,160 @@
+package mcp
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type PaginationSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+	// listQueries records the query parameters of every list request
+	listQueries []url.Values
+}
+
+func (s *PaginationSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.listQueries = nil
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "configmaps", Kind: "ConfigMap", Namespaced: true, Verbs: []string{"list"}},
+			{Name: "events", Kind: "Event", Namespaced: true, Verbs: []string{"list"}},
+			{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"list"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var kind string
+		switch req.URL.Path {
+		case "/api/v1/namespaces/ns-1/configmaps":
+			kind = "ConfigMap"
+		case "/api/v1/namespaces/ns-1/events":
+			kind = "Event"
+		case "/api/v1/namespaces/ns-1/pods":
+			kind = "Pod"
+		default:
+			return
+		}
+		s.listQueries = append(s.listQueries, req.URL.Query())
+		// First page returns item-1 and item-2 along with a continue token, second page returns item-3
+		names := []string{"item-1", "item-2"}
+		list := &unstructured.UnstructuredList{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       kind + "List",
+		}}
+		if req.URL.Query().Get("continue") == "token-page-2" {
+			names = []string{"item-3"}
+		} else if req.URL.Query().Get("limit") == "2" {
+			list.SetContinue("token-page-2")
+		}
+		for _, name := range names {
+			list.Items = append(list.Items, unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       kind,
+				"metadata":   map[string]interface{}{"name": name, "namespace": "ns-1"},
+				"message":    name,
+			}})
+		}
+		test.WriteObject(w, list)
+	}))
+}
+
+func (s *PaginationSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *PaginationSuite) TestPagination() {
+	s.InitMcpClient()
+	testCases := []struct {
+		tool      string
+		arguments map[string]interface{}
+	}{
+		{"resources_list", map[string]interface{}{"apiVersion": "v1", "kind": "ConfigMap", "namespace": "ns-1"}},
+		{"pods_list_in_namespace", map[string]interface{}{"namespace": "ns-1"}},
+		{"events_list", map[string]interface{}{"namespace": "ns-1"}},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.tool+" with limit", func() {
+			arguments := map[string]interface{}{"limit": 2}
+			for k, v := range tc.arguments {
+				arguments[k] = v
+			}
+			toolResult, err := s.CallTool(tc.tool, arguments)
+			s.Run("no error", func() {
+				s.Nilf(err, "call tool failed %v", err)
+				s.Falsef(toolResult.IsError, "call tool failed")
+			})
+			text := toolResult.Content[0].(mcp.TextContent).Text
+			s.Run("sends limit", func() {
+				s.Require().NotEmpty(s.listQueries)
+				s.Equal("2", s.listQueries[len(s.listQueries)-1].Get("limit"))
+				s.Equal("", s.listQueries[len(s.listQueries)-1].Get("continue"))
+			})
+			s.Run("returns first page", func() {
+				s.Contains(text, "item-1")
+				s.Contains(text, "item-2")
+				s.NotContains(text, "item-3")
+			})
+			s.Run("returns continue token", func() {
+				s.True(strings.HasSuffix(text, "# More results are available, use the following continue token to retrieve the next page: token-page-2\n"),
+					"expected continue token in result, got %s", text)
+			})
+			s.Run("returns valid yaml", func() {
+				var decoded interface{}
+				s.NoError(yaml.Unmarshal([]byte(text), &decoded))
+			})
+		})
+		s.Run(tc.tool+" with continue", func() {
+			arguments := map[string]interface{}{"limit": 2, "continue": "token-page-2"}
+			for k, v := range tc.arguments {
+				arguments[k] = v
+			}
+			toolResult, err := s.CallTool(tc.tool, arguments)
+			s.Run("no error", func() {
+				s.Nilf(err, "call tool failed %v", err)
+				s.Falsef(toolResult.IsError, "call tool failed")
+			})
+			text := toolResult.Content[0].(mcp.TextContent).Text
+			s.Run("sends continue token", func() {
+				s.Require().NotEmpty(s.listQueries)
+				s.Equal("token-page-2", s.listQueries[len(s.listQueries)-1].Get("continue"))
+			})
+			s.Run("returns next page", func() {
+				s.Contains(text, "item-3")
+				s.NotContains(text, "item-1")
+			})
+			s.Run("returns no continue token on last page", func() {
+				s.NotContains(text, "continue token")
+			})
+		})
+	}
+	s.Run("resources_list with invalid limit", func() {
+		toolResult, _ := s.CallTool("resources_list", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "namespace": "ns-1", "limit": "two",
+		})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Equal("failed to list resources, failed to parse limit parameter: expected integer, got string",
+				toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+}
+
+func TestPagination(t *testing.T) {
+	suite.Run(t, new(PaginationSuite))
+}
@@ -0,0 +1,165 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type PersistentVolumesSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *PersistentVolumesSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "persistentvolumeclaims", Kind: "PersistentVolumeClaim", Namespaced: true, Verbs: []string{"get", "list"}},
+			{Name: "persistentvolumes", Kind: "PersistentVolume", Namespaced: false, Verbs: []string{"get", "list"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/api/v1/namespaces/ns-1/persistentvolumeclaims":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "PersistentVolumeClaimList", "items": [
+				{"apiVersion": "v1", "kind": "PersistentVolumeClaim",
+				 "metadata": {"name": "bound-pvc", "namespace": "ns-1"},
+				 "spec": {"accessModes": ["ReadWriteOnce"], "resources": {"requests": {"storage": "1Gi"}}, "storageClassName": "standard", "volumeName": "pv-1"},
+				 "status": {"phase": "Bound", "accessModes": ["ReadWriteOnce"], "capacity": {"storage": "5Gi"}}},
+				{"apiVersion": "v1", "kind": "PersistentVolumeClaim",
+				 "metadata": {"name": "pending-pvc", "namespace": "ns-1"},
+				 "spec": {"accessModes": ["ReadWriteMany"], "resources": {"requests": {"storage": "10Gi"}}, "storageClassName": "missing"},
+				 "status": {"phase": "Pending"}}
+			]}`))
+		case "/api/v1/namespaces/empty/persistentvolumeclaims":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "PersistentVolumeClaimList", "items": []}`))
+		case "/api/v1/persistentvolumes/pv-1":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "PersistentVolume",
+				"metadata": {"name": "pv-1"},
+				"spec": {"accessModes": ["ReadWriteOnce"], "capacity": {"storage": "5Gi"}, "storageClassName": "standard",
+				 "persistentVolumeReclaimPolicy": "Delete", "claimRef": {"namespace": "ns-1", "name": "bound-pvc"},
+				 "hostPath": {"path": "/mnt/data", "type": "DirectoryOrCreate"}},
+				"status": {"phase": "Bound"}}`))
+		}
+	}))
+}
+
+func (s *PersistentVolumesSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *PersistentVolumesSuite) TestPvcList() {
+	s.InitMcpClient()
+	s.Run("pvc_list(namespace=ns-1)", func() {
+		toolResult, err := s.CallTool("pvc_list", map[string]interface{}{
+			"namespace": "ns-1",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+			s.Require().Len(decoded, 2)
+		})
+		s.Run("reports bound claim", func() {
+			s.Equal(map[string]interface{}{
+				"Namespace":         "ns-1",
+				"Name":              "bound-pvc",
+				"Phase":             "Bound",
+				"RequestedCapacity": "1Gi",
+				"Capacity":          "5Gi",
+				"StorageClass":      "standard",
+				"AccessModes":       []interface{}{"ReadWriteOnce"},
+				"VolumeName":        "pv-1",
+			}, decoded[0])
+		})
+		s.Run("reports pending claim", func() {
+			s.Equal(map[string]interface{}{
+				"Namespace":         "ns-1",
+				"Name":              "pending-pvc",
+				"Phase":             "Pending",
+				"RequestedCapacity": "10Gi",
+				"Capacity":          "",
+				"StorageClass":      "missing",
+				"AccessModes":       []interface{}{"ReadWriteMany"},
+				"VolumeName":        "",
+			}, decoded[1])
+		})
+	})
+	s.Run("pvc_list(namespace=empty)", func() {
+		toolResult, err := s.CallTool("pvc_list", map[string]interface{}{
+			"namespace": "empty",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		s.Run("returns no claims message", func() {
+			s.Equal("# No persistent volume claims found", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+}
+
+func (s *PersistentVolumesSuite) TestPvGet() {
+	s.InitMcpClient()
+	s.Run("pv_get(name=nil)", func() {
+		toolResult, err := s.CallTool("pv_get", map[string]interface{}{})
+		s.Require().NotNil(toolResult, "toolResult should not be nil")
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes missing name", func() {
+			s.Equal("failed to get persistent volume, missing argument name", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+	s.Run("pv_get(name=pv-1)", func() {
+		toolResult, err := s.CallTool("pv_get", map[string]interface{}{
+			"name": "pv-1",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		var decoded map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("reports volume details", func() {
+			s.Equal("pv-1", decoded["Name"])
+			s.Equal("Bound", decoded["Phase"])
+			s.Equal("5Gi", decoded["Capacity"])
+			s.Equal("Delete", decoded["ReclaimPolicy"])
+			s.Equal("ns-1/bound-pvc", decoded["Claim"])
+		})
+		s.Run("reports volume source", func() {
+			s.Equal(map[string]interface{}{
+				"hostPath": map[string]interface{}{"path": "/mnt/data", "type": "DirectoryOrCreate"},
+			}, decoded["VolumeSource"])
+		})
+	})
+}
+
+func TestPersistentVolumes(t *testing.T) {
+	suite.Run(t, new(PersistentVolumesSuite))
+}
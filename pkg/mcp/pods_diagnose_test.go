@@ -0,0 +1,145 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type PodsDiagnoseSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+	// eventsFieldSelector records the field selector used to list the Pod events
+	eventsFieldSelector string
+}
+
+func (s *PodsDiagnoseSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.eventsFieldSelector = ""
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/api/v1/namespaces/default/pods/crash-looping":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Pod",
+				"metadata": {"name": "crash-looping", "namespace": "default", "uid": "pod-uid"},
+				"spec": {"nodeName": "node-1", "containers": [
+					{"name": "app", "image": "app:latest",
+					 "readinessProbe": {"httpGet": {"path": "/ready", "port": 8080}, "periodSeconds": 10},
+					 "livenessProbe": {"tcpSocket": {"port": 8080}, "initialDelaySeconds": 5, "failureThreshold": 3}},
+					{"name": "sidecar", "image": "sidecar:latest"}
+				]},
+				"status": {"phase": "Running", "containerStatuses": [
+					{"name": "app", "ready": false, "restartCount": 7,
+					 "state": {"waiting": {"reason": "CrashLoopBackOff", "message": "back-off 5m0s restarting failed container"}},
+					 "lastState": {"terminated": {"reason": "OOMKilled", "exitCode": 137, "finishedAt": "2025-01-02T03:04:05Z"}}},
+					{"name": "sidecar", "ready": true, "restartCount": 0,
+					 "state": {"running": {"startedAt": "2025-01-02T00:00:00Z"}}}
+				]}}`))
+		case "/api/v1/namespaces/default/events":
+			s.eventsFieldSelector = req.URL.Query().Get("fieldSelector")
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "EventList", "items": [
+				{"metadata": {"name": "e1", "namespace": "default"}, "type": "Warning", "reason": "BackOff",
+				 "message": "Back-off restarting failed container app", "count": 5, "lastTimestamp": "2025-01-02T03:10:00Z",
+				 "involvedObject": {"kind": "Pod", "name": "crash-looping", "uid": "pod-uid"}},
+				{"metadata": {"name": "e2", "namespace": "default"}, "type": "Warning", "reason": "Unhealthy",
+				 "message": "Liveness probe failed: dial tcp 10.0.0.1:8080: connect: connection refused", "firstTimestamp": "2025-01-02T03:00:00Z",
+				 "involvedObject": {"kind": "Pod", "name": "crash-looping", "uid": "pod-uid"}},
+				{"metadata": {"name": "e3", "namespace": "default"}, "type": "Warning", "reason": "FailedMount",
+				 "message": "stale event of a previous pod", "firstTimestamp": "2025-01-01T00:00:00Z",
+				 "involvedObject": {"kind": "Pod", "name": "crash-looping", "uid": "previous-pod-uid"}}
+			]}`))
+		}
+	}))
+}
+
+func (s *PodsDiagnoseSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *PodsDiagnoseSuite) TestPodsDiagnose() {
+	s.InitMcpClient()
+	s.Run("pods_diagnose(name=crash-looping)", func() {
+		toolResult, err := s.CallTool("pods_diagnose", map[string]interface{}{
+			"name": "crash-looping",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("returns pod phase", func() {
+			s.Equal("default", decoded["Namespace"])
+			s.Equal("crash-looping", decoded["Name"])
+			s.Equal("Running", decoded["Phase"])
+			s.Equal("node-1", decoded["Node"])
+		})
+		s.Require().Len(decoded["Containers"], 2)
+		app := decoded["Containers"].([]interface{})[0].(map[string]interface{})
+		s.Run("returns container state and last state with exit code and reason", func() {
+			s.Equal("app", app["Name"])
+			s.Equal(false, app["Ready"])
+			s.Equal(float64(7), app["Restarts"])
+			s.Equal(map[string]interface{}{
+				"Waiting": true, "Reason": "CrashLoopBackOff", "Message": "back-off 5m0s restarting failed container",
+			}, app["State"])
+			s.Equal(map[string]interface{}{
+				"Terminated": true, "Reason": "OOMKilled", "ExitCode": float64(137), "FinishedAt": "2025-01-02T03:04:05Z",
+			}, app["LastState"])
+		})
+		s.Run("returns probe configuration", func() {
+			s.Equal(map[string]interface{}{
+				"httpGet": map[string]interface{}{"path": "/ready", "port": float64(8080)}, "periodSeconds": float64(10),
+			}, app["ReadinessProbe"])
+			s.Equal(map[string]interface{}{
+				"tcpSocket": map[string]interface{}{"port": float64(8080)}, "initialDelaySeconds": float64(5), "failureThreshold": float64(3),
+			}, app["LivenessProbe"])
+			s.NotContains(app, "StartupProbe")
+		})
+		s.Run("returns healthy containers without probes", func() {
+			sidecar := decoded["Containers"].([]interface{})[1].(map[string]interface{})
+			s.Equal("sidecar", sidecar["Name"])
+			s.Equal(map[string]interface{}{"Running": true, "StartedAt": "2025-01-02T00:00:00Z"}, sidecar["State"])
+			s.NotContains(sidecar, "LastState")
+			s.NotContains(sidecar, "ReadinessProbe")
+			s.NotContains(sidecar, "LivenessProbe")
+		})
+		s.Run("lists Warning events of the pod", func() {
+			s.Contains(s.eventsFieldSelector, "involvedObject.name=crash-looping")
+			s.Contains(s.eventsFieldSelector, "type=Warning")
+		})
+		s.Run("returns recent Warning events of the pod, most recent first", func() {
+			s.Equal([]interface{}{
+				map[string]interface{}{"Timestamp": "2025-01-02T03:10:00Z", "Count": float64(5), "Reason": "BackOff",
+					"Message": "Back-off restarting failed container app"},
+				map[string]interface{}{"Timestamp": "2025-01-02T03:00:00Z", "Count": float64(1), "Reason": "Unhealthy",
+					"Message": "Liveness probe failed: dial tcp 10.0.0.1:8080: connect: connection refused"},
+			}, decoded["WarningEvents"])
+		})
+	})
+	s.Run("pods_diagnose(name=missing)", func() {
+		toolResult, _ := s.CallTool("pods_diagnose", map[string]interface{}{
+			"name": "missing",
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "failed to diagnose pod missing in namespace")
+	})
+}
+
+func TestPodsDiagnose(t *testing.T) {
+	suite.Run(t, new(PodsDiagnoseSuite))
+}
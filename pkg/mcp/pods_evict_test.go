@@ -0,0 +1,117 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type PodsEvictSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *PodsEvictSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "policy/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "poddisruptionbudgets", Kind: "PodDisruptionBudget", Namespaced: true, Verbs: []string{"get", "list"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/api/v1/namespaces/ns-1/pods/web-1":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "web-1", "namespace": "ns-1", "labels": {"app": "web"}}}`))
+		case "/api/v1/namespaces/ns-1/pods/batch-1":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "batch-1", "namespace": "ns-1", "labels": {"app": "batch"}}}`))
+		case "/api/v1/namespaces/ns-1/pods/web-1/eviction":
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Status", "status": "Failure", "reason": "TooManyRequests", "code": 429,
+				"message": "Cannot evict pod as it would violate the pod's disruption budget.",
+				"details": {"causes": [{"reason": "DisruptionBudget", "message": "The disruption budget web-pdb needs 2 healthy pods and has 2 currently"}]}}`))
+		case "/api/v1/namespaces/ns-1/pods/batch-1/eviction":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Status", "status": "Success", "code": 201}`))
+		case "/apis/policy/v1/namespaces/ns-1/poddisruptionbudgets":
+			_, _ = w.Write([]byte(`{"apiVersion": "policy/v1", "kind": "PodDisruptionBudgetList", "items": [
+				{"apiVersion": "policy/v1", "kind": "PodDisruptionBudget", "metadata": {"name": "db-pdb", "namespace": "ns-1"},
+				 "spec": {"minAvailable": 1, "selector": {"matchLabels": {"app": "db"}}}},
+				{"apiVersion": "policy/v1", "kind": "PodDisruptionBudget", "metadata": {"name": "web-pdb", "namespace": "ns-1"},
+				 "spec": {"minAvailable": 2, "selector": {"matchLabels": {"app": "web"}}}}
+			]}`))
+		}
+	}))
+}
+
+func (s *PodsEvictSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *PodsEvictSuite) TestPodsEvict() {
+	s.InitMcpClient()
+	s.Run("pods_evict(namespace=ns-1, name=web-1) blocked by PDB", func() {
+		toolResult, err := s.CallTool("pods_evict", map[string]interface{}{
+			"namespace": "ns-1",
+			"name":      "web-1",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("reports the eviction was blocked", func() {
+			s.Contains(text, "# The pod eviction was blocked by a PodDisruptionBudget (YAML format):\n")
+		})
+		var decoded map[string]interface{}
+		err = yaml.Unmarshal([]byte(text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("reports the blocking PDB", func() {
+			s.Equal(map[string]interface{}{
+				"Namespace": "ns-1",
+				"Name":      "web-1",
+				"Evicted":   false,
+				"BlockedBy": []interface{}{"web-pdb"},
+				"Reason":    "The disruption budget web-pdb needs 2 healthy pods and has 2 currently",
+			}, decoded)
+		})
+	})
+	s.Run("pods_evict(namespace=ns-1, name=batch-1) allowed", func() {
+		toolResult, err := s.CallTool("pods_evict", map[string]interface{}{
+			"namespace": "ns-1",
+			"name":      "batch-1",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		var decoded map[string]interface{}
+		s.Nilf(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "unmarshal failed")
+		s.Run("reports the pod was evicted", func() {
+			s.Equal(map[string]interface{}{"Namespace": "ns-1", "Name": "batch-1", "Evicted": true}, decoded)
+		})
+	})
+	s.Run("pods_evict(namespace=ns-1, name=missing)", func() {
+		toolResult, _ := s.CallTool("pods_evict", map[string]interface{}{
+			"namespace": "ns-1",
+			"name":      "missing",
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+	})
+}
+
+func TestPodsEvict(t *testing.T) {
+	suite.Run(t, new(PodsEvictSuite))
+}
@@ -0,0 +1,128 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type PodsImagePullErrorsSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *PodsImagePullErrorsSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"get", "list"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/apis/authorization.k8s.io/v1/selfsubjectaccessreviews":
+			// Allow listing pods in all namespaces
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "authorization.k8s.io/v1", "kind": "SelfSubjectAccessReview", "status": {"allowed": true}}`))
+		case "/api/v1/pods":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "PodList", "items": [
+				{"metadata": {"name": "web-1", "namespace": "ns-1"},
+				 "spec": {"containers": [{"name": "web", "image": "nginx:1.99-typo"}]},
+				 "status": {"phase": "Pending", "containerStatuses": [
+					{"name": "web", "image": "nginx:1.99-typo", "state": {"waiting": {"reason": "ImagePullBackOff", "message": "Back-off pulling image \"nginx:1.99-typo\""}}}
+				 ]}},
+				{"metadata": {"name": "web-2", "namespace": "ns-1"},
+				 "spec": {"containers": [{"name": "web", "image": "nginx:1.99-typo"}]},
+				 "status": {"phase": "Pending", "containerStatuses": [
+					{"name": "web", "image": "nginx:1.99-typo", "state": {"waiting": {"reason": "ErrImagePull", "message": "rpc error: code = NotFound desc = failed to pull and unpack image \"docker.io/library/nginx:1.99-typo\": not found"}}}
+				 ]}},
+				{"metadata": {"name": "web-3", "namespace": "ns-2"},
+				 "spec": {"containers": [{"name": "web", "image": "nginx:1.99-typo"}]},
+				 "status": {"phase": "Pending", "containerStatuses": [
+					{"name": "web", "image": "nginx:1.99-typo", "state": {"waiting": {"reason": "ImagePullBackOff", "message": "Back-off pulling image \"nginx:1.99-typo\""}}}
+				 ]}},
+				{"metadata": {"name": "private", "namespace": "ns-2"},
+				 "spec": {"imagePullSecrets": [{"name": "quay-creds"}],
+				  "initContainers": [{"name": "migrate", "image": "quay.io/acme/migrate:v2"}],
+				  "containers": [{"name": "app", "image": "quay.io/acme/app:v2"}]},
+				 "status": {"phase": "Pending",
+				  "initContainerStatuses": [{"name": "migrate", "image": "quay.io/acme/migrate:v2",
+				   "state": {"waiting": {"reason": "ErrImagePull", "message": "unauthorized: access to the requested resource is not authorized"}}}],
+				  "containerStatuses": [{"name": "app", "image": "quay.io/acme/app:v2", "state": {"waiting": {"reason": "PodInitializing"}}}]}},
+				{"metadata": {"name": "healthy", "namespace": "ns-2"},
+				 "spec": {"containers": [{"name": "app", "image": "registry.example.com:5000/app:v1"}]},
+				 "status": {"phase": "Running", "containerStatuses": [{"name": "app", "state": {"running": {}}}]}}
+			]}`))
+		case "/api/v1/namespaces/ns-3/pods":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "PodList", "items": []}`))
+		}
+	}))
+}
+
+func (s *PodsImagePullErrorsSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *PodsImagePullErrorsSuite) TestPodsImagePullErrors() {
+	s.InitMcpClient()
+	s.Run("pods_imagepull_errors()", func() {
+		toolResult, err := s.CallTool("pods_imagepull_errors", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Require().Len(decoded, 2)
+		s.Run("reports a broken image used by many pods once", func() {
+			s.Equal(map[string]interface{}{
+				"Image":        "nginx:1.99-typo",
+				"Registry":     "docker.io",
+				"Reasons":      []interface{}{"ErrImagePull", "ImagePullBackOff"},
+				"Message":      "rpc error: code = NotFound desc = failed to pull and unpack image \"docker.io/library/nginx:1.99-typo\": not found",
+				"RequiresAuth": false,
+				"Containers":   []interface{}{"ns-1/web-1 (web)", "ns-1/web-2 (web)", "ns-2/web-3 (web)"},
+			}, decoded[0])
+		})
+		s.Run("reports images from registries requiring authentication", func() {
+			s.Equal(map[string]interface{}{
+				"Image":        "quay.io/acme/migrate:v2",
+				"Registry":     "quay.io",
+				"Reasons":      []interface{}{"ErrImagePull"},
+				"Message":      "unauthorized: access to the requested resource is not authorized",
+				"RequiresAuth": true,
+				"PullSecrets":  []interface{}{"quay-creds"},
+				"Containers":   []interface{}{"ns-2/private (migrate)"},
+			}, decoded[1])
+		})
+	})
+	s.Run("pods_imagepull_errors(namespace=ns-3) without errors", func() {
+		toolResult, err := s.CallTool("pods_imagepull_errors", map[string]interface{}{
+			"namespace": "ns-3",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Equal("# No image pull errors found", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func TestPodsImagePullErrors(t *testing.T) {
+	suite.Run(t, new(PodsImagePullErrorsSuite))
+}
@@ -0,0 +1,169 @@
+package mcp
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type PodsNotReadySuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *PodsNotReadySuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"get", "list"}},
+		},
+	}))
+	notReadySince := time.Now().Add(-10 * time.Minute).UTC().Format(time.RFC3339)
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/api/v1/namespaces/ns-1/pods":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(strings.ReplaceAll(`{"apiVersion": "v1", "kind": "PodList", "items": [
+				{"metadata": {"name": "web-1", "namespace": "ns-1"},
+				 "spec": {"containers": [
+				  {"name": "web", "image": "nginx", "readinessProbe": {"httpGet": {"path": "/healthz", "port": 8080},
+				   "timeoutSeconds": 1, "periodSeconds": 10, "successThreshold": 1, "failureThreshold": 3}},
+				  {"name": "proxy", "image": "envoy"}
+				 ]},
+				 "status": {"phase": "Running",
+				  "conditions": [{"type": "Ready", "status": "False", "reason": "ContainersNotReady",
+				   "message": "containers with unready status: [web]", "lastTransitionTime": "NOT_READY_SINCE"}],
+				  "containerStatuses": [
+				   {"name": "web", "ready": false, "restartCount": 0, "state": {"running": {}}},
+				   {"name": "proxy", "ready": true, "restartCount": 0, "state": {"running": {}}}
+				  ]}},
+				{"metadata": {"name": "web-2", "namespace": "ns-1"},
+				 "spec": {"containers": [{"name": "web", "image": "nginx"}]},
+				 "status": {"phase": "Running",
+				  "conditions": [{"type": "Ready", "status": "True"}],
+				  "containerStatuses": [{"name": "web", "ready": true, "state": {"running": {}}}]}},
+				{"metadata": {"name": "gated", "namespace": "ns-1"},
+				 "spec": {"readinessGates": [{"conditionType": "target-health.example.com/registered"}],
+				  "containers": [{"name": "app", "image": "app"}]},
+				 "status": {"phase": "Running",
+				  "conditions": [{"type": "Ready", "status": "False", "reason": "ReadinessGatesNotReady",
+				   "message": "corresponding condition of pod readiness gate \"target-health.example.com/registered\" does not exist.",
+				   "lastTransitionTime": "NOT_READY_SINCE"}],
+				  "containerStatuses": [{"name": "app", "ready": true, "state": {"running": {}}}]}},
+				{"metadata": {"name": "pending", "namespace": "ns-1"},
+				 "spec": {"containers": [{"name": "app", "image": "app"}]},
+				 "status": {"phase": "Pending", "conditions": [{"type": "Ready", "status": "False"}]}}
+			]}`, "NOT_READY_SINCE", notReadySince)))
+		case "/api/v1/namespaces/ns-2/pods":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "PodList", "items": []}`))
+		}
+	}))
+}
+
+func (s *PodsNotReadySuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *PodsNotReadySuite) TestPodsNotReady() {
+	s.InitMcpClient()
+	s.Run("pods_not_ready(namespace=ns-1)", func() {
+		toolResult, err := s.CallTool("pods_not_ready", map[string]interface{}{
+			"namespace": "ns-1",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Require().Len(decoded, 2, "only running but not ready pods should be reported")
+		s.Run("reports the pods with failing readiness gates", func() {
+			s.Equal("gated", decoded[0]["Name"])
+			s.Equal([]interface{}{"target-health.example.com/registered"}, decoded[0]["FailingReadinessGates"])
+			s.Nil(decoded[0]["Containers"])
+		})
+		s.Run("reports the failing container and its readiness probe", func() {
+			s.Equal("web-1", decoded[1]["Name"])
+			s.Equal("ContainersNotReady", decoded[1]["Reason"])
+			s.Equal([]interface{}{map[string]interface{}{
+				"Name":           "web",
+				"Restarts":       float64(0),
+				"State":          "Running",
+				"ReadinessProbe": "http-get http://:8080/healthz delay=0s timeout=1s period=10s #success=1 #failure=3",
+			}}, decoded[1]["Containers"])
+		})
+		s.Run("reports for how long the pod has been not ready", func() {
+			s.NotEmpty(decoded[1]["NotReadySince"])
+			notReadyFor, err := time.ParseDuration(decoded[1]["NotReadyFor"].(string))
+			s.Nilf(err, "invalid duration %v", err)
+			s.GreaterOrEqual(notReadyFor, 10*time.Minute)
+			s.Less(notReadyFor, 11*time.Minute)
+		})
+	})
+	s.Run("pods_not_ready(namespace=ns-2) without not ready pods", func() {
+		toolResult, err := s.CallTool("pods_not_ready", map[string]interface{}{
+			"namespace": "ns-2",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Equal("# No running but not ready pods found", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func (s *PodsNotReadySuite) TestPodsNotReadyPartialResults() {
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/apis/authorization.k8s.io/v1/selfsubjectaccessreviews":
+			_, _ = w.Write([]byte(`{"apiVersion": "authorization.k8s.io/v1", "kind": "SelfSubjectAccessReview", "status": {"allowed": false}}`))
+		case "/api/v1/namespaces":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "NamespaceList", "items": [
+				{"metadata": {"name": "ns-1"}}, {"metadata": {"name": "ns-2"}}, {"metadata": {"name": "ns-3"}}
+			]}`))
+		case "/api/v1/namespaces/ns-3/pods":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Status", "status": "Failure", "reason": "Forbidden", "code": 403}`))
+		}
+	}))
+	s.InitMcpClient()
+	s.Run("pods_not_ready() without permission to list pods in all namespaces", func() {
+		toolResult, err := s.CallTool("pods_not_ready", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(text), &decoded)
+		s.Run("returns results from the accessible namespaces", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+			s.Len(decoded, 2)
+		})
+		s.Run("reports skipped namespaces as warnings", func() {
+			s.True(strings.HasSuffix(text, "\n# Warnings, the following namespaces were skipped (partial results):\n# - ns-3: forbidden"),
+				"unexpected warnings: %s", text)
+		})
+	})
+}
+
+func TestPodsNotReady(t *testing.T) {
+	suite.Run(t, new(PodsNotReadySuite))
+}
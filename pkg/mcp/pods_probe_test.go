@@ -0,0 +1,185 @@
+package mcp
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type PodsProbeSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+	// commands records the commands executed in the Pod
+	commands [][]string
+	// probeOutput is the output of the probe script returned by the mock exec endpoint
+	probeOutput string
+}
+
+func (s *PodsProbeSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.commands = nil
+	s.probeOutput = "rc=0 start=1700000000000000000 end=1700000000012500000\n"
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/namespaces/default/pods/client/exec" {
+			return
+		}
+		ctx, err := test.CreateHTTPStreams(w, req, &test.StreamOptions{Stdout: io.Discard, Stderr: io.Discard})
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+		s.commands = append(s.commands, req.URL.Query()["command"])
+		defer func(conn io.Closer) { _ = conn.Close() }(ctx.Closer)
+		_, _ = io.WriteString(ctx.StdoutStream, s.probeOutput)
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/namespaces/default/pods/client" {
+			return
+		}
+		test.WriteObject(w, &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "client"},
+			Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "app"}}},
+			Status:     v1.PodStatus{Phase: v1.PodRunning},
+		})
+	}))
+}
+
+func (s *PodsProbeSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *PodsProbeSuite) TestPodsProbeTCP() {
+	s.InitMcpClient()
+	s.Run("pods_probe(name=client, host=backend.ns-1.svc, port=8080)", func() {
+		toolResult, err := s.CallTool("pods_probe", map[string]interface{}{
+			"name": "client",
+			"host": "backend.ns-1.svc",
+			"port": 8080,
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		s.Run("executes check targeting the requested host and port", func() {
+			s.Require().Len(s.commands, 1)
+			command := s.commands[0]
+			s.Require().Len(command, 9)
+			s.Equal([]string{"sh", "-c"}, command[:2])
+			s.Contains(command[2], "nc -z -w")
+			s.Equal([]string{"probe", "tcp", "backend.ns-1.svc", "8080", "5", ""}, command[3:])
+		})
+		var decoded map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("returns reachable with latency", func() {
+			s.Equal("default/client", decoded["Pod"])
+			s.Equal("backend.ns-1.svc:8080", decoded["Target"])
+			s.Equal("tcp", decoded["Protocol"])
+			s.Equal(true, decoded["Reachable"])
+			s.Equal(12.5, decoded["LatencyMs"])
+			s.NotContains(decoded, "Reason")
+		})
+	})
+}
+
+func (s *PodsProbeSuite) TestPodsProbeHTTP() {
+	s.InitMcpClient()
+	s.Run("pods_probe(protocol=http, path=/healthz, timeout_seconds=2)", func() {
+		toolResult, err := s.CallTool("pods_probe", map[string]interface{}{
+			"name":            "client",
+			"host":            "10.0.0.12",
+			"port":            9090,
+			"protocol":        "http",
+			"path":            "/healthz",
+			"timeout_seconds": 2,
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		s.Run("executes request targeting the requested URL", func() {
+			s.Require().Len(s.commands, 1)
+			s.Contains(s.commands[0][2], "curl -s -o /dev/null --max-time")
+			s.Equal([]string{"probe", "http", "10.0.0.12", "9090", "2", "http://10.0.0.12:9090/healthz"}, s.commands[0][3:])
+		})
+	})
+}
+
+func (s *PodsProbeSuite) TestPodsProbeUnreachable() {
+	s.probeOutput = "rc=1 start=%N end=%N\n"
+	s.InitMcpClient()
+	s.Run("pods_probe with unreachable target", func() {
+		toolResult, err := s.CallTool("pods_probe", map[string]interface{}{
+			"name": "client",
+			"host": "backend.ns-1.svc",
+			"port": 8080,
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		var decoded map[string]interface{}
+		s.Nilf(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "unmarshal failed")
+		s.Run("returns unreachable with reason", func() {
+			s.Equal(false, decoded["Reachable"])
+			s.Equal("connection failed (exit code 1)", decoded["Reason"])
+		})
+		s.Run("omits latency when not measurable", func() {
+			s.NotContains(decoded, "LatencyMs")
+		})
+	})
+}
+
+func (s *PodsProbeSuite) TestPodsProbeInvalidTarget() {
+	s.InitMcpClient()
+	s.Run("pods_probe with invalid port", func() {
+		toolResult, _ := s.CallTool("pods_probe", map[string]interface{}{
+			"name": "client",
+			"host": "backend.ns-1.svc",
+			"port": 70000,
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "invalid port 70000")
+	})
+	s.Run("pods_probe with invalid host", func() {
+		toolResult, _ := s.CallTool("pods_probe", map[string]interface{}{
+			"name": "client",
+			"host": "backend; rm -rf /",
+			"port": 8080,
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "invalid host")
+	})
+	s.Run("pods_probe with host passed as an option", func() {
+		for _, host := range []string{"-l", "--config=/etc/passwd", "-"} {
+			toolResult, _ := s.CallTool("pods_probe", map[string]interface{}{
+				"name": "client",
+				"host": host,
+				"port": 8080,
+			})
+			s.Truef(toolResult.IsError, "call tool should fail for host %s", host)
+			s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "invalid host")
+		}
+	})
+	s.Run("does not exec in the pod", func() {
+		s.Empty(s.commands)
+	})
+}
+
+func TestPodsProbe(t *testing.T) {
+	suite.Run(t, new(PodsProbeSuite))
+}
@@ -0,0 +1,185 @@
+package mcp
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type PodsRestartsSuite struct {
+	BaseMcpSuite
+	mockServer          *test.MockServer
+	allNamespacesDenied bool
+}
+
+func (s *PodsRestartsSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.allNamespacesDenied = false
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"get", "list"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/apis/authorization.k8s.io/v1/selfsubjectaccessreviews":
+			// Allow listing pods in all namespaces (unless denied by the test)
+			w.Header().Set("Content-Type", "application/json")
+			if s.allNamespacesDenied {
+				_, _ = w.Write([]byte(`{"apiVersion": "authorization.k8s.io/v1", "kind": "SelfSubjectAccessReview", "status": {"allowed": false}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"apiVersion": "authorization.k8s.io/v1", "kind": "SelfSubjectAccessReview", "status": {"allowed": true}}`))
+		case "/api/v1/pods":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "PodList", "items": [
+				{"metadata": {"name": "stable", "namespace": "ns-1"},
+				 "status": {"phase": "Running", "containerStatuses": [{"name": "app", "restartCount": 0}]}},
+				{"metadata": {"name": "flaky", "namespace": "ns-1"},
+				 "status": {"phase": "Running", "containerStatuses": [
+					{"name": "app", "restartCount": 2, "lastState": {"terminated": {"reason": "Error", "exitCode": 1, "finishedAt": "2025-01-02T03:04:05Z"}}}
+				 ]}},
+				{"metadata": {"name": "crash-looping", "namespace": "ns-2"},
+				 "status": {"phase": "Running", "containerStatuses": [
+					{"name": "app", "restartCount": 12, "lastState": {"terminated": {"reason": "OOMKilled", "exitCode": 137}}},
+					{"name": "sidecar", "restartCount": 0}
+				 ]}},
+				{"metadata": {"name": "init-failing", "namespace": "ns-2"},
+				 "status": {"phase": "Pending",
+				  "initContainerStatuses": [{"name": "migrate", "restartCount": 3, "lastState": {"terminated": {"reason": "Error", "exitCode": 2}}}],
+				  "containerStatuses": [{"name": "app", "restartCount": 1, "lastState": {"terminated": {"reason": "Completed", "exitCode": 0}}}]}}
+			]}`))
+		case "/api/v1/namespaces/ns-1/pods":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "PodList", "items": [
+				{"metadata": {"name": "stable", "namespace": "ns-1"},
+				 "status": {"phase": "Running", "containerStatuses": [{"name": "app", "restartCount": 0}]}}
+			]}`))
+		}
+	}))
+}
+
+func (s *PodsRestartsSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *PodsRestartsSuite) TestPodsRestarts() {
+	s.InitMcpClient()
+	s.Run("pods_restarts()", func() {
+		toolResult, err := s.CallTool("pods_restarts", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("omits pods without restarts and sorts by total restarts", func() {
+			s.Require().Len(decoded, 3)
+			s.Equal("crash-looping", decoded[0]["Name"])
+			s.Equal(float64(12), decoded[0]["Restarts"])
+			s.Equal("init-failing", decoded[1]["Name"])
+			s.Equal(float64(4), decoded[1]["Restarts"])
+			s.Equal("flaky", decoded[2]["Name"])
+			s.Equal(float64(2), decoded[2]["Restarts"])
+		})
+		s.Run("reports last termination reason of restarted containers", func() {
+			s.Equal([]interface{}{
+				map[string]interface{}{"Name": "app", "Restarts": float64(12), "LastTerminationReason": "OOMKilled", "LastTerminationExitCode": float64(137)},
+			}, decoded[0]["Containers"])
+			s.Equal([]interface{}{
+				map[string]interface{}{"Name": "migrate", "Restarts": float64(3), "LastTerminationReason": "Error", "LastTerminationExitCode": float64(2)},
+				map[string]interface{}{"Name": "app", "Restarts": float64(1), "LastTerminationReason": "Completed", "LastTerminationExitCode": float64(0)},
+			}, decoded[1]["Containers"])
+			s.Equal([]interface{}{
+				map[string]interface{}{"Name": "app", "Restarts": float64(2), "LastTerminationReason": "Error", "LastTerminationExitCode": float64(1), "LastTerminatedAt": "2025-01-02T03:04:05Z"},
+			}, decoded[2]["Containers"])
+		})
+	})
+	s.Run("pods_restarts(min_restarts=4)", func() {
+		toolResult, err := s.CallTool("pods_restarts", map[string]interface{}{
+			"min_restarts": 4,
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("filters pods below threshold", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+			s.Require().Len(decoded, 2)
+			s.Equal("crash-looping", decoded[0]["Name"])
+			s.Equal("init-failing", decoded[1]["Name"])
+		})
+	})
+	s.Run("pods_restarts(namespace=ns-1) with no restarted pods", func() {
+		toolResult, err := s.CallTool("pods_restarts", map[string]interface{}{
+			"namespace": "ns-1",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed")
+		s.Equal("# No pods with at least 1 container restarts found", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func (s *PodsRestartsSuite) TestPodsRestartsPartialResults() {
+	s.allNamespacesDenied = true
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/api/v1/namespaces":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "NamespaceList", "items": [
+				{"metadata": {"name": "ns-1"}}, {"metadata": {"name": "ns-2"}}, {"metadata": {"name": "ns-3"}}
+			]}`))
+		case "/api/v1/namespaces/ns-2/pods":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Status", "status": "Failure", "reason": "Forbidden", "code": 403}`))
+		case "/api/v1/namespaces/ns-3/pods":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "PodList", "items": [
+				{"metadata": {"name": "crash-looping", "namespace": "ns-3"},
+				 "status": {"phase": "Running", "containerStatuses": [{"name": "app", "restartCount": 7}]}}
+			]}`))
+		}
+	}))
+	s.InitMcpClient()
+	s.Run("pods_restarts() without permission to list pods in all namespaces", func() {
+		toolResult, err := s.CallTool("pods_restarts", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(text), &decoded)
+		s.Run("returns results from the accessible namespaces", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+			s.Require().Len(decoded, 1)
+			s.Equal("ns-3", decoded[0]["Namespace"])
+			s.Equal("crash-looping", decoded[0]["Name"])
+		})
+		s.Run("reports skipped namespaces as warnings", func() {
+			s.True(strings.HasSuffix(text, "\n# Warnings, the following namespaces were skipped (partial results):\n# - ns-2: forbidden"),
+				"unexpected result %v", text)
+		})
+	})
+}
+
+func TestPodsRestarts(t *testing.T) {
+	suite.Run(t, new(PodsRestartsSuite))
+}
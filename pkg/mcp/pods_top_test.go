@@ -9,6 +9,8 @@ import (
 	"github.com/containers/kubernetes-mcp-server/internal/test"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
 )
 
 type PodsTopSuite struct {
@@ -245,6 +247,100 @@ func (s *PodsTopSuite) TestPodsTopDenied() {
 	})
 }
 
+func (s *PodsTopSuite) TestPodsResourcePressure() {
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(
+		metav1.APIResourceList{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"get", "list"}},
+			},
+		},
+		metav1.APIResourceList{
+			GroupVersion: "metrics.k8s.io/v1beta1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", Kind: "PodMetrics", Namespaced: true, Verbs: []string{"get", "list"}},
+			},
+		},
+	))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/apis/metrics.k8s.io/v1beta1/namespaces/ns-1/pods":
+			_, _ = w.Write([]byte(`{"kind":"PodMetricsList","apiVersion":"metrics.k8s.io/v1beta1","items":[
+				{"metadata":{"name":"busy","namespace":"ns-1"},"containers":[{"name":"app","usage":{"cpu":"450m","memory":"950Mi"}}]},
+				{"metadata":{"name":"idle","namespace":"ns-1"},"containers":[{"name":"app","usage":{"cpu":"10m","memory":"64Mi"}}]}
+			]}`))
+		case "/api/v1/namespaces/ns-1/pods":
+			_, _ = w.Write([]byte(`{"kind":"PodList","apiVersion":"v1","items":[
+				{"metadata":{"name":"busy","namespace":"ns-1"},"spec":{"containers":[{"name":"app","image":"app",
+					"resources":{"requests":{"cpu":"200m","memory":"512Mi"},"limits":{"cpu":"1","memory":"1Gi"}}}]}},
+				{"metadata":{"name":"idle","namespace":"ns-1"},"spec":{"containers":[{"name":"app","image":"app",
+					"resources":{"requests":{"cpu":"100m","memory":"128Mi"},"limits":{"memory":"256Mi"}}}]}}
+			]}`))
+		}
+	}))
+	s.InitMcpClient()
+	s.Run("pods_resource_pressure(namespace=ns-1)", func() {
+		toolResult, err := s.CallTool("pods_resource_pressure", map[string]interface{}{"namespace": "ns-1"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("flags the container crossing the threshold", func() {
+			s.Require().Len(decoded, 2)
+			s.Equal(map[string]interface{}{
+				"Namespace":      "ns-1",
+				"Pod":            "busy",
+				"Container":      "app",
+				"Resource":       "cpu",
+				"Usage":          "450m",
+				"Request":        "200m",
+				"RequestPercent": float64(225),
+				"Limit":          "1",
+				"LimitPercent":   float64(45),
+				"Issues":         []interface{}{"usage at 225% of request"},
+			}, decoded[0])
+			s.Equal(map[string]interface{}{
+				"Namespace":      "ns-1",
+				"Pod":            "busy",
+				"Container":      "app",
+				"Resource":       "memory",
+				"Usage":          "950Mi",
+				"Request":        "512Mi",
+				"RequestPercent": float64(185),
+				"Limit":          "1Gi",
+				"LimitPercent":   float64(92),
+				"Issues":         []interface{}{"usage at 185% of request", "usage at 92% of limit"},
+			}, decoded[1])
+		})
+	})
+	s.Run("pods_resource_pressure(namespace=ns-1, threshold_percent=300)", func() {
+		toolResult, err := s.CallTool("pods_resource_pressure", map[string]interface{}{"namespace": "ns-1", "threshold_percent": 300})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		var decoded []map[string]interface{}
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded))
+		s.Require().Len(decoded, 1)
+		s.Equal([]interface{}{"usage at 92% of limit"}, decoded[0]["Issues"])
+	})
+}
+
+func (s *PodsTopSuite) TestPodsResourcePressureMetricsUnavailable() {
+	s.mockServer.Handle(test.NewDiscoveryClientHandler())
+	s.InitMcpClient()
+	s.Run("pods_resource_pressure with metrics API not available", func() {
+		toolResult, err := s.CallTool("pods_resource_pressure", map[string]interface{}{})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to get pods resource pressure: metrics API is not available", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
 func TestPodsTop(t *testing.T) {
 	suite.Run(t, new(PodsTopSuite))
 }
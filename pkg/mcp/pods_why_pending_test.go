@@ -0,0 +1,136 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type PodsWhyPendingSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *PodsWhyPendingSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/api/v1/namespaces/ns-1/pods/pending":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "pending", "namespace": "ns-1", "uid": "pending-uid"},
+				"spec": {"containers": [{"name": "app", "image": "app", "resources": {"requests": {"cpu": "2", "memory": "1Gi"}}}],
+				 "tolerations": [{"key": "node.kubernetes.io/not-ready", "operator": "Exists", "effect": "NoExecute"}]},
+				"status": {"phase": "Pending", "conditions": [{"type": "PodScheduled", "status": "False", "reason": "Unschedulable",
+				 "message": "0/3 nodes are available: 2 node(s) had untolerated taint {dedicated: gpu}, 1 Insufficient cpu."}]}}`))
+		case "/api/v1/namespaces/ns-1/pods/running":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "running", "namespace": "ns-1"},
+				"spec": {"nodeName": "node-3", "containers": [{"name": "app", "image": "app"}]},
+				"status": {"phase": "Running"}}`))
+		case "/api/v1/namespaces/ns-1/events":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "EventList", "items": [
+				{"metadata": {"name": "pending.1", "namespace": "ns-1"},
+				 "involvedObject": {"apiVersion": "v1", "kind": "Pod", "name": "pending", "uid": "pending-uid"},
+				 "type": "Warning", "reason": "FailedScheduling", "count": 3, "lastTimestamp": "2025-01-02T03:04:05Z",
+				 "message": "0/3 nodes are available: 2 node(s) had untolerated taint {dedicated: gpu}, 1 Insufficient cpu."}
+			]}`))
+		case "/api/v1/nodes":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "NodeList", "items": [
+				{"metadata": {"name": "node-1", "labels": {"kubernetes.io/hostname": "node-1"}},
+				 "spec": {"taints": [{"key": "dedicated", "value": "gpu", "effect": "NoSchedule"}, {"key": "maintenance", "effect": "PreferNoSchedule"}]},
+				 "status": {"allocatable": {"cpu": "8", "memory": "32Gi", "pods": "110"}, "conditions": [{"type": "Ready", "status": "True"}]}},
+				{"metadata": {"name": "node-2", "labels": {"kubernetes.io/hostname": "node-2"}},
+				 "spec": {"taints": [{"key": "dedicated", "value": "gpu", "effect": "NoSchedule"}]},
+				 "status": {"allocatable": {"cpu": "8", "memory": "32Gi", "pods": "110"}, "conditions": [{"type": "Ready", "status": "True"}]}},
+				{"metadata": {"name": "node-3", "labels": {"kubernetes.io/hostname": "node-3"}},
+				 "spec": {"taints": [{"key": "node.kubernetes.io/not-ready", "effect": "NoExecute"}]},
+				 "status": {"allocatable": {"cpu": "2", "memory": "8Gi", "pods": "110"}, "conditions": [{"type": "Ready", "status": "True"}]}}
+			]}`))
+		case "/api/v1/pods":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "PodList", "items": [
+				{"metadata": {"name": "running", "namespace": "ns-1"},
+				 "spec": {"nodeName": "node-3", "containers": [{"name": "app", "image": "app", "resources": {"requests": {"cpu": "500m"}}}]},
+				 "status": {"phase": "Running"}}
+			]}`))
+		}
+	}))
+}
+
+func (s *PodsWhyPendingSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *PodsWhyPendingSuite) TestPodsWhyPending() {
+	s.InitMcpClient()
+	s.Run("pods_why_pending with missing name returns error", func() {
+		toolResult, _ := s.CallTool("pods_why_pending", map[string]interface{}{"namespace": "ns-1"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to explain pending pod, missing argument name", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("pods_why_pending(namespace=ns-1, name=pending)", func() {
+		toolResult, err := s.CallTool("pods_why_pending", map[string]interface{}{
+			"namespace": "ns-1",
+			"name":      "pending",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("reports the scheduler reasons", func() {
+			s.Equal("Unschedulable", decoded["Reason"])
+			s.Equal("0/3 nodes are available: 2 node(s) had untolerated taint {dedicated: gpu}, 1 Insufficient cpu.", decoded["Message"])
+			s.Equal(map[string]interface{}{
+				"Timestamp": "2025-01-02T03:04:05Z",
+				"Count":     float64(3),
+				"Message":   "0/3 nodes are available: 2 node(s) had untolerated taint {dedicated: gpu}, 1 Insufficient cpu.",
+			}, decoded["FailedScheduling"])
+		})
+		s.Run("identifies the taint as the main blocker", func() {
+			blockers, ok := decoded["Blockers"].([]interface{})
+			s.Require().Truef(ok, "expected blockers, got %v", decoded["Blockers"])
+			s.Require().Len(blockers, 2)
+			s.Equal(map[string]interface{}{
+				"Constraint": "untolerated taint dedicated=gpu:NoSchedule",
+				"Nodes":      []interface{}{"node-1", "node-2"},
+				"Suggestion": "add a toleration for the taint dedicated=gpu:NoSchedule to the Pod or remove the taint from the nodes",
+			}, blockers[0])
+			s.Equal("insufficient cpu", blockers[1].(map[string]interface{})["Constraint"])
+			s.Equal([]interface{}{"node-3"}, blockers[1].(map[string]interface{})["Nodes"])
+		})
+		s.Run("reports the blockers of each node accounting allocated requests", func() {
+			s.Equal([]interface{}{
+				map[string]interface{}{"Name": "node-1", "Blockers": []interface{}{"untolerated taint dedicated=gpu:NoSchedule"}},
+				map[string]interface{}{"Name": "node-2", "Blockers": []interface{}{"untolerated taint dedicated=gpu:NoSchedule"}},
+				map[string]interface{}{"Name": "node-3", "Blockers": []interface{}{"insufficient cpu (requested: 2, available: 1500m)"}},
+			}, decoded["Nodes"])
+			s.NotContains(decoded, "FittingNodes")
+		})
+	})
+	s.Run("pods_why_pending(namespace=ns-1, name=running)", func() {
+		toolResult, err := s.CallTool("pods_why_pending", map[string]interface{}{
+			"namespace": "ns-1",
+			"name":      "running",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "Explanation: The Pod is not Pending (phase Running)")
+	})
+}
+
+func TestPodsWhyPending(t *testing.T) {
+	suite.Run(t, new(PodsWhyPendingSuite))
+}
@@ -0,0 +1,175 @@
+package mcp
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	authv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type RBACSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *RBACSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"get", "list", "delete"}},
+		},
+	}, metav1.APIResourceList{
+		GroupVersion: "apps/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "deployments", Kind: "Deployment", Namespaced: true, Verbs: []string{"get", "list", "create", "delete"}},
+		},
+	}))
+	// Fake authorizer: the current identity can only read Pods in ns-1,
+	// the deployer ServiceAccount can only create Deployments in ns-1
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/apis/authorization.k8s.io/v1/selfsubjectaccessreviews":
+			body, _ := io.ReadAll(req.Body)
+			obj, _, _ := scheme.Codecs.UniversalDeserializer().Decode(body, nil, &authv1.SelfSubjectAccessReview{})
+			review := obj.(*authv1.SelfSubjectAccessReview)
+			attributes := review.Spec.ResourceAttributes
+			if attributes.Namespace == "ns-1" && attributes.Resource == "pods" && (attributes.Verb == "get" || attributes.Verb == "list") {
+				review.Status = authv1.SubjectAccessReviewStatus{Allowed: true, Reason: `RBAC: allowed by RoleBinding "viewer/ns-1"`}
+			}
+			test.WriteObject(w, review)
+		case "/apis/authorization.k8s.io/v1/subjectaccessreviews":
+			body, _ := io.ReadAll(req.Body)
+			obj, _, _ := scheme.Codecs.UniversalDeserializer().Decode(body, nil, &authv1.SubjectAccessReview{})
+			review := obj.(*authv1.SubjectAccessReview)
+			attributes := review.Spec.ResourceAttributes
+			if review.Spec.User == "system:serviceaccount:ns-1:deployer" && attributes.Namespace == "ns-1" &&
+				attributes.Group == "apps" && attributes.Resource == "deployments" && attributes.Verb == "create" {
+				review.Status = authv1.SubjectAccessReviewStatus{Allowed: true}
+			} else {
+				review.Status = authv1.SubjectAccessReviewStatus{Denied: true, Reason: "denied by policy"}
+			}
+			test.WriteObject(w, review)
+		}
+	}))
+}
+
+func (s *RBACSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *RBACSuite) TestRBACCanI() {
+	s.InitMcpClient()
+	s.Run("rbac_can_i(verb=list, apiVersion=v1, kind=Pod, namespace=ns-1) for the current identity", func() {
+		toolResult, err := s.CallTool("rbac_can_i", map[string]interface{}{
+			"verb":       "list",
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"namespace":  "ns-1",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("is allowed", func() {
+			s.Equal(map[string]interface{}{
+				"Allowed":   true,
+				"Verb":      "list",
+				"Resource":  "pods",
+				"Namespace": "ns-1",
+				"Reason":    `RBAC: allowed by RoleBinding "viewer/ns-1"`,
+			}, decoded)
+		})
+	})
+	s.Run("rbac_can_i(verb=delete, apiVersion=v1, kind=Pod, namespace=ns-1, name=web) for the current identity", func() {
+		toolResult, err := s.CallTool("rbac_can_i", map[string]interface{}{
+			"verb":       "delete",
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"namespace":  "ns-1",
+			"name":       "web",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		var decoded map[string]interface{}
+		s.Nilf(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "unmarshal failed")
+		s.Run("is not allowed", func() {
+			s.Equal(false, decoded["Allowed"])
+			s.Equal("web", decoded["Name"])
+		})
+	})
+	s.Run("rbac_can_i(verb=create, apiVersion=apps/v1, kind=Deployment, namespace=ns-1, user=deployer)", func() {
+		toolResult, err := s.CallTool("rbac_can_i", map[string]interface{}{
+			"verb":       "create",
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"namespace":  "ns-1",
+			"user":       "system:serviceaccount:ns-1:deployer",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		var decoded map[string]interface{}
+		s.Nilf(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "unmarshal failed")
+		s.Run("is allowed for the subject", func() {
+			s.Equal(map[string]interface{}{
+				"Allowed":   true,
+				"Verb":      "create",
+				"Resource":  "deployments.apps",
+				"Namespace": "ns-1",
+				"User":      "system:serviceaccount:ns-1:deployer",
+			}, decoded)
+		})
+	})
+	s.Run("rbac_can_i(verb=delete, apiVersion=apps/v1, kind=Deployment, groups=[developers])", func() {
+		toolResult, err := s.CallTool("rbac_can_i", map[string]interface{}{
+			"verb":       "delete",
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"groups":     []interface{}{"developers"},
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		var decoded map[string]interface{}
+		s.Nilf(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "unmarshal failed")
+		s.Run("is denied for the subject", func() {
+			s.Equal(map[string]interface{}{
+				"Allowed":  false,
+				"Denied":   true,
+				"Verb":     "delete",
+				"Resource": "deployments.apps",
+				"Groups":   []interface{}{"developers"},
+				"Reason":   "denied by policy",
+			}, decoded)
+		})
+	})
+	s.Run("rbac_can_i() without verb", func() {
+		toolResult, _ := s.CallTool("rbac_can_i", map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to check access, missing argument verb", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func TestRBAC(t *testing.T) {
+	suite.Run(t, new(RBACSuite))
+}
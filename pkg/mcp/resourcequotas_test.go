@@ -0,0 +1,90 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type ResourceQuotasSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *ResourceQuotasSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/api/v1/namespaces/ns-1/resourcequotas":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "ResourceQuotaList", "items": [
+				{"metadata": {"name": "compute", "namespace": "ns-1"},
+				 "spec": {"hard": {"requests.cpu": "2", "requests.memory": "4Gi", "pods": "10"}},
+				 "status": {"hard": {"requests.cpu": "2", "requests.memory": "4Gi", "pods": "10"},
+				  "used": {"requests.cpu": "1900m", "requests.memory": "1Gi", "pods": "3"}}},
+				{"metadata": {"name": "no-loadbalancers", "namespace": "ns-1"},
+				 "spec": {"hard": {"services.loadbalancers": "0"}},
+				 "status": {"hard": {"services.loadbalancers": "0"}, "used": {"services.loadbalancers": "0"}}}
+			]}`))
+		case "/api/v1/namespaces/default/resourcequotas":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "ResourceQuotaList", "items": []}`))
+		}
+	}))
+}
+
+func (s *ResourceQuotasSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *ResourceQuotasSuite) TestResourceQuotasList() {
+	s.InitMcpClient()
+	s.Run("resourcequotas_list(namespace=ns-1)", func() {
+		toolResult, err := s.CallTool("resourcequotas_list", map[string]interface{}{
+			"namespace": "ns-1",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Require().Len(decoded, 2)
+		s.Run("returns used vs hard with percentage and near-limit flag", func() {
+			s.Equal("compute", decoded[0]["Name"])
+			s.Equal([]interface{}{
+				map[string]interface{}{"Resource": "pods", "Used": "3", "Hard": "10", "PercentUsed": float64(30)},
+				map[string]interface{}{"Resource": "requests.cpu", "Used": "1900m", "Hard": "2", "PercentUsed": float64(95), "NearLimit": true},
+				map[string]interface{}{"Resource": "requests.memory", "Used": "1Gi", "Hard": "4Gi", "PercentUsed": float64(25)},
+			}, decoded[0]["Resources"])
+		})
+		s.Run("flags zero hard limits as exhausted", func() {
+			s.Equal([]interface{}{
+				map[string]interface{}{"Resource": "services.loadbalancers", "Used": "0", "Hard": "0", "PercentUsed": float64(100), "NearLimit": true},
+			}, decoded[1]["Resources"])
+		})
+	})
+	s.Run("resourcequotas_list() in namespace without quotas", func() {
+		toolResult, err := s.CallTool("resourcequotas_list", map[string]interface{}{})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Equal("# No resource quotas found", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func TestResourceQuotas(t *testing.T) {
+	suite.Run(t, new(ResourceQuotasSuite))
+}
@@ -0,0 +1,139 @@
+package mcp
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type ResourcesDescribeSuite struct {
+	BaseMcpSuite
+	mockServer          *test.MockServer
+	eventsFieldSelector string
+}
+
+func (s *ResourcesDescribeSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.eventsFieldSelector = ""
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(
+		metav1.APIResourceList{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "secrets", Kind: "Secret", Namespaced: true, Verbs: []string{"get", "list"}},
+				{Name: "events", Kind: "Event", Namespaced: true, Verbs: []string{"get", "list"}},
+			},
+		},
+		metav1.APIResourceList{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "deployments", Kind: "Deployment", Namespaced: true, Verbs: []string{"get", "list"}},
+			},
+		},
+	))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/apis/apps/v1/namespaces/ns-1/deployments/web":
+			_, _ = w.Write([]byte(`{"apiVersion": "apps/v1", "kind": "Deployment",
+				"metadata": {"name": "web", "namespace": "ns-1", "uid": "web-uid", "creationTimestamp": "2025-01-02T03:04:05Z",
+				 "labels": {"app": "web", "tier": "frontend"},
+				 "annotations": {"deployment.kubernetes.io/revision": "3", "kubectl.kubernetes.io/last-applied-configuration": "{\"huge\": true}"},
+				 "managedFields": [{"manager": "kubectl"}]},
+				"spec": {"replicas": 3, "selector": {"matchLabels": {"app": "web"}},
+				 "template": {"metadata": {"labels": {"app": "web"}}, "spec": {"containers": [{"name": "app", "image": "quay.io/example/web:1.2"}]}}},
+				"status": {"replicas": 3, "availableReplicas": 2, "conditions": [
+				 {"type": "Available", "status": "False", "reason": "MinimumReplicasUnavailable", "message": "Deployment does not have minimum availability.", "lastTransitionTime": "2025-01-02T03:04:05Z"},
+				 {"type": "Progressing", "status": "True", "reason": "NewReplicaSetAvailable", "lastTransitionTime": "2025-01-02T03:04:05Z"}
+				]}}`))
+		case "/api/v1/namespaces/ns-1/events":
+			s.eventsFieldSelector = req.URL.Query().Get("fieldSelector")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "EventList", "items": [
+				{"metadata": {"name": "web.2", "namespace": "ns-1"}, "involvedObject": {"kind": "Deployment", "name": "web", "uid": "web-uid"},
+				 "type": "Normal", "reason": "ScalingReplicaSet", "count": 1, "firstTimestamp": "2025-01-02T04:00:00Z",
+				 "source": {"component": "deployment-controller"}, "message": "Scaled up replica set web-5d4f8 to 3"},
+				{"metadata": {"name": "web.1", "namespace": "ns-1"}, "involvedObject": {"kind": "Deployment", "name": "web", "uid": "web-uid"},
+				 "type": "Normal", "reason": "ScalingReplicaSet", "count": 1, "firstTimestamp": "2025-01-02T03:04:05Z",
+				 "source": {"component": "deployment-controller"}, "message": "Scaled up replica set web-7c9b2 to 1"},
+				{"metadata": {"name": "web.0", "namespace": "ns-1"}, "involvedObject": {"kind": "Deployment", "name": "web", "uid": "previous-web-uid"},
+				 "type": "Warning", "reason": "Previous", "count": 1, "firstTimestamp": "2025-01-01T00:00:00Z", "message": "Event of a deleted Deployment"}
+			]}`))
+		case "/api/v1/namespaces/ns-1/secrets/credentials":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "credentials", "namespace": "ns-1"},
+				"type": "Opaque", "data": {"password": "c3VwZXItc2VjcmV0"}}`))
+		}
+	}))
+}
+
+func (s *ResourcesDescribeSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *ResourcesDescribeSuite) TestResourcesDescribe() {
+	s.InitMcpClient()
+	s.Run("resources_describe with missing name returns error", func() {
+		toolResult, _ := s.CallTool("resources_describe", map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to describe resource, missing argument name", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("resources_describe(apiVersion=apps/v1, kind=Deployment, name=web)", func() {
+		toolResult, err := s.CallTool("resources_describe", map[string]interface{}{
+			"apiVersion": "apps/v1", "kind": "Deployment", "namespace": "ns-1", "name": "web",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("describes the metadata", func() {
+			s.Contains(text, "Name:         web\nNamespace:    ns-1\nKind:         Deployment\nAPI Version:  apps/v1\n")
+			s.Contains(text, "Labels:       app=web\n              tier=frontend\n")
+			s.Contains(text, "Annotations:  deployment.kubernetes.io/revision=3\n")
+			s.Contains(text, "Created:      2025-01-02T03:04:05Z (")
+			s.NotContains(text, "last-applied-configuration")
+			s.NotContains(text, "managedFields")
+		})
+		s.Run("describes the spec", func() {
+			s.Contains(text, "Spec:\n  replicas: 3\n")
+			s.Contains(text, "image: quay.io/example/web:1.2")
+			s.Contains(text, "Status:\n  availableReplicas: 2\n  replicas: 3\n")
+		})
+		s.Run("describes the conditions", func() {
+			s.Regexp(regexp.MustCompile(`(?m)^  Available\s+False\s+MinimumReplicasUnavailable\s+\S+ ago\s+Deployment does not have minimum availability\.$`), text)
+			s.Regexp(regexp.MustCompile(`(?m)^  Progressing\s+True\s+NewReplicaSetAvailable\s+\S+ ago\s+<none>$`), text)
+		})
+		s.Run("queries the events of the resource", func() {
+			s.Equal("involvedObject.kind=Deployment,involvedObject.name=web", s.eventsFieldSelector)
+		})
+		s.Run("describes the events oldest first", func() {
+			s.Regexp(regexp.MustCompile(`(?s)Events:\n  Type\s+Reason\s+Age\s+Count\s+From\s+Message\n`+
+				`  Normal\s+ScalingReplicaSet\s+\S+\s+1\s+deployment-controller\s+Scaled up replica set web-7c9b2 to 1\n`+
+				`  Normal\s+ScalingReplicaSet\s+\S+\s+1\s+deployment-controller\s+Scaled up replica set web-5d4f8 to 3\n`), text)
+			s.NotContains(text, "Event of a deleted Deployment")
+		})
+	})
+	s.Run("resources_describe(apiVersion=v1, kind=Secret, name=credentials) redacts the values", func() {
+		toolResult, err := s.CallTool("resources_describe", map[string]interface{}{
+			"apiVersion": "v1", "kind": "Secret", "namespace": "ns-1", "name": "credentials",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		s.Contains(text, "Data:\n  password: 12 bytes\n")
+		s.NotContains(text, "c3VwZXItc2VjcmV0")
+	})
+}
+
+func TestResourcesDescribe(t *testing.T) {
+	suite.Run(t, new(ResourcesDescribeSuite))
+}
@@ -0,0 +1,146 @@
+package mcp
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type ResourcesDiffSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+	dryRun     string
+}
+
+func (s *ResourcesDiffSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.dryRun = ""
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(
+		metav1.APIResourceList{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "configmaps", Kind: "ConfigMap", Namespaced: true, Verbs: []string{"get", "patch"}},
+			},
+		},
+	))
+	live := func() *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name":            "cm-1",
+				"namespace":       "ns-1",
+				"resourceVersion": "1",
+				"labels":          map[string]interface{}{"app": "demo"},
+			},
+			"data": map[string]interface{}{"a": "1", "b": "2", "c": "3"},
+		}}
+	}
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.URL.Path == "/api/v1/namespaces/ns-1/configmaps/cm-1" && req.Method == http.MethodGet:
+			test.WriteObject(w, live())
+		case req.URL.Path == "/api/v1/namespaces/ns-1/configmaps/cm-1" && req.Method == http.MethodPatch:
+			s.dryRun = req.URL.Query().Get("dryRun")
+			body, _ := io.ReadAll(req.Body)
+			applied := &unstructured.Unstructured{}
+			if err := applied.UnmarshalJSON(body); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			// Simulate the server-side apply by merging the applied data into the live object
+			projected := live()
+			projected.SetResourceVersion("2")
+			data, _, _ := unstructured.NestedStringMap(applied.Object, "data")
+			for k, v := range data {
+				_ = unstructured.SetNestedField(projected.Object, v, "data", k)
+			}
+			test.WriteObject(w, projected)
+		case req.URL.Path == "/api/v1/namespaces/ns-1/configmaps/cm-new" && req.Method == http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`))
+		}
+	}))
+}
+
+func (s *ResourcesDiffSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *ResourcesDiffSuite) TestResourcesDiff() {
+	s.InitMcpClient()
+	s.Run("resources_diff with missing resource returns error", func() {
+		toolResult, _ := s.CallTool("resources_diff", map[string]interface{}{})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to diff resources, missing argument resource", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("resources_diff with changed field", func() {
+		toolResult, err := s.CallTool("resources_diff", map[string]interface{}{
+			"resource": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm-1\n  namespace: ns-1\ndata:\n  b: changed\n",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		s.Run("performs a dry-run apply", func() {
+			s.Equal("All", s.dryRun)
+		})
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns unified diff headers", func() {
+			s.Contains(text, "--- ConfigMap ns-1/cm-1 (live)\n")
+			s.Contains(text, "+++ ConfigMap ns-1/cm-1 (projected)\n")
+		})
+		s.Run("diff shows only the changed field", func() {
+			var changes []string
+			for _, line := range strings.Split(text, "\n") {
+				if (strings.HasPrefix(line, "-") || strings.HasPrefix(line, "+")) &&
+					!strings.HasPrefix(line, "---") && !strings.HasPrefix(line, "+++") {
+					changes = append(changes, line)
+				}
+			}
+			s.Equal([]string{"-  b: \"2\"", "+  b: changed"}, changes)
+		})
+	})
+	s.Run("resources_diff with unchanged resource", func() {
+		toolResult, err := s.CallTool("resources_diff", map[string]interface{}{
+			"resource": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm-1\n  namespace: ns-1\ndata:\n  a: \"1\"\n",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		s.Run("returns no differences", func() {
+			s.Equal("# ConfigMap ns-1/cm-1: no differences\n", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+	s.Run("resources_diff with new resource", func() {
+		toolResult, err := s.CallTool("resources_diff", map[string]interface{}{
+			"resource": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm-new\n  namespace: ns-1\ndata:\n  a: \"1\"\n",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		s.Run("returns would create", func() {
+			s.Equal("# ConfigMap ns-1/cm-new: would create\n", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+}
+
+func TestResourcesDiff(t *testing.T) {
+	suite.Run(t, new(ResourcesDiffSuite))
+}
@@ -0,0 +1,153 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+const podOpenAPIV3 = `{
+  "openapi": "3.0.0",
+  "info": {"title": "Kubernetes", "version": "v1.34.0"},
+  "paths": {},
+  "components": {
+    "schemas": {
+      "io.k8s.api.core.v1.Pod": {
+        "description": "Pod is a collection of containers that can run on a host.",
+        "type": "object",
+        "properties": {
+          "apiVersion": {"description": "APIVersion defines the versioned schema of this representation of an object.", "type": "string"},
+          "kind": {"description": "Kind is a string value representing the REST resource this object represents.", "type": "string"},
+          "metadata": {"allOf": [{"$ref": "#/components/schemas/io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta"}], "default": {}, "description": "Standard object's metadata."},
+          "spec": {"allOf": [{"$ref": "#/components/schemas/io.k8s.api.core.v1.PodSpec"}], "default": {}, "description": "Specification of the desired behavior of the pod."}
+        },
+        "x-kubernetes-group-version-kind": [{"group": "", "kind": "Pod", "version": "v1"}]
+      },
+      "io.k8s.api.core.v1.PodSpec": {
+        "description": "PodSpec is a description of a pod.",
+        "type": "object",
+        "required": ["containers"],
+        "properties": {
+          "containers": {
+            "description": "List of containers belonging to the pod.",
+            "type": "array",
+            "items": {"allOf": [{"$ref": "#/components/schemas/io.k8s.api.core.v1.Container"}], "default": {}}
+          },
+          "nodeSelector": {
+            "description": "NodeSelector is a selector which must be true for the pod to fit on a node.",
+            "type": "object",
+            "additionalProperties": {"type": "string", "default": ""}
+          }
+        }
+      },
+      "io.k8s.api.core.v1.Container": {
+        "description": "A single application container that you want to run within a pod.",
+        "type": "object",
+        "required": ["name"],
+        "properties": {
+          "image": {"description": "Container image name.", "type": "string"},
+          "name": {"description": "Name of the container specified as a DNS_LABEL.", "type": "string", "default": ""}
+        }
+      },
+      "io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta": {
+        "description": "ObjectMeta is metadata that all persisted resources must have.",
+        "type": "object",
+        "properties": {
+          "name": {"description": "Name must be unique within a namespace.", "type": "string"}
+        }
+      }
+    }
+  }
+}`
+
+type ResourcesExplainSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *ResourcesExplainSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/openapi/v3":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"paths": {"api/v1": {"serverRelativeURL": "/openapi/v3/api/v1?hash=0123456789"}}}`))
+		case "/openapi/v3/api/v1":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(podOpenAPIV3))
+		}
+	}))
+}
+
+func (s *ResourcesExplainSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *ResourcesExplainSuite) TestResourcesExplain() {
+	s.InitMcpClient()
+	s.Run("resources_explain with missing kind returns error", func() {
+		toolResult, _ := s.CallTool("resources_explain", map[string]interface{}{"apiVersion": "v1"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to explain resource, missing argument kind", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("resources_explain(apiVersion=v1, kind=Pod)", func() {
+		toolResult, err := s.CallTool("resources_explain", map[string]interface{}{"apiVersion": "v1", "kind": "Pod"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		out := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns kind description", func() {
+			s.Contains(out, "KIND:       Pod\nVERSION:    v1\n")
+			s.Contains(out, "DESCRIPTION:\n    Pod is a collection of containers that can run on a host.\n")
+		})
+		s.Run("returns fields", func() {
+			s.Contains(out, "  metadata\t<ObjectMeta>\n    Standard object's metadata.\n")
+			s.Contains(out, "  spec\t<PodSpec>\n    Specification of the desired behavior of the pod.\n")
+		})
+	})
+	s.Run("resources_explain(apiVersion=v1, kind=Pod, field=spec.containers)", func() {
+		toolResult, err := s.CallTool("resources_explain", map[string]interface{}{"apiVersion": "v1", "kind": "Pod", "field": "spec.containers"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		s.Run("returns field description", func() {
+			s.Equal("KIND:       Pod\nVERSION:    v1\n\n"+
+				"FIELD: containers <[]Container>\n\n"+
+				"DESCRIPTION:\n    List of containers belonging to the pod.\n\n"+
+				"FIELDS:\n"+
+				"  image\t<string>\n    Container image name.\n\n"+
+				"  name\t<string> -required-\n    Name of the container specified as a DNS_LABEL.\n",
+				toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+	s.Run("resources_explain(apiVersion=v1, kind=Pod, field=spec.nodeSelector)", func() {
+		toolResult, _ := s.CallTool("resources_explain", map[string]interface{}{"apiVersion": "v1", "kind": "Pod", "field": "spec.nodeSelector"})
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "FIELD: nodeSelector <map[string]string>\n")
+	})
+	s.Run("resources_explain with non-existent field returns error", func() {
+		toolResult, _ := s.CallTool("resources_explain", map[string]interface{}{"apiVersion": "v1", "kind": "Pod", "field": "spec.nonExistent"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to explain resource: field \"spec.nonExistent\" does not exist in Pod", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("resources_explain with non-existent kind returns error", func() {
+		toolResult, _ := s.CallTool("resources_explain", map[string]interface{}{"apiVersion": "v1", "kind": "Widget"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to explain resource: no schema found for /v1, Kind=Widget", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func TestResourcesExplain(t *testing.T) {
+	suite.Run(t, new(ResourcesExplainSuite))
+}
@@ -0,0 +1,141 @@
+package mcp
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type ResourcesExportSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *ResourcesExportSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"get"}},
+			{Name: "services", Kind: "Service", Namespaced: true, Verbs: []string{"get"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/api/v1/namespaces/ns-1/pods/web":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Pod",
+				"metadata": {"name": "web", "namespace": "ns-1", "uid": "a-uid", "resourceVersion": "1234", "generation": 1,
+				 "creationTimestamp": "2025-01-02T03:04:05Z", "labels": {"app": "web"},
+				 "annotations": {"kubectl.kubernetes.io/last-applied-configuration": "{}", "team": "frontend"},
+				 "ownerReferences": [{"apiVersion": "apps/v1", "kind": "ReplicaSet", "name": "web-5d4f", "uid": "rs-uid"}],
+				 "managedFields": [{"manager": "kubectl", "operation": "Apply"}]},
+				"spec": {"nodeName": "node-1", "serviceAccount": "default", "serviceAccountName": "default",
+				 "containers": [{"name": "web", "image": "nginx:1.27", "ports": [{"containerPort": 80}],
+				  "volumeMounts": [{"name": "data", "mountPath": "/data"}, {"name": "kube-api-access-x7k2p", "mountPath": "/var/run/secrets/kubernetes.io/serviceaccount", "readOnly": true}]}],
+				 "volumes": [{"name": "data", "emptyDir": {}}, {"name": "kube-api-access-x7k2p", "projected": {"sources": [{"serviceAccountToken": {"path": "token"}}]}}]},
+				"status": {"phase": "Running", "podIP": "10.0.0.12"}}`))
+		case "/api/v1/namespaces/ns-1/services/web":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Service",
+				"metadata": {"name": "web", "namespace": "ns-1", "uid": "svc-uid", "resourceVersion": "99"},
+				"spec": {"clusterIP": "172.30.0.10", "clusterIPs": ["172.30.0.10"], "selector": {"app": "web"}, "ports": [{"port": 80}]},
+				"status": {"loadBalancer": {}}}`))
+		}
+	}))
+}
+
+func (s *ResourcesExportSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *ResourcesExportSuite) TestResourcesExportPod() {
+	s.InitMcpClient()
+	s.Run("resources_export(apiVersion=v1, kind=Pod, namespace=ns-1, name=web)", func() {
+		toolResult, err := s.CallTool("resources_export", map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"namespace":  "ns-1",
+			"name":       "web",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns re-appliable manifest header", func() {
+			s.True(strings.HasPrefix(text, "# The following manifest (YAML) can be re-applied with resources_create_or_update\n"))
+		})
+		var decoded unstructured.Unstructured
+		err = yaml.Unmarshal([]byte(text), &decoded.Object)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("strips server-managed fields", func() {
+			s.NotContains(decoded.Object, "status")
+			metadata := decoded.Object["metadata"].(map[string]interface{})
+			for _, field := range []string{"uid", "resourceVersion", "generation", "creationTimestamp", "managedFields", "ownerReferences"} {
+				s.NotContainsf(metadata, field, "expected metadata.%s to be stripped", field)
+			}
+			s.Equal(map[string]string{"team": "frontend"}, decoded.GetAnnotations())
+		})
+		s.Run("strips fields set by the cluster", func() {
+			spec := decoded.Object["spec"].(map[string]interface{})
+			s.NotContains(spec, "nodeName")
+			s.NotContains(spec, "serviceAccount")
+			s.Equal([]interface{}{map[string]interface{}{"name": "data", "emptyDir": map[string]interface{}{}}}, spec["volumes"])
+		})
+		s.Run("preserves metadata and spec", func() {
+			s.Equal("web", decoded.GetName())
+			s.Equal("ns-1", decoded.GetNamespace())
+			s.Equal(map[string]string{"app": "web"}, decoded.GetLabels())
+			s.Equal("default", decoded.Object["spec"].(map[string]interface{})["serviceAccountName"])
+			s.Equal([]interface{}{map[string]interface{}{
+				"name":         "web",
+				"image":        "nginx:1.27",
+				"ports":        []interface{}{map[string]interface{}{"containerPort": float64(80)}},
+				"volumeMounts": []interface{}{map[string]interface{}{"name": "data", "mountPath": "/data"}},
+			}}, decoded.Object["spec"].(map[string]interface{})["containers"])
+		})
+	})
+}
+
+func (s *ResourcesExportSuite) TestResourcesExportService() {
+	s.InitMcpClient()
+	s.Run("resources_export(apiVersion=v1, kind=Service, namespace=ns-1, name=web)", func() {
+		toolResult, err := s.CallTool("resources_export", map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"namespace":  "ns-1",
+			"name":       "web",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		var decoded unstructured.Unstructured
+		s.Nilf(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded.Object), "unmarshal failed")
+		s.Run("strips allocated cluster IPs and preserves spec", func() {
+			s.Equal(map[string]interface{}{
+				"selector": map[string]interface{}{"app": "web"},
+				"ports":    []interface{}{map[string]interface{}{"port": float64(80)}},
+			}, decoded.Object["spec"])
+			s.NotContains(decoded.Object, "status")
+		})
+	})
+}
+
+func TestResourcesExport(t *testing.T) {
+	suite.Run(t, new(ResourcesExportSuite))
+}
@@ -0,0 +1,137 @@
+package mcp
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type ResourcesLabelSuite struct {
+	BaseMcpSuite
+	mockServer       *test.MockServer
+	patchContentType string
+	labels           map[string]interface{}
+}
+
+func (s *ResourcesLabelSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.patchContentType = ""
+	s.labels = map[string]interface{}{"app": "cm"}
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(
+		metav1.APIResourceList{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "configmaps", Kind: "ConfigMap", Namespaced: true, Verbs: []string{"get", "patch"}},
+			},
+		},
+	))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/namespaces/ns-1/configmaps/cm-1" || req.Method != http.MethodPatch {
+			return
+		}
+		s.patchContentType = req.Header.Get("Content-Type")
+		body, _ := io.ReadAll(req.Body)
+		patch := struct {
+			Metadata struct {
+				Labels map[string]interface{} `json:"labels"`
+			} `json:"metadata"`
+		}{}
+		_ = json.Unmarshal(body, &patch)
+		for key, value := range patch.Metadata.Labels {
+			if value == nil {
+				delete(s.labels, key)
+			} else {
+				s.labels[key] = value
+			}
+		}
+		test.WriteObject(w, &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name": "cm-1", "namespace": "ns-1", "resourceVersion": "2", "labels": s.labels,
+				"managedFields": []interface{}{map[string]interface{}{"manager": "kubectl"}},
+			},
+		}})
+	}))
+}
+
+func (s *ResourcesLabelSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *ResourcesLabelSuite) TestResourcesLabel() {
+	s.InitMcpClient()
+	s.Run("resources_label with missing key returns error", func() {
+		toolResult, _ := s.CallTool("resources_label", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "namespace": "ns-1", "name": "cm-1",
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to update resource labels, missing argument key", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("resources_label with invalid label value returns error", func() {
+		toolResult, _ := s.CallTool("resources_label", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "namespace": "ns-1", "name": "cm-1", "key": "tier", "value": "not valid!",
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "failed to update resource labels: invalid label value")
+	})
+	labels := func(toolResult *mcp.CallToolResult) map[string]interface{} {
+		var decoded map[string]interface{}
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded))
+		s.NotContains(decoded, "managedFields")
+		return decoded["labels"].(map[string]interface{})
+	}
+	s.Run("resources_label adds a label", func() {
+		toolResult, err := s.CallTool("resources_label", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "namespace": "ns-1", "name": "cm-1", "key": "tier", "value": "frontend",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Equal(string(types.MergePatchType), s.patchContentType)
+		s.Equal(map[string]interface{}{"app": "cm", "tier": "frontend"}, labels(toolResult))
+	})
+	s.Run("resources_label overwrites a label", func() {
+		toolResult, err := s.CallTool("resources_label", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "namespace": "ns-1", "name": "cm-1", "key": "tier", "value": "backend",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Equal(map[string]interface{}{"app": "cm", "tier": "backend"}, labels(toolResult))
+	})
+	s.Run("resources_label removes a label with the remove flag", func() {
+		toolResult, err := s.CallTool("resources_label", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "namespace": "ns-1", "name": "cm-1", "key": "tier", "remove": true,
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Equal(map[string]interface{}{"app": "cm"}, labels(toolResult))
+	})
+	s.Run("resources_label removes a label with a key ending with a dash", func() {
+		toolResult, err := s.CallTool("resources_label", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "namespace": "ns-1", "name": "cm-1", "key": "app-",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Nil(s.labels["app"])
+		s.Len(s.labels, 0)
+	})
+}
+
+func TestResourcesLabel(t *testing.T) {
+	suite.Run(t, new(ResourcesLabelSuite))
+}
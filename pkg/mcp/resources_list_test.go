@@ -0,0 +1,229 @@
+package mcp
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type ResourcesListSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *ResourcesListSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "configmaps", Kind: "ConfigMap", Namespaced: true, Verbs: []string{"list"}},
+			{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"list"}},
+		},
+	}))
+	// ConfigMaps seeded in multiple namespaces, filtered by the label selector like the API server does
+	seeded := []struct{ namespace, name, app string }{
+		{"ns-1", "frontend-config", "frontend"},
+		{"ns-1", "backend-config", "backend"},
+		{"ns-2", "frontend-config", "frontend"},
+		{"ns-3", "unlabeled-config", ""},
+	}
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var namespace string
+		switch req.URL.Path {
+		case "/apis/authorization.k8s.io/v1/selfsubjectaccessreviews":
+			// Allow listing configmaps in all namespaces
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "authorization.k8s.io/v1", "kind": "SelfSubjectAccessReview", "status": {"allowed": true}}`))
+			return
+		case "/api/v1/configmaps":
+		case "/api/v1/namespaces/ns-2/configmaps":
+			namespace = "ns-2"
+		default:
+			return
+		}
+		selector, err := labels.Parse(req.URL.Query().Get("labelSelector"))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		list := &unstructured.UnstructuredList{Object: map[string]interface{}{"apiVersion": "v1", "kind": "ConfigMapList"}}
+		for _, cm := range seeded {
+			item := unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": "v1", "kind": "ConfigMap"}}
+			item.SetNamespace(cm.namespace)
+			item.SetName(cm.name)
+			if cm.app != "" {
+				item.SetLabels(map[string]string{"app": cm.app})
+			}
+			if (namespace == "" || namespace == cm.namespace) && selector.Matches(labels.Set(item.GetLabels())) {
+				list.Items = append(list.Items, item)
+			}
+		}
+		test.WriteObject(w, list)
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/namespaces/ns-1/pods" {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "PodList", "items": [
+			{"metadata": {"name": "web-1", "namespace": "ns-1"}, "spec": {"nodeName": "node-1", "containers": [{"name": "web"}, {"name": "proxy"}]}, "status": {"phase": "Running"}},
+			{"metadata": {"name": "web-2", "namespace": "ns-1"}, "spec": {"containers": [{"name": "web"}]}, "status": {"phase": "Pending"}}
+		]}`))
+	}))
+}
+
+func (s *ResourcesListSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *ResourcesListSuite) TestResourcesListLabelSelectorAllNamespaces() {
+	s.InitMcpClient()
+	s.Run("resources_list(labelSelector=app=frontend) in all namespaces", func() {
+		toolResult, err := s.CallTool("resources_list", map[string]interface{}{
+			"apiVersion":    "v1",
+			"kind":          "ConfigMap",
+			"labelSelector": "app=frontend",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		var decoded []unstructured.Unstructured
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("returns only matching resources from all namespaces", func() {
+			s.Require().Len(decoded, 2)
+			s.Equal("ns-1", decoded[0].GetNamespace())
+			s.Equal("frontend-config", decoded[0].GetName())
+			s.Equal("ns-2", decoded[1].GetNamespace())
+			s.Equal("frontend-config", decoded[1].GetName())
+		})
+	})
+	s.Run("resources_list(labelSelector=app) in all namespaces", func() {
+		toolResult, err := s.CallTool("resources_list", map[string]interface{}{
+			"apiVersion":    "v1",
+			"kind":          "ConfigMap",
+			"labelSelector": "app",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		var decoded []unstructured.Unstructured
+		s.Nilf(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "unmarshal failed")
+		s.Run("omits unlabeled resources", func() {
+			s.Len(decoded, 3)
+			for _, item := range decoded {
+				s.NotEqual("unlabeled-config", item.GetName())
+			}
+		})
+	})
+}
+
+func (s *ResourcesListSuite) TestResourcesListLabelSelectorInNamespace() {
+	s.InitMcpClient()
+	s.Run("resources_list(namespace=ns-2, labelSelector=app=frontend)", func() {
+		toolResult, err := s.CallTool("resources_list", map[string]interface{}{
+			"apiVersion":    "v1",
+			"kind":          "ConfigMap",
+			"namespace":     "ns-2",
+			"labelSelector": "app=frontend",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed")
+		var decoded []unstructured.Unstructured
+		s.Nilf(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "unmarshal failed")
+		s.Run("returns only matching resources from the namespace", func() {
+			s.Require().Len(decoded, 1)
+			s.Equal("ns-2", decoded[0].GetNamespace())
+		})
+	})
+}
+
+func (s *ResourcesListSuite) TestResourcesListTruncated() {
+	s.Cfg.MaxOutputBytes = 450
+	s.InitMcpClient()
+	s.Run("resources_list exceeding max_output_bytes", func() {
+		toolResult, err := s.CallTool("resources_list", map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("truncates output to max_output_bytes", func() {
+			s.LessOrEqual(len(text), 450)
+		})
+		s.Run("keeps whole items and adds truncation notice", func() {
+			var decoded []unstructured.Unstructured
+			s.Require().Nilf(yaml.Unmarshal([]byte(text), &decoded), "unmarshal failed")
+			s.Less(len(decoded), 4)
+			s.Contains(text, fmt.Sprintf("# ... output truncated, %d of 4 items shown", len(decoded)))
+		})
+	})
+}
+
+func (s *ResourcesListSuite) TestResourcesListColumns() {
+	s.InitMcpClient()
+	s.Run("resources_list(columns=.metadata.name,.status.phase)", func() {
+		toolResult, err := s.CallTool("resources_list", map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"namespace":  "ns-1",
+			"columns":    ".metadata.name,.status.phase",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		s.Run("returns projected columns as table", func() {
+			s.Equal("NAME    PHASE\n"+
+				"web-1   Running\n"+
+				"web-2   Pending\n", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+	s.Run("resources_list(columns=POD:.metadata.name,NODE:{.spec.nodeName},CONTAINERS:.spec.containers[*].name)", func() {
+		toolResult, err := s.CallTool("resources_list", map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"namespace":  "ns-1",
+			"columns":    "POD:.metadata.name,NODE:{.spec.nodeName},CONTAINERS:.spec.containers[*].name",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Run("returns custom headers, missing and multiple values", func() {
+			s.Equal("POD     NODE     CONTAINERS\n"+
+				"web-1   node-1   web,proxy\n"+
+				"web-2   <none>   web\n", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+	s.Run("resources_list(columns=.metadata.name,{.status[.phase}) with invalid JSONPath", func() {
+		toolResult, _ := s.CallTool("resources_list", map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"namespace":  "ns-1",
+			"columns":    ".metadata.name,{.status[.phase}",
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "failed to list resources, invalid column {.status[.phase}")
+	})
+}
+
+func TestResourcesListMockServer(t *testing.T) {
+	suite.Run(t, new(ResourcesListSuite))
+}
@@ -0,0 +1,148 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type ResourcesOwnersSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *ResourcesOwnersSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(
+		metav1.APIResourceList{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"get", "list"}},
+			},
+		},
+		metav1.APIResourceList{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "deployments", Kind: "Deployment", Namespaced: true, Verbs: []string{"get", "list"}},
+				{Name: "replicasets", Kind: "ReplicaSet", Namespaced: true, Verbs: []string{"get", "list"}},
+			},
+		},
+	))
+	const (
+		deployment = `{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "web", "namespace": "ns-1", "uid": "deployment-uid"}}`
+		replicaSet = `{"apiVersion": "apps/v1", "kind": "ReplicaSet", "metadata": {"name": "web-5d4f8", "namespace": "ns-1", "uid": "replicaset-uid",
+			"ownerReferences": [{"apiVersion": "apps/v1", "kind": "Deployment", "name": "web", "uid": "deployment-uid", "controller": true}]}}`
+		oldReplicaSet = `{"apiVersion": "apps/v1", "kind": "ReplicaSet", "metadata": {"name": "web-7c9b2", "namespace": "ns-1", "uid": "old-replicaset-uid",
+			"ownerReferences": [{"apiVersion": "apps/v1", "kind": "Deployment", "name": "web", "uid": "deployment-uid", "controller": true}]}}`
+		pod = `{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "web-5d4f8-abcde", "namespace": "ns-1", "uid": "pod-uid",
+			"ownerReferences": [{"apiVersion": "apps/v1", "kind": "ReplicaSet", "name": "web-5d4f8", "uid": "replicaset-uid", "controller": true}]}}`
+		orphan = `{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "orphan", "namespace": "ns-1", "uid": "orphan-uid",
+			"ownerReferences": [{"apiVersion": "apps/v1", "kind": "ReplicaSet", "name": "deleted", "uid": "deleted-uid", "controller": true}]}}`
+	)
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/apis/apps/v1/namespaces/ns-1/deployments/web":
+			_, _ = w.Write([]byte(deployment))
+		case "/apis/apps/v1/namespaces/ns-1/replicasets/web-5d4f8":
+			_, _ = w.Write([]byte(replicaSet))
+		case "/api/v1/namespaces/ns-1/pods/web-5d4f8-abcde":
+			_, _ = w.Write([]byte(pod))
+		case "/api/v1/namespaces/ns-1/pods/orphan":
+			_, _ = w.Write([]byte(orphan))
+		case "/apis/apps/v1/namespaces/ns-1/replicasets/deleted":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"kind": "Status", "apiVersion": "v1", "status": "Failure", "reason": "NotFound", "code": 404,
+				"message": "replicasets.apps \"deleted\" not found"}`))
+		case "/apis/apps/v1/namespaces/ns-1/deployments":
+			_, _ = w.Write([]byte(`{"apiVersion": "apps/v1", "kind": "DeploymentList", "items": [` + deployment + `]}`))
+		case "/apis/apps/v1/namespaces/ns-1/replicasets":
+			_, _ = w.Write([]byte(`{"apiVersion": "apps/v1", "kind": "ReplicaSetList", "items": [` + replicaSet + `,` + oldReplicaSet + `]}`))
+		case "/api/v1/namespaces/ns-1/pods":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "PodList", "items": [` + pod + `,` + orphan + `]}`))
+		}
+	}))
+}
+
+func (s *ResourcesOwnersSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *ResourcesOwnersSuite) TestResourcesOwners() {
+	s.InitMcpClient()
+	s.Run("resources_owners with missing name returns error", func() {
+		toolResult, _ := s.CallTool("resources_owners", map[string]interface{}{"apiVersion": "v1", "kind": "Pod", "namespace": "ns-1"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to get resource owners, missing argument name", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("resources_owners(kind=Pod, name=web-5d4f8-abcde)", func() {
+		toolResult, err := s.CallTool("resources_owners", map[string]interface{}{
+			"apiVersion": "v1", "kind": "Pod", "namespace": "ns-1", "name": "web-5d4f8-abcde",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("returns the full chain up to the Deployment", func() {
+			s.Equal([]interface{}{
+				map[string]interface{}{"APIVersion": "v1", "Kind": "Pod", "Namespace": "ns-1", "Name": "web-5d4f8-abcde"},
+				map[string]interface{}{"APIVersion": "apps/v1", "Kind": "ReplicaSet", "Namespace": "ns-1", "Name": "web-5d4f8"},
+				map[string]interface{}{"APIVersion": "apps/v1", "Kind": "Deployment", "Namespace": "ns-1", "Name": "web"},
+			}, decoded["Chain"])
+			s.NotContains(decoded, "Children")
+		})
+	})
+	s.Run("resources_owners(kind=Pod, name=orphan) reports the missing owner", func() {
+		toolResult, err := s.CallTool("resources_owners", map[string]interface{}{
+			"apiVersion": "v1", "kind": "Pod", "namespace": "ns-1", "name": "orphan",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		var decoded map[string]interface{}
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded))
+		s.Equal([]interface{}{
+			map[string]interface{}{"APIVersion": "v1", "Kind": "Pod", "Namespace": "ns-1", "Name": "orphan"},
+			map[string]interface{}{"APIVersion": "apps/v1", "Kind": "ReplicaSet", "Namespace": "ns-1", "Name": "deleted",
+				"Missing": "replicasets.apps \"deleted\" not found"},
+		}, decoded["Chain"])
+	})
+	s.Run("resources_owners(kind=Deployment, name=web, children=true) lists the controlled children", func() {
+		toolResult, err := s.CallTool("resources_owners", map[string]interface{}{
+			"apiVersion": "apps/v1", "kind": "Deployment", "namespace": "ns-1", "name": "web", "children": true,
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		var decoded map[string]interface{}
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded))
+		s.Equal([]interface{}{
+			map[string]interface{}{"APIVersion": "apps/v1", "Kind": "Deployment", "Namespace": "ns-1", "Name": "web"},
+		}, decoded["Chain"])
+		s.Equal([]interface{}{
+			map[string]interface{}{"APIVersion": "apps/v1", "Kind": "ReplicaSet", "Namespace": "ns-1", "Name": "web-5d4f8",
+				"Children": []interface{}{
+					map[string]interface{}{"APIVersion": "v1", "Kind": "Pod", "Namespace": "ns-1", "Name": "web-5d4f8-abcde"},
+				}},
+			map[string]interface{}{"APIVersion": "apps/v1", "Kind": "ReplicaSet", "Namespace": "ns-1", "Name": "web-7c9b2"},
+		}, decoded["Children"])
+	})
+}
+
+func TestResourcesOwners(t *testing.T) {
+	suite.Run(t, new(ResourcesOwnersSuite))
+}
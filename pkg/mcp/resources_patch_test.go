@@ -0,0 +1,239 @@
+package mcp
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type ResourcesPatchSuite struct {
+	BaseMcpSuite
+	mockServer       *test.MockServer
+	patchContentType string
+	patchBody        string
+}
+
+func (s *ResourcesPatchSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.patchContentType = ""
+	s.patchBody = ""
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(
+		metav1.APIResourceList{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "configmaps", Kind: "ConfigMap", Namespaced: true, Verbs: []string{"get", "patch"}},
+			},
+		},
+	))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/namespaces/ns-1/configmaps/cm-1" || req.Method != http.MethodPatch {
+			return
+		}
+		body, _ := io.ReadAll(req.Body)
+		s.patchContentType = req.Header.Get("Content-Type")
+		s.patchBody = string(body)
+		// Live resource has resourceVersion 2, stale resourceVersion 1 is rejected with a Conflict
+		if strings.Contains(s.patchBody, `"resourceVersion":"1"`) || strings.Contains(s.patchBody, `"path":"/metadata/resourceVersion","value":"1"`) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Status", "status": "Failure", "reason": "Conflict", "code": 409,
+				"message": "Operation cannot be fulfilled on configmaps \"cm-1\": the object has been modified; please apply your changes to the latest version and try again"}`))
+			return
+		}
+		test.WriteObject(w, &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]interface{}{"name": "cm-1", "namespace": "ns-1", "resourceVersion": "2"},
+			"data":       map[string]interface{}{"key": "patched"},
+		}})
+	}))
+}
+
+func (s *ResourcesPatchSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *ResourcesPatchSuite) TestResourcesPatch() {
+	s.InitMcpClient()
+	s.Run("resources_patch with missing patch returns error", func() {
+		toolResult, _ := s.CallTool("resources_patch", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "namespace": "ns-1", "name": "cm-1",
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to patch resource, missing argument patch", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("resources_patch with invalid patch_type returns error", func() {
+		toolResult, _ := s.CallTool("resources_patch", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "namespace": "ns-1", "name": "cm-1",
+			"patch_type": "apply", "patch": `{"data":{"key":"patched"}}`,
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to patch resource, invalid patch_type apply (valid values are strategic, merge, json)", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	testCases := []struct {
+		patchType   string
+		patch       string
+		contentType types.PatchType
+	}{
+		{"strategic", `{"data":{"key":"patched"}}`, types.StrategicMergePatchType},
+		{"merge", `{"data":{"key":"patched"}}`, types.MergePatchType},
+		{"json", `[{"op":"replace","path":"/data/key","value":"patched"}]`, types.JSONPatchType},
+	}
+	for _, tc := range testCases {
+		s.Run("resources_patch(patch_type="+tc.patchType+")", func() {
+			toolResult, err := s.CallTool("resources_patch", map[string]interface{}{
+				"apiVersion": "v1", "kind": "ConfigMap", "namespace": "ns-1", "name": "cm-1",
+				"patch_type": tc.patchType, "patch": tc.patch,
+			})
+			s.Run("no error", func() {
+				s.Nilf(err, "call tool failed %v", err)
+				s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+			})
+			s.Run("sends patch with content type "+string(tc.contentType), func() {
+				s.Equal(string(tc.contentType), s.patchContentType)
+				s.Equal(tc.patch, s.patchBody)
+			})
+			s.Run("returns patched resource", func() {
+				s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "# The following resource (YAML) has been patched successfully\n")
+				s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "key: patched")
+			})
+		})
+	}
+	s.Run("resources_patch with patch_type=strategic by default", func() {
+		toolResult, _ := s.CallTool("resources_patch", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "namespace": "ns-1", "name": "cm-1",
+			"patch": `{"data":{"key":"patched"}}`,
+		})
+		s.Falsef(toolResult.IsError, "call tool failed")
+		s.Equal(string(types.StrategicMergePatchType), s.patchContentType)
+	})
+	s.Run("resources_patch with malformed json patch returns error", func() {
+		s.patchBody = ""
+		toolResult, _ := s.CallTool("resources_patch", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "namespace": "ns-1", "name": "cm-1",
+			"patch_type": "json", "patch": `[{"op":"replace","path":"/data/key",`,
+		})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "failed to patch resource: invalid JSON patch, expected a list of operations")
+		})
+		s.Run("does not send patch", func() {
+			s.Empty(s.patchBody)
+		})
+	})
+	s.Run("resources_patch with json patch object returns error", func() {
+		toolResult, _ := s.CallTool("resources_patch", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "namespace": "ns-1", "name": "cm-1",
+			"patch_type": "json", "patch": `{"data":{"key":"patched"}}`,
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "invalid JSON patch, expected a list of operations")
+	})
+	s.Run("resources_patch with json patch invalid op returns error", func() {
+		toolResult, _ := s.CallTool("resources_patch", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "namespace": "ns-1", "name": "cm-1",
+			"patch_type": "json", "patch": `[{"op":"update","path":"/data/key","value":"patched"}]`,
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to patch resource: invalid JSON patch, operation 0 has an invalid op \"update\"", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("resources_patch with malformed merge patch returns error", func() {
+		toolResult, _ := s.CallTool("resources_patch", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "namespace": "ns-1", "name": "cm-1",
+			"patch_type": "merge", "patch": `data: {key: patched}`,
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "failed to patch resource: invalid merge patch, expected a JSON object")
+	})
+}
+
+func (s *ResourcesPatchSuite) TestResourcesPatchResourceVersion() {
+	s.InitMcpClient()
+	testCases := []struct {
+		patchType    string
+		patch        string
+		precondition string
+	}{
+		{"strategic", `{"data":{"key":"patched"}}`, `"metadata":{"resourceVersion":"2"}`},
+		{"merge", `{"data":{"key":"patched"}}`, `"metadata":{"resourceVersion":"2"}`},
+		{"json", `[{"op":"replace","path":"/data/key","value":"patched"}]`, `{"op":"add","path":"/metadata/resourceVersion","value":"2"}`},
+	}
+	for _, tc := range testCases {
+		s.Run("resources_patch(patch_type="+tc.patchType+") with stale resource_version", func() {
+			toolResult, err := s.CallTool("resources_patch", map[string]interface{}{
+				"apiVersion": "v1", "kind": "ConfigMap", "namespace": "ns-1", "name": "cm-1",
+				"patch_type": tc.patchType, "patch": tc.patch, "resource_version": "1",
+			})
+			s.Run("returns conflict error", func() {
+				s.Nilf(err, "call tool failed %v", err)
+				s.Truef(toolResult.IsError, "call tool should fail")
+				s.Contains(toolResult.Content[0].(mcp.TextContent).Text,
+					"failed to patch resource: conflict, the resource has been modified and no longer matches resource version 1, get the latest version and retry")
+			})
+		})
+		s.Run("resources_patch(patch_type="+tc.patchType+") with matching resource_version", func() {
+			toolResult, err := s.CallTool("resources_patch", map[string]interface{}{
+				"apiVersion": "v1", "kind": "ConfigMap", "namespace": "ns-1", "name": "cm-1",
+				"patch_type": tc.patchType, "patch": tc.patch, "resource_version": "2",
+			})
+			s.Run("no error", func() {
+				s.Nilf(err, "call tool failed %v", err)
+				s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+			})
+			s.Run("sends patch with resource version precondition", func() {
+				s.Contains(s.patchBody, tc.precondition)
+				s.Contains(s.patchBody, "patched")
+			})
+		})
+	}
+	resource := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm-1\n  namespace: ns-1\ndata:\n  key: patched\n"
+	s.Run("resources_create_or_update with stale resource_version", func() {
+		toolResult, err := s.CallTool("resources_create_or_update", map[string]interface{}{
+			"resource": resource, "resource_version": "1",
+		})
+		s.Run("returns conflict error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Contains(toolResult.Content[0].(mcp.TextContent).Text,
+				"conflict, the resource has been modified and no longer matches resource version 1, get the latest version and retry")
+		})
+	})
+	s.Run("resources_create_or_update with matching resource_version", func() {
+		toolResult, err := s.CallTool("resources_create_or_update", map[string]interface{}{
+			"resource": resource, "resource_version": "2",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		s.Run("applies resource with resource version precondition", func() {
+			s.Equal(string(types.ApplyPatchType), s.patchContentType)
+			s.Contains(s.patchBody, `"resourceVersion":"2"`)
+		})
+	})
+	s.Run("resources_create_or_update with resource_version and multiple resources returns error", func() {
+		toolResult, _ := s.CallTool("resources_create_or_update", map[string]interface{}{
+			"resource": resource + "---\n" + resource, "resource_version": "2",
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "resource version can only be provided for a single resource")
+	})
+}
+
+func TestResourcesPatch(t *testing.T) {
+	suite.Run(t, new(ResourcesPatchSuite))
+}
@@ -0,0 +1,255 @@
+package mcp
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+type ResourcesWatchSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+	// watchQuery records the query of the last watch request
+	watchQuery url.Values
+	// watchEvents are the events streamed by the mock watch endpoint
+	watchEvents []string
+	// closeWatch closes the watch once all the events are streamed (instead of waiting for the client to stop it)
+	closeWatch bool
+	// watchAborted is notified when the client stops the watch request
+	watchAborted chan struct{}
+}
+
+func (s *ResourcesWatchSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.watchQuery = nil
+	s.watchEvents = nil
+	s.closeWatch = false
+	s.watchAborted = make(chan struct{}, 1)
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"get", "list", "watch"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/namespaces/default/pods" {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if req.URL.Query().Get("watch") != "true" {
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "PodList", "metadata": {"resourceVersion": "100"}, "items": [
+				{"metadata": {"name": "web", "namespace": "default", "resourceVersion": "100"}, "status": {"phase": "Pending"}}
+			]}`))
+			return
+		}
+		s.watchQuery = req.URL.Query()
+		for _, event := range s.watchEvents {
+			_, _ = w.Write([]byte(event + "\n"))
+		}
+		w.(http.Flusher).Flush()
+		if !s.closeWatch {
+			<-req.Context().Done()
+			select {
+			case s.watchAborted <- struct{}{}:
+			default:
+			}
+		}
+	}))
+}
+
+func (s *ResourcesWatchSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *ResourcesWatchSuite) TestResourcesWatch() {
+	s.watchEvents = []string{
+		`{"type": "MODIFIED", "object": {"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "web", "namespace": "default", "resourceVersion": "101"}, "status": {"phase": "Running"}}}`,
+	}
+	s.InitMcpClient()
+	s.Run("resources_watch(name=web, timeout_seconds=1)", func() {
+		toolResult, err := s.CallTool("resources_watch", map[string]interface{}{
+			"apiVersion":      "v1",
+			"kind":            "Pod",
+			"name":            "web",
+			"timeout_seconds": 1,
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		s.Run("watches the named resource from the listed resource version", func() {
+			s.Require().NotNil(s.watchQuery)
+			s.Equal("metadata.name=web", s.watchQuery.Get("fieldSelector"))
+			s.Equal("100", s.watchQuery.Get("resourceVersion"))
+		})
+		var decoded map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("captures the Modified event", func() {
+			s.Require().Len(decoded["Events"], 1)
+			event := decoded["Events"].([]interface{})[0].(map[string]interface{})
+			s.Equal("MODIFIED", event["Type"])
+			s.Equal("default", event["Namespace"])
+			s.Equal("web", event["Name"])
+			s.Equal("101", event["ResourceVersion"])
+			s.NotEmpty(event["ObservedAt"])
+		})
+		s.Run("stops after timeout", func() {
+			s.Equal("timed out after 1s", decoded["StopReason"])
+		})
+	})
+}
+
+func (s *ResourcesWatchSuite) TestResourcesWatchUntil() {
+	s.watchEvents = []string{
+		`{"type": "MODIFIED", "object": {"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "web", "namespace": "default", "resourceVersion": "101"}, "status": {"phase": "Pending", "conditions": [{"type": "Ready", "status": "False"}]}}}`,
+		`{"type": "MODIFIED", "object": {"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "web", "namespace": "default", "resourceVersion": "102"}, "status": {"phase": "Running", "conditions": [{"type": "Ready", "status": "True"}]}}}`,
+		`{"type": "DELETED", "object": {"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "web", "namespace": "default", "resourceVersion": "103"}}}`,
+	}
+	s.InitMcpClient()
+	for _, until := range []string{"jsonpath={.status.phase}=Running", "condition=Ready"} {
+		s.Run("resources_watch(until="+until+")", func() {
+			toolResult, err := s.CallTool("resources_watch", map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"namespace":  "default",
+				"until":      until,
+			})
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+			var decoded map[string]interface{}
+			s.Nilf(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "unmarshal failed")
+			s.Run("stops once the condition is met", func() {
+				s.Equal("condition met", decoded["StopReason"])
+				s.Require().Len(decoded["Events"], 2)
+				s.Equal("102", decoded["Events"].([]interface{})[1].(map[string]interface{})["ResourceVersion"])
+			})
+		})
+	}
+	s.Run("resources_watch(until=delete, max_events=1)", func() {
+		toolResult, err := s.CallTool("resources_watch", map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"namespace":  "default",
+			"until":      "delete",
+			"max_events": 1,
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		var decoded map[string]interface{}
+		s.Nilf(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "unmarshal failed")
+		s.Run("stops once the maximum number of events is reached", func() {
+			s.Equal("reached the maximum of 1 events", decoded["StopReason"])
+			s.Len(decoded["Events"], 1)
+		})
+	})
+	s.Run("resources_watch(until=jsonpath={.status.phase}=Pending) already met", func() {
+		toolResult, err := s.CallTool("resources_watch", map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"namespace":  "default",
+			"until":      "jsonpath={.status.phase}=Pending",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		var decoded map[string]interface{}
+		s.Nilf(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "unmarshal failed")
+		s.Equal("condition already met before watching", decoded["StopReason"])
+		s.Empty(decoded["Events"])
+	})
+}
+
+func (s *ResourcesWatchSuite) TestResourcesWatchClosed() {
+	s.closeWatch = true
+	s.InitMcpClient()
+	s.Run("resources_watch when the server closes the watch", func() {
+		toolResult, err := s.CallTool("resources_watch", map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"namespace":  "default",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		var decoded map[string]interface{}
+		s.Nilf(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "unmarshal failed")
+		s.Equal("watch closed by the server", decoded["StopReason"])
+		s.Empty(decoded["Events"])
+	})
+}
+
+func (s *ResourcesWatchSuite) TestResourcesWatchInvalidCondition() {
+	s.InitMcpClient()
+	for _, until := range []string{"ready", "jsonpath={.status.phase", "condition="} {
+		s.Run("resources_watch(until="+until+")", func() {
+			toolResult, _ := s.CallTool("resources_watch", map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"until":      until,
+			})
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "invalid condition")
+		})
+	}
+}
+
+func (s *ResourcesWatchSuite) TestResourcesWatchCancelled() {
+	s.InitMcpClient()
+	s.Run("resources_watch(timeout_seconds=60) with cancelled request", func() {
+		ctx, cancel := context.WithTimeout(s.T().Context(), 500*time.Millisecond)
+		defer cancel()
+		request := mcp.CallToolRequest{}
+		request.Params.Name = "resources_watch"
+		request.Params.Arguments = map[string]interface{}{
+			"apiVersion":      "v1",
+			"kind":            "Pod",
+			"timeout_seconds": 60,
+		}
+		start := time.Now()
+		_, err := s.Client.CallTool(ctx, request)
+		s.Run("returns promptly", func() {
+			s.Error(err)
+			s.Less(time.Since(start), 5*time.Second)
+		})
+		s.Run("aborts the in-flight watch request", func() {
+			select {
+			case <-s.watchAborted:
+			case <-time.After(5 * time.Second):
+				s.Fail("watch request was not aborted")
+			}
+		})
+	})
+	s.Run("ResourcesWatch returns the context error when cancelled", func() {
+		k, err := s.mcpServer.p.GetDerivedKubernetes(s.T().Context(), s.mcpServer.p.GetDefaultTarget())
+		s.Require().NoError(err)
+		ctx, cancel := context.WithCancel(s.T().Context())
+		time.AfterFunc(200*time.Millisecond, cancel)
+		start := time.Now()
+		ret, err := k.ResourcesWatch(ctx, &schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, "default",
+			kubernetes.ResourcesWatchOptions{Timeout: time.Minute})
+		s.ErrorIs(err, context.Canceled)
+		s.Nil(ret)
+		s.Less(time.Since(start), 5*time.Second)
+	})
+}
+
+func TestResourcesWatch(t *testing.T) {
+	suite.Run(t, new(ResourcesWatchSuite))
+}
@@ -0,0 +1,162 @@
+package mcp
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type RoutesSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+	notAfter   time.Time
+}
+
+func (s *RoutesSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.Cfg.Toolsets = []string{"routes"}
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.notAfter = time.Now().Add(10 * 24 * time.Hour).UTC().Truncate(time.Second)
+}
+
+func (s *RoutesSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *RoutesSuite) certificate() string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err, "Expected no error generating key")
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "app.apps.example.com"},
+		DNSNames:     []string{"app.apps.example.com", "www.example.com"},
+		IPAddresses:  []net.IP{net.ParseIP("10.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     s.notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	s.Require().NoError(err, "Expected no error creating certificate")
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func (s *RoutesSuite) handleRoutes() {
+	certificate := s.certificate()
+	s.mockServer.Handle(test.NewInOpenShiftDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "route.openshift.io/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "routes", Kind: "Route", Namespaced: true, Verbs: []string{"get", "list"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/apis/route.openshift.io/v1/namespaces/ns-1/routes" {
+			return
+		}
+		route := func(name, termination, certificate string) unstructured.Unstructured {
+			tls := map[string]interface{}{"termination": termination}
+			if certificate != "" {
+				tls["certificate"] = certificate
+			}
+			return unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "route.openshift.io/v1",
+				"kind":       "Route",
+				"metadata":   map[string]interface{}{"name": name, "namespace": "ns-1"},
+				"spec":       map[string]interface{}{"host": name + ".apps.example.com", "tls": tls},
+			}}
+		}
+		test.WriteObject(w, &unstructured.UnstructuredList{
+			Object: map[string]interface{}{"apiVersion": "route.openshift.io/v1", "kind": "RouteList"},
+			Items: []unstructured.Unstructured{
+				route("app", "edge", certificate),
+				route("default-cert", "reencrypt", ""),
+				route("passthrough", "passthrough", ""),
+			},
+		})
+	}))
+}
+
+func (s *RoutesSuite) TestRoutesTLS() {
+	s.handleRoutes()
+	s.InitMcpClient()
+	s.Run("routes_tls(namespace=ns-1)", func() {
+		toolResult, err := s.CallTool("routes_tls", map[string]interface{}{
+			"namespace": "ns-1",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("skips passthrough routes", func() {
+			s.Require().Len(decoded, 2)
+			s.Equal("app", decoded[0]["Name"])
+			s.Equal("default-cert", decoded[1]["Name"])
+		})
+		s.Run("extracts embedded certificate details", func() {
+			s.Equal("edge", decoded[0]["Termination"])
+			s.Equal("CN=app.apps.example.com", decoded[0]["Subject"])
+			s.Equal("CN=app.apps.example.com", decoded[0]["Issuer"])
+			s.Equal([]interface{}{"app.apps.example.com", "www.example.com", "10.0.0.1"}, decoded[0]["SANs"])
+			s.Equal(s.notAfter.Format(time.RFC3339), decoded[0]["NotAfter"])
+		})
+		s.Run("flags certificate expiring within default warn_days", func() {
+			s.Equal(true, decoded[0]["Expiring"])
+		})
+		s.Run("references default serving certificate", func() {
+			s.Equal("default ingress serving certificate", decoded[1]["Certificate"])
+		})
+	})
+	s.Run("routes_tls(namespace=ns-1, warn_days=5)", func() {
+		toolResult, err := s.CallTool("routes_tls", map[string]interface{}{
+			"namespace": "ns-1",
+			"warn_days": 5,
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("does not flag certificate expiring after warn_days", func() {
+			s.Require().NoError(err)
+			s.Require().NotEmpty(decoded)
+			s.Equal(false, decoded[0]["Expiring"])
+		})
+	})
+}
+
+func (s *RoutesSuite) TestRoutesNotInOpenShift() {
+	s.InitMcpClient()
+	s.Run("ListTools does not return routes tools", func() {
+		tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+		s.Require().NoError(err, "Expected no error from ListTools")
+		s.Empty(tools.Tools, "Expected no tools when not running in OpenShift")
+	})
+}
+
+func TestRoutes(t *testing.T) {
+	suite.Run(t, new(RoutesSuite))
+}
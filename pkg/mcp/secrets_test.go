@@ -0,0 +1,134 @@
+package mcp
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type SecretsSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *SecretsSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "secrets", Kind: "Secret", Namespaced: true, Verbs: []string{"get", "list"}},
+		},
+	}))
+	secrets := map[string]string{
+		"Opaque": `{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "db-credentials", "namespace": "ns-1"}, "type": "Opaque",
+			"data": {"username": "YWRtaW4=", "password": "czNjcjN0"}}`,
+		"kubernetes.io/tls": `{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "web-tls", "namespace": "ns-1"}, "type": "kubernetes.io/tls",
+			"data": {"tls.crt": "Y2VydGlmaWNhdGU=", "tls.key": "cHJpdmF0ZS1rZXk="}}`,
+		"kubernetes.io/dockerconfigjson": `{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "pull-secret", "namespace": "ns-1"}, "type": "kubernetes.io/dockerconfigjson",
+			"data": {".dockerconfigjson": "eyJhdXRocyI6e319"}}`,
+		"kubernetes.io/service-account-token": `{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "builder-token", "namespace": "ns-1",
+			"annotations": {"kubernetes.io/service-account.name": "builder"}}, "type": "kubernetes.io/service-account-token",
+			"data": {"token": "dG9rZW4=", "ca.crt": "Y2E="}}`,
+	}
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/namespaces/ns-1/secrets" {
+			return
+		}
+		// Honor the type field selector like the API server
+		items := make([]string, 0, len(secrets))
+		for _, secretType := range []string{"Opaque", "kubernetes.io/tls", "kubernetes.io/dockerconfigjson", "kubernetes.io/service-account-token"} {
+			if fieldSelector := req.URL.Query().Get("fieldSelector"); fieldSelector == "" || fieldSelector == "type="+secretType {
+				items = append(items, secrets[secretType])
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "SecretList", "items": [` + strings.Join(items, ",") + `]}`))
+	}))
+}
+
+func (s *SecretsSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *SecretsSuite) TestSecretsList() {
+	s.InitMcpClient()
+	s.Run("secrets_list(namespace=ns-1)", func() {
+		toolResult, err := s.CallTool("secrets_list", map[string]interface{}{"namespace": "ns-1"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("returns all the secrets with their key names", func() {
+			s.Require().Len(decoded, 4)
+			s.Equal(map[string]interface{}{
+				"Namespace": "ns-1",
+				"Name":      "db-credentials",
+				"Type":      "Opaque",
+				"Keys":      []interface{}{"password", "username"},
+			}, decoded[0])
+			s.Equal(map[string]interface{}{
+				"Namespace":      "ns-1",
+				"Name":           "builder-token",
+				"Type":           "kubernetes.io/service-account-token",
+				"Keys":           []interface{}{"ca.crt", "token"},
+				"ServiceAccount": "builder",
+			}, decoded[3])
+		})
+	})
+	s.Run("secrets_list(namespace=ns-1, type=kubernetes.io/tls)", func() {
+		toolResult, err := s.CallTool("secrets_list", map[string]interface{}{
+			"namespace": "ns-1",
+			"type":      "kubernetes.io/tls",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(text), &decoded)
+		s.Run("returns only the TLS secrets with their key names", func() {
+			s.Require().NoError(err)
+			s.Equal([]map[string]interface{}{{
+				"Namespace": "ns-1",
+				"Name":      "web-tls",
+				"Type":      "kubernetes.io/tls",
+				"Keys":      []interface{}{"tls.crt", "tls.key"},
+			}}, decoded)
+		})
+		s.Run("does not return secret values", func() {
+			s.NotContains(text, "Y2VydGlmaWNhdGU=")
+			s.NotContains(text, "cHJpdmF0ZS1rZXk=")
+		})
+	})
+	s.Run("secrets_list(namespace=ns-1, type=bootstrap.kubernetes.io/token)", func() {
+		toolResult, err := s.CallTool("secrets_list", map[string]interface{}{
+			"namespace": "ns-1",
+			"type":      "bootstrap.kubernetes.io/token",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Equal("# No secrets found", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func TestSecrets(t *testing.T) {
+	suite.Run(t, new(SecretsSuite))
+}
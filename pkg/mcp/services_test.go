@@ -0,0 +1,155 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type ServicesSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *ServicesSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "services", Kind: "Service", Namespaced: true, Verbs: []string{"get", "list"}},
+		},
+	}, metav1.APIResourceList{
+		GroupVersion: "discovery.k8s.io/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "endpointslices", Kind: "EndpointSlice", Namespaced: true, Verbs: []string{"get", "list"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/apis/authorization.k8s.io/v1/selfsubjectaccessreviews":
+			// Allow listing services and endpoint slices in all namespaces
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "authorization.k8s.io/v1", "kind": "SelfSubjectAccessReview", "status": {"allowed": true}}`))
+		case "/api/v1/services":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "ServiceList", "items": [
+				{"apiVersion": "v1", "kind": "Service",
+				 "metadata": {"name": "web", "namespace": "ns-1"},
+				 "spec": {"type": "ClusterIP", "clusterIP": "10.96.0.10", "selector": {"app": "web"},
+				  "ports": [{"name": "http", "protocol": "TCP", "port": 80, "targetPort": 8080}]}},
+				{"apiVersion": "v1", "kind": "Service",
+				 "metadata": {"name": "api", "namespace": "ns-2"},
+				 "spec": {"type": "NodePort", "clusterIP": "10.96.0.20", "selector": {"app": "api"},
+				  "ports": [{"protocol": "TCP", "port": 443, "targetPort": "https", "nodePort": 30443}]}},
+				{"apiVersion": "v1", "kind": "Service",
+				 "metadata": {"name": "db", "namespace": "ns-2"},
+				 "spec": {"type": "ExternalName", "externalName": "db.example.com"}}
+			]}`))
+		case "/apis/discovery.k8s.io/v1/endpointslices":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "discovery.k8s.io/v1", "kind": "EndpointSliceList", "items": [
+				{"apiVersion": "discovery.k8s.io/v1", "kind": "EndpointSlice", "addressType": "IPv4",
+				 "metadata": {"name": "web-ipv4", "namespace": "ns-1", "labels": {"kubernetes.io/service-name": "web"}},
+				 "endpoints": [
+				  {"addresses": ["10.244.0.5"], "conditions": {"ready": true}, "targetRef": {"kind": "Pod", "namespace": "ns-1", "name": "web-1"}},
+				  {"addresses": ["10.244.0.6"], "conditions": {"ready": false}, "targetRef": {"kind": "Pod", "namespace": "ns-1", "name": "web-2"}}
+				 ]},
+				{"apiVersion": "discovery.k8s.io/v1", "kind": "EndpointSlice", "addressType": "IPv6",
+				 "metadata": {"name": "web-ipv6", "namespace": "ns-1", "labels": {"kubernetes.io/service-name": "web"}},
+				 "endpoints": [
+				  {"addresses": ["fd00::5"], "conditions": {"ready": true}, "targetRef": {"kind": "Pod", "namespace": "ns-1", "name": "web-1"}}
+				 ]},
+				{"apiVersion": "discovery.k8s.io/v1", "kind": "EndpointSlice", "addressType": "IPv4",
+				 "metadata": {"name": "api-ipv4", "namespace": "ns-2", "labels": {"kubernetes.io/service-name": "api"}},
+				 "endpoints": [
+				  {"addresses": ["10.244.1.7"], "conditions": {"ready": false}, "targetRef": {"kind": "Pod", "namespace": "ns-2", "name": "api-1"}}
+				 ]}
+			]}`))
+		case "/api/v1/namespaces/empty/services":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "ServiceList", "items": []}`))
+		case "/apis/discovery.k8s.io/v1/namespaces/empty/endpointslices":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "discovery.k8s.io/v1", "kind": "EndpointSliceList", "items": []}`))
+		}
+	}))
+}
+
+func (s *ServicesSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *ServicesSuite) TestServicesList() {
+	s.InitMcpClient()
+	s.Run("services_list()", func() {
+		toolResult, err := s.CallTool("services_list", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Require().Len(decoded, 3)
+		s.Run("counts ready and total endpoints across slices", func() {
+			s.Equal(map[string]interface{}{
+				"Namespace":        "ns-1",
+				"Name":             "web",
+				"Type":             "ClusterIP",
+				"ClusterIP":        "10.96.0.10",
+				"Selector":         "app=web",
+				"Ports":            []interface{}{"http 80->8080/TCP"},
+				"ReadyEndpoints":   float64(1),
+				"TotalEndpoints":   float64(2),
+				"NoReadyEndpoints": false,
+			}, decoded[0])
+		})
+		s.Run("flags services with no ready endpoints", func() {
+			s.Equal(map[string]interface{}{
+				"Namespace":        "ns-2",
+				"Name":             "api",
+				"Type":             "NodePort",
+				"ClusterIP":        "10.96.0.20",
+				"Selector":         "app=api",
+				"Ports":            []interface{}{"443->https/TCP (nodePort 30443)"},
+				"ReadyEndpoints":   float64(0),
+				"TotalEndpoints":   float64(1),
+				"NoReadyEndpoints": true,
+			}, decoded[1])
+		})
+		s.Run("doesn't flag ExternalName services", func() {
+			s.Equal(map[string]interface{}{
+				"Namespace":    "ns-2",
+				"Name":         "db",
+				"Type":         "ExternalName",
+				"ExternalName": "db.example.com",
+				"Ports":        []interface{}{},
+			}, decoded[2])
+		})
+	})
+	s.Run("services_list(namespace=empty)", func() {
+		toolResult, err := s.CallTool("services_list", map[string]interface{}{
+			"namespace": "empty",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Equal("# No services found", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func TestServices(t *testing.T) {
+	suite.Run(t, new(ServicesSuite))
+}
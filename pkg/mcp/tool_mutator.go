@@ -39,6 +39,42 @@ func WithTargetParameter(defaultCluster, targetParameterName string, targets []s
 	}
 }
 
+// Names of the parameters selecting the user and groups to impersonate in the tool calls
+const (
+	impersonateUserParameterName   = "as_user"
+	impersonateGroupsParameterName = "as_groups"
+)
+
+// WithImpersonationParameters adds the user and groups impersonation parameters to the tool's input schema if
+// impersonation is enabled and the tool is cluster-aware
+func WithImpersonationParameters(enabled bool) ToolMutator {
+	return func(tool api.ServerTool) api.ServerTool {
+		if !enabled || !tool.IsClusterAware() {
+			return tool
+		}
+
+		if tool.Tool.InputSchema == nil {
+			tool.Tool.InputSchema = &jsonschema.Schema{Type: "object"}
+		}
+
+		if tool.Tool.InputSchema.Properties == nil {
+			tool.Tool.InputSchema.Properties = make(map[string]*jsonschema.Schema)
+		}
+
+		tool.Tool.InputSchema.Properties[impersonateUserParameterName] = &jsonschema.Schema{
+			Type:        "string",
+			Description: "Optional user to impersonate when running the tool, the tool only performs what this user is allowed to do",
+		}
+		tool.Tool.InputSchema.Properties[impersonateGroupsParameterName] = &jsonschema.Schema{
+			Type:        "array",
+			Description: "Optional groups to impersonate along with as_user when running the tool",
+			Items:       &jsonschema.Schema{Type: "string"},
+		}
+
+		return tool
+	}
+}
+
 func createTargetProperty(defaultCluster, targetName string, targets []string) *jsonschema.Schema {
 	baseSchema := &jsonschema.Schema{
 		Type: "string",
@@ -345,3 +345,37 @@ func (s *TargetParameterToolMutatorSuite) TestNonClusterAwareTool() {
 func TestTargetParameterToolMutator(t *testing.T) {
 	suite.Run(t, new(TargetParameterToolMutatorSuite))
 }
+
+type ImpersonationParametersToolMutatorSuite struct {
+	suite.Suite
+}
+
+func (s *ImpersonationParametersToolMutatorSuite) TestEnabled() {
+	tool := WithImpersonationParameters(true)(createTestTool("cluster-aware-tool"))
+	s.Run("adds as_user parameter", func() {
+		s.Require().NotNil(tool.Tool.InputSchema.Properties["as_user"], "Expected as_user property to be added")
+		s.Equal("string", tool.Tool.InputSchema.Properties["as_user"].Type)
+	})
+	s.Run("adds as_groups parameter", func() {
+		s.Require().NotNil(tool.Tool.InputSchema.Properties["as_groups"], "Expected as_groups property to be added")
+		s.Equal("array", tool.Tool.InputSchema.Properties["as_groups"].Type)
+		s.Equal("string", tool.Tool.InputSchema.Properties["as_groups"].Items.Type)
+	})
+}
+
+func (s *ImpersonationParametersToolMutatorSuite) TestDisabled() {
+	tool := WithImpersonationParameters(false)(createTestTool("cluster-aware-tool"))
+	s.Nilf(tool.Tool.InputSchema.Properties["as_user"], "Expected as_user property to not be added")
+	s.Nilf(tool.Tool.InputSchema.Properties["as_groups"], "Expected as_groups property to not be added")
+}
+
+func (s *ImpersonationParametersToolMutatorSuite) TestNonClusterAwareTool() {
+	tool := createTestTool("non-cluster-aware-tool")
+	tool.ClusterAware = ptr.To(false)
+	tool = WithImpersonationParameters(true)(tool)
+	s.Nilf(tool.Tool.InputSchema.Properties["as_user"], "Expected as_user property to not be added")
+}
+
+func TestImpersonationParametersToolMutator(t *testing.T) {
+	suite.Run(t, new(ImpersonationParametersToolMutatorSuite))
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/config"
 	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/core"
 	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/helm"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/mustgather"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/suite"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
@@ -182,6 +183,32 @@ func (s *ToolsetsSuite) TestGranularToolsetsTools() {
 	}
 }
 
+func (s *ToolsetsSuite) TestGranularToolsetsToolsInOpenShift() {
+	testCases := []api.Toolset{
+		&mustgather.Toolset{},
+	}
+	for _, testCase := range testCases {
+		s.Run("Toolset "+testCase.GetName()+" in OpenShift", func() {
+			s.Handle(&test.InOpenShiftHandler{})
+			toolsets.Clear()
+			toolsets.Register(testCase)
+			s.Cfg.Toolsets = []string{testCase.GetName()}
+			s.InitMcpClient()
+			tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+			s.Run("ListTools returns tools", func() {
+				s.NotNil(tools, "Expected tools from ListTools")
+				s.NoError(err, "Expected no error from ListTools")
+			})
+			s.Run("ListTools returns correct Tool metadata", func() {
+				expectedMetadata := test.ReadFile("testdata", "toolsets-"+testCase.GetName()+"-tools.json")
+				metadata, err := json.MarshalIndent(tools.Tools, "", "  ")
+				s.Require().NoErrorf(err, "failed to marshal tools metadata: %v", err)
+				s.JSONEq(expectedMetadata, string(metadata), "tools metadata does not match expected")
+			})
+		})
+	}
+}
+
 func (s *ToolsetsSuite) TestInputSchemaEdgeCases() {
 	//https://github.com/containers/kubernetes-mcp-server/issues/340
 	s.Run("InputSchema for no-arg tool is object with empty properties", func() {
@@ -0,0 +1,134 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type WebhooksSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *WebhooksSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "admissionregistration.k8s.io/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "validatingwebhookconfigurations", Kind: "ValidatingWebhookConfiguration", Namespaced: false, Verbs: []string{"get", "list"}},
+			{Name: "mutatingwebhookconfigurations", Kind: "MutatingWebhookConfiguration", Namespaced: false, Verbs: []string{"get", "list"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/apis/admissionregistration.k8s.io/v1/validatingwebhookconfigurations":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "admissionregistration.k8s.io/v1", "kind": "ValidatingWebhookConfigurationList", "items": [
+				{"apiVersion": "admissionregistration.k8s.io/v1", "kind": "ValidatingWebhookConfiguration",
+				 "metadata": {"name": "policy-engine"},
+				 "webhooks": [
+				  {"name": "pods.policy.example.com", "failurePolicy": "Fail", "timeoutSeconds": 10,
+				   "clientConfig": {"service": {"namespace": "policy", "name": "policy-webhook", "port": 443, "path": "/validate"}},
+				   "rules": [{"operations": ["CREATE", "UPDATE"], "apiGroups": [""], "apiVersions": ["v1"], "resources": ["pods"]}]},
+				  {"name": "deployments.policy.example.com", "failurePolicy": "Ignore",
+				   "clientConfig": {"url": "https://policy.example.com/validate"},
+				   "rules": [{"operations": ["CREATE"], "apiGroups": ["apps"], "apiVersions": ["v1"], "resources": ["deployments"]}]}
+				 ]}
+			]}`))
+		case "/apis/admissionregistration.k8s.io/v1/mutatingwebhookconfigurations":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "admissionregistration.k8s.io/v1", "kind": "MutatingWebhookConfigurationList", "items": [
+				{"apiVersion": "admissionregistration.k8s.io/v1", "kind": "MutatingWebhookConfiguration",
+				 "metadata": {"name": "sidecar-injector"},
+				 "webhooks": [
+				  {"name": "exec.sidecar.example.com", "failurePolicy": "Ignore",
+				   "clientConfig": {"service": {"namespace": "mesh", "name": "injector"}},
+				   "rules": [{"operations": ["CONNECT"], "apiGroups": [""], "apiVersions": ["v1"], "resources": ["pods/*"]}]}
+				 ]}
+			]}`))
+		}
+	}))
+}
+
+func (s *WebhooksSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *WebhooksSuite) TestWebhooksList() {
+	s.InitMcpClient()
+	s.Run("webhooks_list()", func() {
+		toolResult, err := s.CallTool("webhooks_list", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("returns validating and mutating webhooks", func() {
+			s.Require().Len(decoded, 3)
+			s.Equal("deployments.policy.example.com", decoded[0]["Name"])
+			s.Equal("https://policy.example.com/validate", decoded[0]["Target"])
+			s.Equal("pods.policy.example.com", decoded[1]["Name"])
+			s.Equal("exec.sidecar.example.com", decoded[2]["Name"])
+			s.Equal("Mutating", decoded[2]["Type"])
+		})
+	})
+	s.Run("webhooks_list(resource=pods)", func() {
+		toolResult, err := s.CallTool("webhooks_list", map[string]interface{}{
+			"resource": "pods",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		var decoded []map[string]interface{}
+		s.Nilf(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "unmarshal failed")
+		s.Run("returns only the webhook targeting pods with its Fail policy", func() {
+			s.Equal([]map[string]interface{}{{
+				"Configuration":  "policy-engine",
+				"Type":           "Validating",
+				"Name":           "pods.policy.example.com",
+				"FailurePolicy":  "Fail",
+				"Rules":          []interface{}{"CREATE,UPDATE pods (groups: core, versions: v1)"},
+				"Target":         "service policy/policy-webhook:443/validate",
+				"TimeoutSeconds": float64(10),
+			}}, decoded)
+		})
+	})
+	s.Run("webhooks_list(resource=pods/exec)", func() {
+		toolResult, err := s.CallTool("webhooks_list", map[string]interface{}{
+			"resource": "pods/exec",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		var decoded []map[string]interface{}
+		s.Nilf(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "unmarshal failed")
+		s.Run("matches subresource wildcards", func() {
+			s.Require().Len(decoded, 1)
+			s.Equal("exec.sidecar.example.com", decoded[0]["Name"])
+		})
+	})
+	s.Run("webhooks_list(resource=services)", func() {
+		toolResult, err := s.CallTool("webhooks_list", map[string]interface{}{
+			"resource": "services",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Equal("# No admission webhooks found", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func TestWebhooks(t *testing.T) {
+	suite.Run(t, new(WebhooksSuite))
+}
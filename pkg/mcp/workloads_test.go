@@ -0,0 +1,275 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type WorkloadsSuite struct {
+	BaseMcpSuite
+	mockServer    *test.MockServer
+	logsQueries   map[string]string
+	podsSelectors []string
+}
+
+func (s *WorkloadsSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.logsQueries = make(map[string]string)
+	s.podsSelectors = nil
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "apps/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "deployments", Kind: "Deployment", Namespaced: true, Verbs: []string{"get", "list"}},
+			{Name: "statefulsets", Kind: "StatefulSet", Namespaced: true, Verbs: []string{"get", "list"}},
+		},
+	}, metav1.APIResourceList{
+		GroupVersion: "policy/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "poddisruptionbudgets", Kind: "PodDisruptionBudget", Namespaced: true, Verbs: []string{"get", "list"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/apis/apps/v1/namespaces/ns-1/deployments/web":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "apps/v1", "kind": "Deployment",
+				"metadata": {"name": "web", "namespace": "ns-1"},
+				"spec": {"replicas": 2, "selector": {"matchLabels": {"app": "web"}},
+				 "template": {"metadata": {"labels": {"app": "web", "tier": "frontend"}}, "spec": {"containers": [{"name": "web", "image": "nginx"}]}}}}`))
+		case "/apis/apps/v1/namespaces/ns-1/statefulsets/db":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "apps/v1", "kind": "StatefulSet",
+				"metadata": {"name": "db", "namespace": "ns-1"},
+				"spec": {"replicas": 1, "selector": {"matchLabels": {"app": "db"}},
+				 "template": {"metadata": {"labels": {"app": "db"}}, "spec": {"containers": [{"name": "db", "image": "postgres"}]}}}}`))
+		case "/apis/apps/v1/namespaces/audit/deployments":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "apps/v1", "kind": "DeploymentList", "items": [
+				{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "api", "namespace": "audit"},
+				 "spec": {"template": {"spec": {"containers": [
+				  {"name": "app", "image": "api", "resources": {"requests": {"cpu": "100m"}, "limits": {"memory": "1Gi"}}},
+				  {"name": "proxy", "image": "proxy", "resources": {"requests": {"cpu": "50m", "memory": "64Mi"}, "limits": {"memory": "128Mi"}}}
+				 ]}}}}
+			]}`))
+		case "/apis/apps/v1/namespaces/audit/statefulsets":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "apps/v1", "kind": "StatefulSetList", "items": [
+				{"apiVersion": "apps/v1", "kind": "StatefulSet", "metadata": {"name": "cache", "namespace": "audit"},
+				 "spec": {"template": {"spec": {"containers": [
+				  {"name": "redis", "image": "redis", "resources": {"requests": {"cpu": "2", "memory": "1Gi"}, "limits": {"cpu": "1", "memory": "1Gi"}}},
+				  {"name": "exporter", "image": "exporter"}
+				 ]}}}}
+			]}`))
+		case "/apis/policy/v1/namespaces/ns-1/poddisruptionbudgets":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "policy/v1", "kind": "PodDisruptionBudgetList", "items": [
+				{"apiVersion": "policy/v1", "kind": "PodDisruptionBudget", "metadata": {"name": "web-pdb", "namespace": "ns-1"},
+				 "spec": {"minAvailable": 2, "selector": {"matchLabels": {"app": "web"}}},
+				 "status": {"currentHealthy": 2, "desiredHealthy": 2, "expectedPods": 2, "disruptionsAllowed": 0}},
+				{"apiVersion": "policy/v1", "kind": "PodDisruptionBudget", "metadata": {"name": "api-pdb", "namespace": "ns-1"},
+				 "spec": {"maxUnavailable": "50%", "selector": {"matchLabels": {"app": "api"}}},
+				 "status": {"currentHealthy": 4, "desiredHealthy": 2, "expectedPods": 4, "disruptionsAllowed": 2}}
+			]}`))
+		case "/api/v1/namespaces/ns-1/pods":
+			s.podsSelectors = append(s.podsSelectors, req.URL.Query().Get("labelSelector"))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "PodList", "items": [
+				{"metadata": {"name": "web-b", "namespace": "ns-1", "labels": {"app": "web"}}},
+				{"metadata": {"name": "web-a", "namespace": "ns-1", "labels": {"app": "web"}}}
+			]}`))
+		case "/api/v1/namespaces/ns-1/pods/web-a/log":
+			s.logsQueries[req.URL.Path] = req.URL.RawQuery
+			_, _ = w.Write([]byte("GET / 200\nGET /healthz 200\n"))
+		case "/api/v1/namespaces/ns-1/pods/web-b/log":
+			s.logsQueries[req.URL.Path] = req.URL.RawQuery
+			_, _ = w.Write([]byte("GET /missing 404\n"))
+		}
+	}))
+}
+
+func (s *WorkloadsSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *WorkloadsSuite) TestWorkloadsLogs() {
+	s.InitMcpClient()
+	s.Run("workloads_logs(kind=Deployment, namespace=ns-1, name=web, since=5m, tail_lines=10)", func() {
+		toolResult, err := s.CallTool("workloads_logs", map[string]interface{}{
+			"kind":       "Deployment",
+			"namespace":  "ns-1",
+			"name":       "web",
+			"container":  "web",
+			"since":      "5m",
+			"tail_lines": 10,
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		s.Run("resolves the pods with the workload selector", func() {
+			s.Equal([]string{"app=web"}, s.podsSelectors)
+		})
+		s.Run("returns the logs of both pods prefixed with the pod name", func() {
+			s.Equal("[web-a] GET / 200\n"+
+				"[web-a] GET /healthz 200\n"+
+				"[web-b] GET /missing 404\n",
+				toolResult.Content[0].(mcp.TextContent).Text)
+		})
+		s.Run("forwards container, since and tail_lines", func() {
+			for _, pod := range []string{"web-a", "web-b"} {
+				s.Equal("container=web&sinceSeconds=300&tailLines=10", s.logsQueries["/api/v1/namespaces/ns-1/pods/"+pod+"/log"])
+			}
+		})
+	})
+	s.Run("workloads_logs(kind=ReplicaSet)", func() {
+		toolResult, _ := s.CallTool("workloads_logs", map[string]interface{}{
+			"kind": "ReplicaSet",
+			"name": "web",
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to get ReplicaSet web logs: unsupported workload kind ReplicaSet, supported kinds are: Deployment, StatefulSet, DaemonSet, Job", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("workloads_logs(since=yesterday)", func() {
+		toolResult, _ := s.CallTool("workloads_logs", map[string]interface{}{
+			"kind":  "Deployment",
+			"name":  "web",
+			"since": "yesterday",
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to get workload logs, invalid since yesterday, expected a duration such as 30s, 5m or 1h", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func (s *WorkloadsSuite) TestPdbForWorkload() {
+	s.InitMcpClient()
+	s.Run("pdb_for_workload with unsupported kind returns error", func() {
+		toolResult, _ := s.CallTool("pdb_for_workload", map[string]interface{}{"kind": "Job", "namespace": "ns-1", "name": "web"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to get Job web pod disruption budgets: unsupported workload kind Job, supported kinds are: Deployment, StatefulSet",
+			toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("pdb_for_workload(kind=Deployment, namespace=ns-1, name=web)", func() {
+		toolResult, err := s.CallTool("pdb_for_workload", map[string]interface{}{
+			"kind":      "Deployment",
+			"namespace": "ns-1",
+			"name":      "web",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("returns the matching pod disruption budget only", func() {
+			s.Equal([]interface{}{map[string]interface{}{
+				"Name":               "web-pdb",
+				"MinAvailable":       "2",
+				"CurrentHealthy":     float64(2),
+				"DesiredHealthy":     float64(2),
+				"ExpectedPods":       float64(2),
+				"DisruptionsAllowed": float64(0),
+			}}, decoded["PodDisruptionBudgets"])
+		})
+		s.Run("reports the workload as blocking", func() {
+			s.Equal(true, decoded["Blocking"])
+			s.Equal("PodDisruptionBudget web-pdb allows no disruptions, evictions of the Pods of Deployment web (e.g. node drains) will be blocked until more Pods are healthy or the budget is relaxed",
+				decoded["Explanation"])
+		})
+	})
+	s.Run("pdb_for_workload(kind=StatefulSet, namespace=ns-1, name=db) without pod disruption budget", func() {
+		toolResult, err := s.CallTool("pdb_for_workload", map[string]interface{}{
+			"kind":      "StatefulSet",
+			"namespace": "ns-1",
+			"name":      "db",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		var decoded map[string]interface{}
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded))
+		s.Equal(false, decoded["Blocking"])
+		s.Empty(decoded["PodDisruptionBudgets"])
+		s.Contains(decoded["Explanation"], "No PodDisruptionBudget covers the Pods of StatefulSet db")
+	})
+}
+
+func (s *WorkloadsSuite) TestWorkloadsResourceAudit() {
+	s.InitMcpClient()
+	s.Run("workloads_resource_audit(namespace=audit)", func() {
+		toolResult, err := s.CallTool("workloads_resource_audit", map[string]interface{}{"namespace": "audit"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Require().Lenf(decoded, 3, "expected 3 flagged containers, got %v", decoded)
+		s.Run("flags the container missing a memory request", func() {
+			s.Equal(map[string]interface{}{
+				"Kind":      "Deployment",
+				"Name":      "api",
+				"Container": "app",
+				"Requests":  "cpu=100m",
+				"Limits":    "memory=1Gi",
+				"Issues": []interface{}{
+					"memory limit without request, the request defaults to the limit which may reserve more memory than needed",
+				},
+			}, decoded[0])
+		})
+		s.Run("flags the container with requests exceeding limits", func() {
+			s.Equal("cache", decoded[1]["Name"])
+			s.Equal("redis", decoded[1]["Container"])
+			s.Equal([]interface{}{"cpu request 2 exceeds limit 1"}, decoded[1]["Issues"])
+		})
+		s.Run("flags the container without requests nor limits", func() {
+			s.Equal("exporter", decoded[2]["Container"])
+			s.Equal("none", decoded[2]["Requests"])
+			s.Len(decoded[2]["Issues"], 1)
+			s.Contains(decoded[2]["Issues"].([]interface{})[0], "no resource requests nor limits (BestEffort)")
+		})
+	})
+}
+
+func (s *WorkloadsSuite) TestWorkloadsResourceAuditPartialResults() {
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/apis/apps/v1/namespaces/restricted/deployments":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Status", "status": "Failure", "reason": "Forbidden", "code": 403}`))
+		case "/apis/apps/v1/namespaces/restricted/statefulsets":
+			_, _ = w.Write([]byte(`{"apiVersion": "apps/v1", "kind": "StatefulSetList", "items": []}`))
+		}
+	}))
+	s.InitMcpClient()
+	s.Run("workloads_resource_audit(namespace=restricted) without permission to list deployments", func() {
+		toolResult, err := s.CallTool("workloads_resource_audit", map[string]interface{}{"namespace": "restricted"})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Equal("# No workload resource misconfigurations found\n"+
+			"# Warnings, the following namespaces were skipped (partial results):\n"+
+			"# - restricted: cannot list Deployments (forbidden)",
+			toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func TestWorkloads(t *testing.T) {
+	suite.Run(t, new(WorkloadsSuite))
+}
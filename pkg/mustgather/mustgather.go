@@ -0,0 +1,395 @@
+package mustgather
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	namespacesDir       = "namespaces"
+	clusterScopedDir    = "cluster-scoped-resources"
+	clusterOperatorsDir = "config.openshift.io/clusteroperators"
+	// maxLogLineLength bounds the length of the matching log lines returned (the rest of the line is truncated)
+	maxLogLineLength = 512
+)
+
+// Bundle is an unpacked must-gather directory.
+// A must-gather directory contains one sub-directory per gather image (e.g. must-gather.local.123/quay-io-...), each
+// with the collected namespaces and cluster-scoped-resources. The data of all the images is analyzed.
+// Only the files within the allowed directory (once their symbolic links are resolved) are read.
+type Bundle struct {
+	allowedDir string
+	roots      []string
+}
+
+// Open returns the Bundle for the provided unpacked must-gather directory, either the top-level directory or the
+// directory of a single gather image.
+// The path is either absolute or relative to allowedDir, and must be within allowedDir once its symbolic links are
+// resolved.
+func Open(allowedDir, path string) (*Bundle, error) {
+	if allowedDir == "" {
+		return nil, errors.New("no must-gather directory configured")
+	}
+	if path == "" {
+		return nil, errors.New("empty must-gather path")
+	}
+	allowedDir, err := filepath.Abs(allowedDir)
+	if err != nil {
+		return nil, err
+	}
+	if allowedDir, err = filepath.EvalSymlinks(allowedDir); err != nil {
+		return nil, err
+	}
+	b := &Bundle{allowedDir: allowedDir}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(allowedDir, path)
+	}
+	if path, err = b.resolve(path); err != nil {
+		return nil, err
+	}
+	if info, err := os.Stat(path); err != nil {
+		return nil, err
+	} else if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory, must-gather archives must be unpacked first", path)
+	}
+	if b.isRoot(path) {
+		b.roots = []string{path}
+		return b, nil
+	}
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() && b.isRoot(filepath.Join(path, entry.Name())) {
+			b.roots = append(b.roots, filepath.Join(path, entry.Name()))
+		}
+	}
+	if len(b.roots) == 0 {
+		return nil, fmt.Errorf("%s is not a must-gather directory, no %s or %s directories found", path, namespacesDir, clusterScopedDir)
+	}
+	return b, nil
+}
+
+// Namespaces returns the namespaces collected in the bundle with their phase and number of collected Pods
+func (b *Bundle) Namespaces() []map[string]any {
+	namespaces := map[string]map[string]any{}
+	for _, root := range b.roots {
+		entries, _ := b.readDir(filepath.Join(root, namespacesDir))
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				continue
+			}
+			namespace, ok := namespaces[entry.Name()]
+			if !ok {
+				namespace = map[string]any{"Name": entry.Name(), "Pods": 0}
+				namespaces[entry.Name()] = namespace
+			}
+			dir := filepath.Join(root, namespacesDir, entry.Name())
+			if obj, err := b.readObject(filepath.Join(dir, entry.Name()+".yaml")); err == nil {
+				if phase, found, _ := unstructured.NestedString(obj.Object, "status", "phase"); found {
+					namespace["Phase"] = phase
+				}
+			}
+			pods, _ := b.readDir(filepath.Join(dir, "pods"))
+			namespace["Pods"] = max(namespace["Pods"].(int), len(pods))
+		}
+	}
+	ret := make([]map[string]any, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		ret = append(ret, namespace)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i]["Name"].(string) < ret[j]["Name"].(string) })
+	return ret
+}
+
+// ClusterOperators returns the version and the Available, Progressing, and Degraded conditions of the collected
+// ClusterOperators, with the messages of the conditions reporting a problem.
+// The ClusterOperators reporting a problem are returned first.
+func (b *Bundle) ClusterOperators() ([]map[string]any, error) {
+	operators := map[string]map[string]any{}
+	for _, root := range b.roots {
+		dir := filepath.Join(root, clusterScopedDir, clusterOperatorsDir)
+		files, _ := filepath.Glob(filepath.Join(dir, "*.yaml"))
+		// Older must-gathers collect the ClusterOperators as a single list
+		files = append(files, dir+".yaml")
+		for _, file := range files {
+			objs, err := b.readObjects(file)
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+			for _, obj := range objs {
+				if obj.GetKind() == "ClusterOperator" {
+					operators[obj.GetName()] = clusterOperatorSummary(&obj)
+				}
+			}
+		}
+	}
+	if len(operators) == 0 {
+		return nil, errors.New("no ClusterOperators collected in the must-gather")
+	}
+	ret := make([]map[string]any, 0, len(operators))
+	for _, operator := range operators {
+		ret = append(ret, operator)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		_, iProblem := ret[i]["Messages"]
+		_, jProblem := ret[j]["Messages"]
+		if iProblem != jProblem {
+			return iProblem
+		}
+		return ret[i]["Name"].(string) < ret[j]["Name"].(string)
+	})
+	return ret, nil
+}
+
+// Events returns the events collected in the provided namespace (or all namespaces if empty), oldest first.
+// If warningsOnly is true, only the Warning events are returned.
+func (b *Bundle) Events(namespace string, warningsOnly bool) ([]map[string]any, error) {
+	var events []v1.Event
+	for _, root := range b.roots {
+		files, _ := filepath.Glob(filepath.Join(root, namespacesDir, "*", "core", "events.yaml"))
+		for _, file := range files {
+			if namespace != "" && filepath.Base(filepath.Dir(filepath.Dir(file))) != namespace {
+				continue
+			}
+			data, err := b.readFile(file)
+			if err != nil {
+				return nil, err
+			}
+			eventList := &v1.EventList{}
+			if err = yaml.Unmarshal(data, eventList); err != nil {
+				return nil, fmt.Errorf("failed to read %s: %v", file, err)
+			}
+			for _, event := range eventList.Items {
+				if !warningsOnly || event.Type == v1.EventTypeWarning {
+					events = append(events, event)
+				}
+			}
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return eventTimestamp(&events[i]).Before(eventTimestamp(&events[j]))
+	})
+	ret := make([]map[string]any, 0, len(events))
+	for _, event := range events {
+		count := event.Count
+		if count == 0 {
+			count = 1
+		}
+		ret = append(ret, map[string]any{
+			"Namespace": event.Namespace,
+			"Type":      event.Type,
+			"Reason":    event.Reason,
+			"Object":    event.InvolvedObject.Kind + "/" + event.InvolvedObject.Name,
+			"Count":     count,
+			"Timestamp": eventTimestamp(&event).UTC().Format(time.RFC3339),
+			"Message":   strings.TrimSpace(event.Message),
+		})
+	}
+	return ret, nil
+}
+
+// GrepLogs returns the lines of the collected container logs matching the provided pattern, prefixed with the
+// namespace, pod, container (and previous for the logs of the previous container instance) and line number.
+// The logs can be restricted to a namespace and to the pods with a name starting with the provided prefix.
+// No more than maxMatches lines are returned (truncated is true if there were more).
+func (b *Bundle) GrepLogs(pattern *regexp.Regexp, namespace, podPrefix string, maxMatches int) (matches []string, truncated bool, err error) {
+	for _, root := range b.roots {
+		podsDirs, _ := filepath.Glob(filepath.Join(root, namespacesDir, "*", "pods"))
+		for _, podsDir := range podsDirs {
+			podsNamespace := filepath.Base(filepath.Dir(podsDir))
+			if namespace != "" && podsNamespace != namespace {
+				continue
+			}
+			err = filepath.WalkDir(podsDir, func(path string, entry os.DirEntry, err error) error {
+				if err != nil || entry.IsDir() || !strings.HasSuffix(path, ".log") {
+					return err
+				}
+				// namespaces/<namespace>/pods/<pod>/<container>/<container>/logs/current.log
+				parts := strings.Split(filepath.ToSlash(strings.TrimPrefix(path, podsDir+string(filepath.Separator))), "/")
+				if len(parts) < 3 || !strings.HasPrefix(parts[0], podPrefix) {
+					return nil
+				}
+				source := podsNamespace + "/" + parts[0] + "/" + parts[1]
+				if filepath.Base(path) == "previous.log" {
+					source += " (previous)"
+				}
+				fileMatches, err := b.grepFile(path, pattern, source, maxMatches-len(matches)+1)
+				matches = append(matches, fileMatches...)
+				if len(matches) > maxMatches {
+					return filepath.SkipAll
+				}
+				return err
+			})
+			if err != nil {
+				return nil, false, err
+			}
+			if len(matches) > maxMatches {
+				return matches[:maxMatches], true, nil
+			}
+		}
+	}
+	return matches, false, nil
+}
+
+// resolve returns the provided path with its symbolic links resolved, or an error if it's not within the allowed
+// directory
+func (b *Bundle) resolve(path string) (string, error) {
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(b.allowedDir, resolved)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%s is outside of the must-gather directory %s", path, b.allowedDir)
+	}
+	return resolved, nil
+}
+
+// readFile returns the content of the provided file, which must be within the allowed directory
+func (b *Bundle) readFile(path string) ([]byte, error) {
+	resolved, err := b.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadFile(resolved)
+}
+
+// readDir returns the entries of the provided directory, which must be within the allowed directory
+func (b *Bundle) readDir(path string) ([]os.DirEntry, error) {
+	resolved, err := b.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadDir(resolved)
+}
+
+// isRoot returns true if the provided directory contains the data collected by a gather image
+func (b *Bundle) isRoot(dir string) bool {
+	for _, name := range []string{namespacesDir, clusterScopedDir} {
+		resolved, err := b.resolve(filepath.Join(dir, name))
+		if err != nil {
+			continue
+		}
+		if info, err := os.Stat(resolved); err == nil && info.IsDir() {
+			return true
+		}
+	}
+	return false
+}
+
+// grepFile returns up to limit lines of the provided file matching the pattern, the file must be within the allowed
+// directory
+func (b *Bundle) grepFile(path string, pattern *regexp.Regexp, source string, limit int) ([]string, error) {
+	resolved, err := b.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(resolved)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	var ret []string
+	reader := bufio.NewReader(f)
+	for line := 1; len(ret) < limit; line++ {
+		text, err := reader.ReadString('\n')
+		if text != "" && pattern.MatchString(text) {
+			text = strings.TrimRight(text, "\r\n")
+			if len(text) > maxLogLineLength {
+				text = text[:maxLogLineLength] + "..."
+			}
+			ret = append(ret, fmt.Sprintf("%s:%d: %s", source, line, text))
+		}
+		if err != nil {
+			break
+		}
+	}
+	return ret, nil
+}
+
+// clusterOperatorSummary returns the name, operator version and conditions of the provided ClusterOperator
+func clusterOperatorSummary(obj *unstructured.Unstructured) map[string]any {
+	summary := map[string]any{"Name": obj.GetName()}
+	versions, _, _ := unstructured.NestedSlice(obj.Object, "status", "versions")
+	for _, version := range versions {
+		if v, ok := version.(map[string]interface{}); ok && v["name"] == "operator" {
+			summary["Version"] = v["version"]
+		}
+	}
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	messages := map[string]any{}
+	for _, conditionType := range []string{"Available", "Progressing", "Degraded"} {
+		summary[conditionType] = "Unknown"
+		for _, condition := range conditions {
+			c, ok := condition.(map[string]interface{})
+			if !ok || c["type"] != conditionType {
+				continue
+			}
+			status := fmt.Sprint(c["status"])
+			summary[conditionType] = status
+			problem := (conditionType == "Available") != (status == "True")
+			if problem && (c["reason"] != nil || c["message"] != nil) {
+				messages[conditionType] = strings.TrimSpace(fmt.Sprintf("%v: %v", c["reason"], c["message"]))
+			}
+		}
+	}
+	if len(messages) > 0 {
+		summary["Messages"] = messages
+	}
+	return summary
+}
+
+// readObject returns the object in the provided YAML file
+func (b *Bundle) readObject(file string) (*unstructured.Unstructured, error) {
+	data, err := b.readFile(file)
+	if err != nil {
+		return nil, err
+	}
+	obj := &unstructured.Unstructured{}
+	if err = yaml.Unmarshal(data, &obj.Object); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", file, err)
+	}
+	return obj, nil
+}
+
+// readObjects returns the objects in the provided YAML file, the object itself or the items if it's a List
+func (b *Bundle) readObjects(file string) ([]unstructured.Unstructured, error) {
+	obj, err := b.readObject(file)
+	if err != nil {
+		return nil, err
+	}
+	if !obj.IsList() {
+		return []unstructured.Unstructured{*obj}, nil
+	}
+	list, err := obj.ToList()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", file, err)
+	}
+	return list.Items, nil
+}
+
+// eventTimestamp returns the most relevant timestamp of the provided event
+func eventTimestamp(event *v1.Event) time.Time {
+	for _, t := range []metav1.Time{event.LastTimestamp, event.FirstTimestamp, metav1.Time(event.EventTime), event.CreationTimestamp} {
+		if !t.IsZero() {
+			return t.Time
+		}
+	}
+	return time.Time{}
+}
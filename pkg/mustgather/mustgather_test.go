@@ -0,0 +1,256 @@
+package mustgather
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+const testImage = "quay-io-openshift-release-dev-ocp-v4-0-art-dev-sha256-abcdef"
+
+// newTestMustGather creates a must-gather directory (must-gather.local.1234) in a new allowed directory and returns
+// both of them
+func newTestMustGather(t *testing.T) (allowedDir, path string) {
+	allowedDir = t.TempDir()
+	path = filepath.Join(allowedDir, "must-gather.local.1234")
+	writeFiles(t, filepath.Join(path, testImage), map[string]string{
+		"namespaces/openshift-etcd/openshift-etcd.yaml": `{"apiVersion": "v1", "kind": "Namespace", "metadata": {"name": "openshift-etcd"}, "status": {"phase": "Active"}}`,
+		"namespaces/openshift-etcd/core/events.yaml": `{"apiVersion": "v1", "kind": "EventList", "items": [
+			{"metadata": {"name": "etcd.2", "namespace": "openshift-etcd"}, "involvedObject": {"kind": "Pod", "name": "etcd-master-0"},
+			 "type": "Warning", "reason": "Unhealthy", "count": 3, "lastTimestamp": "2025-01-02T02:00:00Z", "message": "Readiness probe failed"},
+			{"metadata": {"name": "etcd.1", "namespace": "openshift-etcd"}, "involvedObject": {"kind": "Pod", "name": "etcd-master-0"},
+			 "type": "Normal", "reason": "Started", "lastTimestamp": "2025-01-02T01:00:00Z", "message": "Started container etcd"}
+		]}`,
+		"namespaces/openshift-etcd/pods/etcd-master-0/etcd/etcd/logs/current.log":                      "starting etcd\nERROR slow fdatasync\n",
+		"namespaces/openshift-monitoring/pods/prometheus-k8s-0/prometheus/prometheus/logs/current.log": "ERROR WAL corrupted\n",
+	})
+	return allowedDir, path
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		file := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write file: %v", err)
+		}
+	}
+}
+
+// writeOutsideFile writes a file with a secret outside of any allowed directory and returns its path
+func writeOutsideFile(t *testing.T, name, content string) string {
+	file := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	return file
+}
+
+func TestOpen(t *testing.T) {
+	allowedDir, path := newTestMustGather(t)
+	t.Run("opens the top-level must-gather directory", func(t *testing.T) {
+		b, err := Open(allowedDir, path)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(b.roots) != 1 || filepath.Base(b.roots[0]) != testImage {
+			t.Errorf("Unexpected roots: %v", b.roots)
+		}
+	})
+	t.Run("opens the directory of a single gather image", func(t *testing.T) {
+		b, err := Open(allowedDir, filepath.Join(path, testImage))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(b.roots) != 1 || filepath.Base(b.roots[0]) != testImage {
+			t.Errorf("Unexpected roots: %v", b.roots)
+		}
+	})
+	t.Run("opens a path relative to the allowed directory", func(t *testing.T) {
+		if _, err := Open(allowedDir, "must-gather.local.1234"); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+	t.Run("fails without allowed directory", func(t *testing.T) {
+		_, err := Open("", path)
+		if err == nil || err.Error() != "no must-gather directory configured" {
+			t.Errorf("Expected no must-gather directory configured error, got %v", err)
+		}
+	})
+	t.Run("fails with a non must-gather directory", func(t *testing.T) {
+		dir := filepath.Join(allowedDir, "empty")
+		if err := os.Mkdir(dir, 0755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+		_, err := Open(allowedDir, dir)
+		if err == nil || !strings.Contains(err.Error(), "is not a must-gather directory") {
+			t.Errorf("Expected not a must-gather directory error, got %v", err)
+		}
+	})
+	t.Run("fails with a directory outside of the allowed directory", func(t *testing.T) {
+		_, outside := newTestMustGather(t)
+		_, err := Open(allowedDir, outside)
+		if err == nil || !strings.Contains(err.Error(), "is outside of the must-gather directory") {
+			t.Errorf("Expected outside of the must-gather directory error, got %v", err)
+		}
+	})
+	t.Run("fails with a relative path escaping the allowed directory", func(t *testing.T) {
+		_, outside := newTestMustGather(t)
+		rel, err := filepath.Rel(allowedDir, outside)
+		if err != nil || !strings.HasPrefix(rel, "..") {
+			t.Fatalf("Unexpected relative path %s: %v", rel, err)
+		}
+		_, err = Open(allowedDir, rel)
+		if err == nil || !strings.Contains(err.Error(), "is outside of the must-gather directory") {
+			t.Errorf("Expected outside of the must-gather directory error, got %v", err)
+		}
+	})
+	t.Run("fails with a symbolic link escaping the allowed directory", func(t *testing.T) {
+		_, outside := newTestMustGather(t)
+		link := filepath.Join(allowedDir, "must-gather.link")
+		if err := os.Symlink(outside, link); err != nil {
+			t.Fatalf("Failed to create symbolic link: %v", err)
+		}
+		_, err := Open(allowedDir, link)
+		if err == nil || !strings.Contains(err.Error(), "is outside of the must-gather directory") {
+			t.Errorf("Expected outside of the must-gather directory error, got %v", err)
+		}
+	})
+}
+
+func TestNamespaces(t *testing.T) {
+	allowedDir, path := newTestMustGather(t)
+	b, err := Open(allowedDir, path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []map[string]any{
+		{"Name": "openshift-etcd", "Phase": "Active", "Pods": 1},
+		{"Name": "openshift-monitoring", "Pods": 1},
+	}
+	if namespaces := b.Namespaces(); !reflect.DeepEqual(namespaces, expected) {
+		t.Errorf("Unexpected namespaces: %v", namespaces)
+	}
+}
+
+func TestEvents(t *testing.T) {
+	allowedDir, path := newTestMustGather(t)
+	b, err := Open(allowedDir, path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	t.Run("returns the events oldest first", func(t *testing.T) {
+		events, err := b.Events("", false)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(events) != 2 || events[0]["Reason"] != "Started" || events[1]["Reason"] != "Unhealthy" {
+			t.Errorf("Unexpected events: %v", events)
+		}
+		if events[0]["Count"] != int32(1) || events[1]["Count"] != int32(3) {
+			t.Errorf("Unexpected event counts: %v", events)
+		}
+	})
+	t.Run("returns the warning events of the namespace", func(t *testing.T) {
+		events, err := b.Events("openshift-etcd", true)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(events) != 1 || events[0]["Reason"] != "Unhealthy" {
+			t.Errorf("Unexpected events: %v", events)
+		}
+	})
+	t.Run("returns no events for a namespace without events", func(t *testing.T) {
+		events, err := b.Events("openshift-monitoring", false)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(events) != 0 {
+			t.Errorf("Unexpected events: %v", events)
+		}
+	})
+	t.Run("fails with a symbolic link escaping the allowed directory", func(t *testing.T) {
+		secret := writeOutsideFile(t, "events.yaml", `{"apiVersion": "v1", "kind": "EventList", "items": [{"reason": "Secret"}]}`)
+		dir := filepath.Join(path, testImage, "namespaces", "openshift-monitoring", "core")
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+		if err := os.Symlink(secret, filepath.Join(dir, "events.yaml")); err != nil {
+			t.Fatalf("Failed to create symbolic link: %v", err)
+		}
+		events, err := b.Events("openshift-monitoring", false)
+		if err == nil || !strings.Contains(err.Error(), "is outside of the must-gather directory") {
+			t.Errorf("Expected outside of the must-gather directory error, got %v (%v)", err, events)
+		}
+	})
+}
+
+func TestGrepLogs(t *testing.T) {
+	allowedDir, path := newTestMustGather(t)
+	b, err := Open(allowedDir, path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	pattern := regexp.MustCompile("ERROR")
+	t.Run("returns the matching lines of all the namespaces", func(t *testing.T) {
+		matches, truncated, err := b.GrepLogs(pattern, "", "", 10)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := []string{
+			"openshift-etcd/etcd-master-0/etcd:2: ERROR slow fdatasync",
+			"openshift-monitoring/prometheus-k8s-0/prometheus:1: ERROR WAL corrupted",
+		}
+		if truncated || !reflect.DeepEqual(matches, expected) {
+			t.Errorf("Unexpected matches (truncated %v): %v", truncated, matches)
+		}
+	})
+	t.Run("returns the matching lines of the namespace", func(t *testing.T) {
+		matches, _, err := b.GrepLogs(pattern, "openshift-monitoring", "", 10)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(matches) != 1 || !strings.HasPrefix(matches[0], "openshift-monitoring/") {
+			t.Errorf("Unexpected matches: %v", matches)
+		}
+	})
+	t.Run("returns the matching lines of the pods with the prefix", func(t *testing.T) {
+		matches, _, err := b.GrepLogs(pattern, "", "etcd-", 10)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(matches) != 1 || !strings.HasPrefix(matches[0], "openshift-etcd/etcd-master-0/") {
+			t.Errorf("Unexpected matches: %v", matches)
+		}
+	})
+	t.Run("truncates to max matches", func(t *testing.T) {
+		matches, truncated, err := b.GrepLogs(pattern, "", "", 1)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !truncated || len(matches) != 1 {
+			t.Errorf("Unexpected matches (truncated %v): %v", truncated, matches)
+		}
+	})
+	t.Run("fails with a symbolic link escaping the allowed directory", func(t *testing.T) {
+		secret := writeOutsideFile(t, "secret.log", "ERROR secret\n")
+		dir := filepath.Join(path, testImage, "namespaces", "openshift-etcd", "pods", "etcd-master-0", "etcd", "etcd", "logs")
+		if err := os.Symlink(secret, filepath.Join(dir, "previous.log")); err != nil {
+			t.Fatalf("Failed to create symbolic link: %v", err)
+		}
+		matches, _, err := b.GrepLogs(pattern, "openshift-etcd", "", 10)
+		if err == nil || !strings.Contains(err.Error(), "is outside of the must-gather directory") {
+			t.Errorf("Expected outside of the must-gather directory error, got %v", err)
+		}
+		for _, match := range matches {
+			if strings.Contains(match, "secret") {
+				t.Errorf("Unexpected match outside of the must-gather directory: %s", match)
+			}
+		}
+	})
+}
@@ -0,0 +1,150 @@
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// Column is a custom column of the tables printed by PrintColumns
+type Column struct {
+	Header string
+	// FieldSpec is the JSONPath expression of the column value (e.g. {.metadata.name})
+	FieldSpec string
+	parser    *jsonpath.JSONPath
+}
+
+// ParseColumns parses the provided comma-separated column specification (like `kubectl -o custom-columns`).
+// Each column is either a JSONPath expression (e.g. .metadata.name) or a header and expression pair (e.g. NAME:.metadata.name).
+// Returns an error if any of the expressions can't be compiled.
+func ParseColumns(spec string) ([]Column, error) {
+	var columns []Column
+	for _, part := range splitColumns(spec) {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		header, fieldSpec, hasHeader := strings.Cut(part, ":")
+		if !hasHeader || strings.HasPrefix(part, "{") || strings.HasPrefix(part, ".") {
+			header, fieldSpec = "", part
+		}
+		fieldSpec = relaxedJSONPath(strings.TrimSpace(fieldSpec))
+		if header == "" {
+			header = columnHeader(fieldSpec)
+		}
+		parser := jsonpath.New(header).AllowMissingKeys(true)
+		if err := parser.Parse(fieldSpec); err != nil {
+			return nil, fmt.Errorf("invalid column %s: %v", part, err)
+		}
+		columns = append(columns, Column{Header: strings.TrimSpace(header), FieldSpec: fieldSpec, parser: parser})
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("no columns provided")
+	}
+	return columns, nil
+}
+
+// PrintColumns prints the items of the provided list (or the provided object) as a table with the provided columns.
+// Missing values are printed as <none>, multiple values are comma-separated.
+func PrintColumns(obj runtime.Unstructured, columns []Column) (string, error) {
+	headers, rows, err := columnRows(obj, columns)
+	if err != nil {
+		return "", err
+	}
+	buf := new(bytes.Buffer)
+	w := printers.GetNewTabWriter(buf)
+	_, _ = fmt.Fprintln(w, strings.Join(headers, "\t"))
+	for _, row := range rows {
+		_, _ = fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	if err := w.Flush(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// columnRows returns the headers and the values of the provided columns for each of the items of the provided list
+// (or the provided object)
+func columnRows(obj runtime.Unstructured, columns []Column) ([]string, [][]string, error) {
+	var items []map[string]any
+	if list, ok := obj.(*unstructured.UnstructuredList); ok {
+		for _, item := range list.Items {
+			items = append(items, item.Object)
+		}
+	} else {
+		items = append(items, obj.UnstructuredContent())
+	}
+	headers := make([]string, 0, len(columns))
+	for _, column := range columns {
+		headers = append(headers, column.Header)
+	}
+	rows := make([][]string, 0, len(items))
+	for _, item := range items {
+		values := make([]string, 0, len(columns))
+		for _, column := range columns {
+			results, err := column.parser.FindResults(item)
+			if err != nil {
+				return nil, nil, err
+			}
+			var value []string
+			for _, result := range results {
+				for _, r := range result {
+					value = append(value, fmt.Sprint(r.Interface()))
+				}
+			}
+			if len(value) == 0 {
+				value = []string{"<none>"}
+			}
+			values = append(values, strings.Join(value, ","))
+		}
+		rows = append(rows, values)
+	}
+	return headers, rows, nil
+}
+
+// splitColumns splits the provided column specification by the commas that are not part of a JSONPath expression
+func splitColumns(spec string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, c := range spec {
+		switch c {
+		case '{', '[', '(':
+			depth++
+		case '}', ']', ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, spec[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, spec[start:])
+}
+
+// relaxedJSONPath wraps the provided expression in braces and adds the leading dot if missing (.metadata.name -> {.metadata.name})
+func relaxedJSONPath(fieldSpec string) string {
+	fieldSpec = strings.TrimSuffix(strings.TrimPrefix(fieldSpec, "{"), "}")
+	if !strings.HasPrefix(fieldSpec, ".") && !strings.HasPrefix(fieldSpec, "[") {
+		fieldSpec = "." + fieldSpec
+	}
+	return "{" + fieldSpec + "}"
+}
+
+// columnHeader returns the default header of the provided expression, the upper-cased last field name ({.status.phase} -> PHASE)
+func columnHeader(fieldSpec string) string {
+	fields := strings.FieldsFunc(strings.Trim(fieldSpec, "{}"), func(r rune) bool {
+		return r == '.' || r == '[' || r == ']'
+	})
+	for i := len(fields) - 1; i >= 0; i-- {
+		if field := strings.Trim(fields[i], "*'\""); field != "" && !strings.ContainsAny(field, "?@=()") {
+			return strings.ToUpper(field)
+		}
+	}
+	return strings.ToUpper(strings.Trim(fieldSpec, "{}."))
+}
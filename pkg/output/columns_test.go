@@ -0,0 +1,66 @@
+package output
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestParseColumns(t *testing.T) {
+	t.Run("derives headers from expressions", func(t *testing.T) {
+		columns, err := ParseColumns(".metadata.name, status.phase,{.spec.containers[*].image}")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := []struct{ header, fieldSpec string }{
+			{"NAME", "{.metadata.name}"},
+			{"PHASE", "{.status.phase}"},
+			{"IMAGE", "{.spec.containers[*].image}"},
+		}
+		if len(columns) != len(expected) {
+			t.Fatalf("Expected %d columns, got %d", len(expected), len(columns))
+		}
+		for i, e := range expected {
+			if columns[i].Header != e.header || columns[i].FieldSpec != e.fieldSpec {
+				t.Errorf("Expected column %s:%s, got %s:%s", e.header, e.fieldSpec, columns[i].Header, columns[i].FieldSpec)
+			}
+		}
+	})
+	t.Run("keeps commas inside expressions", func(t *testing.T) {
+		columns, err := ParseColumns("READY:{.status.conditions[?(@.type==\"Ready\")].status},FIRST:.spec.containers[0,1].name")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(columns) != 2 || columns[0].Header != "READY" || columns[1].FieldSpec != "{.spec.containers[0,1].name}" {
+			t.Errorf("Unexpected columns: %v", columns)
+		}
+	})
+	t.Run("fails for invalid expressions", func(t *testing.T) {
+		if _, err := ParseColumns(".metadata.name,{.status[.phase}"); err == nil {
+			t.Error("Expected error for invalid JSONPath")
+		}
+	})
+	t.Run("fails for empty specification", func(t *testing.T) {
+		if _, err := ParseColumns(" , "); err == nil {
+			t.Error("Expected error for empty columns")
+		}
+	})
+}
+
+func TestPrintColumns(t *testing.T) {
+	list := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+		{Object: map[string]interface{}{"metadata": map[string]interface{}{"name": "pod-1"}, "status": map[string]interface{}{"phase": "Running"}}},
+		{Object: map[string]interface{}{"metadata": map[string]interface{}{"name": "pod-2"}}},
+	}}
+	columns, _ := ParseColumns(".metadata.name,.status.phase")
+	out, err := PrintColumns(list, columns)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := "NAME    PHASE\n" +
+		"pod-1   Running\n" +
+		"pod-2   <none>\n"
+	if out != expected {
+		t.Errorf("Unexpected output:\n%s", out)
+	}
+}
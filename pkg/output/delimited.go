@@ -0,0 +1,211 @@
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Csv prints list results as comma-separated rows with a header line (RFC 4180)
+var Csv = &Delimited{name: "csv", comma: ','}
+
+// Tsv prints list results as tab-separated rows with a header line (quoted the same way as Csv)
+var Tsv = &Delimited{name: "tsv", comma: '\t'}
+
+// Delimited outputs aren't available as the server list output, they can only be requested by the list tools
+// (output_format argument) to export the results to spreadsheets or scripts
+var delimitedOutputs = []*Delimited{Csv, Tsv}
+
+// Delimited prints objects as delimited rows, values containing the delimiter, quotes or line breaks are quoted
+// (and quotes doubled) as specified by RFC 4180
+type Delimited struct {
+	name  string
+	comma rune
+}
+
+// defaultDelimitedColumns are the columns printed for the lists that aren't retrieved in Table format
+var defaultDelimitedColumns, _ = ParseColumns("NAMESPACE:.metadata.namespace,NAME:.metadata.name,KIND:.kind,APIVERSION:.apiVersion")
+
+// FormatFromString returns the output with the provided name, either a server list output or a delimited one
+func FormatFromString(name string) Output {
+	if output := FromString(name); output != nil {
+		return output
+	}
+	for _, output := range delimitedOutputs {
+		if output.GetName() == name {
+			return output
+		}
+	}
+	return nil
+}
+
+// FormatNames returns the names of all the outputs that can be requested by the list tools
+func FormatNames() []string {
+	ret := slices.Clone(Names)
+	for _, output := range delimitedOutputs {
+		ret = append(ret, output.GetName())
+	}
+	return ret
+}
+
+func (p *Delimited) GetName() string {
+	return p.name
+}
+func (p *Delimited) AsTable() bool {
+	return true
+}
+func (p *Delimited) PrintObj(obj runtime.Unstructured) (string, error) {
+	if obj.GetObjectKind().GroupVersionKind() != metav1.SchemeGroupVersion.WithKind("Table") {
+		return p.PrintColumns(obj, defaultDelimitedColumns)
+	}
+	t := &metav1.Table{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), t); err != nil {
+		return "", err
+	}
+	namespaces := make([]string, len(t.Rows))
+	rowLabels := make([]string, len(t.Rows))
+	withNamespace := false
+	for i, row := range t.Rows {
+		if row.Object.Raw == nil {
+			continue
+		}
+		rowObject, err := runtime.Decode(unstructured.UnstructuredJSONScheme, row.Object.Raw)
+		if u, ok := rowObject.(*unstructured.Unstructured); err == nil && ok {
+			namespaces[i] = u.GetNamespace()
+			withNamespace = withNamespace || namespaces[i] != ""
+			rowLabels[i] = labels.Set(u.GetLabels()).String()
+		}
+	}
+	headers := make([]string, 0, len(t.ColumnDefinitions)+2)
+	if withNamespace {
+		headers = append(headers, "NAMESPACE")
+	}
+	for _, column := range t.ColumnDefinitions {
+		headers = append(headers, strings.ToUpper(column.Name))
+	}
+	headers = append(headers, "LABELS")
+	rows := make([][]string, 0, len(t.Rows))
+	for i, row := range t.Rows {
+		values := make([]string, 0, len(headers))
+		if withNamespace {
+			values = append(values, namespaces[i])
+		}
+		for _, cell := range row.Cells {
+			values = append(values, fmt.Sprint(cell))
+		}
+		rows = append(rows, append(values, rowLabels[i]))
+	}
+	return p.PrintRows(headers, rows)
+}
+
+// PrintColumns prints the items of the provided list (or the provided object) as delimited rows with the provided columns
+func (p *Delimited) PrintColumns(obj runtime.Unstructured, columns []Column) (string, error) {
+	headers, rows, err := columnRows(obj, columns)
+	if err != nil {
+		return "", err
+	}
+	return p.PrintRows(headers, rows)
+}
+
+// PrintMaps prints the provided items as delimited rows, nested maps are flattened into columns with dot-separated
+// names (e.g. InvolvedObject.Name), the columns are sorted by name
+func (p *Delimited) PrintMaps(items []map[string]any) (string, error) {
+	flattened := make([]map[string]string, 0, len(items))
+	headerSet := make(map[string]bool)
+	for _, item := range items {
+		values := make(map[string]string)
+		flattenMap("", item, values)
+		for header := range values {
+			headerSet[header] = true
+		}
+		flattened = append(flattened, values)
+	}
+	headers := make([]string, 0, len(headerSet))
+	for header := range headerSet {
+		headers = append(headers, header)
+	}
+	sort.Strings(headers)
+	rows := make([][]string, 0, len(flattened))
+	for _, values := range flattened {
+		row := make([]string, 0, len(headers))
+		for _, header := range headers {
+			row = append(row, values[header])
+		}
+		rows = append(rows, row)
+	}
+	return p.PrintRows(headers, rows)
+}
+
+// PrintRows prints the provided header line and rows
+func (p *Delimited) PrintRows(headers []string, rows [][]string) (string, error) {
+	buf := new(bytes.Buffer)
+	w := csv.NewWriter(buf)
+	w.Comma = p.comma
+	if err := w.Write(headers); err != nil {
+		return "", err
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// Truncate limits the provided delimited content to maxBytes (no limit if maxBytes <= 0) keeping the header line and
+// whole records (quoted values may span several lines), the records shown are re-encoded so that the content remains
+// parseable.
+// Returns the notice of the number of records shown if the content was truncated (to be returned apart from the content).
+func (p *Delimited) Truncate(content string, maxBytes int) (string, string, error) {
+	if maxBytes <= 0 || len(content) <= maxBytes {
+		return content, "", nil
+	}
+	r := csv.NewReader(strings.NewReader(content))
+	r.Comma = p.comma
+	records, err := r.ReadAll()
+	if err != nil || len(records) == 0 {
+		return "", "", err
+	}
+	buf := new(bytes.Buffer)
+	w := csv.NewWriter(buf)
+	w.Comma = p.comma
+	shown := 0
+	for i, record := range records {
+		size := buf.Len()
+		if err = w.Write(record); err != nil {
+			return "", "", err
+		}
+		w.Flush()
+		// The header line is always kept
+		if i > 0 && buf.Len() > maxBytes {
+			buf.Truncate(size)
+			break
+		}
+		shown = i
+	}
+	return buf.String(), fmt.Sprintf("Output truncated, %d of %d rows shown", shown, len(records)-1), w.Error()
+}
+
+// flattenMap sets the values of the provided item (and its nested maps) with their dot-separated key
+func flattenMap(prefix string, item map[string]any, values map[string]string) {
+	for key, value := range item {
+		switch v := value.(type) {
+		case map[string]any:
+			flattenMap(prefix+key+".", v, values)
+		case map[string]string:
+			for nestedKey, nestedValue := range v {
+				values[prefix+key+"."+nestedKey] = nestedValue
+			}
+		case nil:
+			values[prefix+key] = ""
+		default:
+			values[prefix+key] = fmt.Sprint(v)
+		}
+	}
+}
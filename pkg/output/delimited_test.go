@@ -0,0 +1,140 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDelimitedPrintRows(t *testing.T) {
+	headers := []string{"NAME", "MESSAGE"}
+	rows := [][]string{
+		{"pod-1", "Back-off restarting, container \"app\" failed\nsee logs"},
+		{"pod-2", "plain"},
+	}
+	t.Run("csv quotes values with commas, quotes and line breaks (RFC 4180)", func(t *testing.T) {
+		out, err := Csv.PrintRows(headers, rows)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := "NAME,MESSAGE\n" +
+			"pod-1,\"Back-off restarting, container \"\"app\"\" failed\nsee logs\"\n" +
+			"pod-2,plain\n"
+		if out != expected {
+			t.Errorf("Unexpected output:\n%s", out)
+		}
+	})
+	t.Run("tsv quotes values with quotes and line breaks", func(t *testing.T) {
+		out, err := Tsv.PrintRows(headers, rows)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := "NAME\tMESSAGE\n" +
+			"pod-1\t\"Back-off restarting, container \"\"app\"\" failed\nsee logs\"\n" +
+			"pod-2\tplain\n"
+		if out != expected {
+			t.Errorf("Unexpected output:\n%s", out)
+		}
+	})
+}
+
+func TestDelimitedPrintObj(t *testing.T) {
+	t.Run("prints Table rows with namespace and labels", func(t *testing.T) {
+		table := &unstructured.Unstructured{}
+		_ = json.Unmarshal([]byte(`{"apiVersion": "meta.k8s.io/v1", "kind": "Table",
+			"columnDefinitions": [{"name": "Name", "type": "string"}, {"name": "Status", "type": "string"}, {"name": "Restarts", "type": "integer"}],
+			"rows": [
+				{"cells": ["pod-1", "Running", 0], "object": {"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "pod-1", "namespace": "ns-1", "labels": {"app": "web", "tier": "frontend"}}}},
+				{"cells": ["pod-2", "Pending", 3], "object": {"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "pod-2", "namespace": "ns-2"}}}
+			]}`), &table.Object)
+		out, err := Csv.PrintObj(table)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := "NAMESPACE,NAME,STATUS,RESTARTS,LABELS\n" +
+			"ns-1,pod-1,Running,0,\"app=web,tier=frontend\"\n" +
+			"ns-2,pod-2,Pending,3,\n"
+		if out != expected {
+			t.Errorf("Unexpected output:\n%s", out)
+		}
+	})
+	t.Run("prints default columns for lists", func(t *testing.T) {
+		list := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+			{Object: map[string]interface{}{"apiVersion": "v1", "kind": "ConfigMap", "metadata": map[string]interface{}{"name": "cm-1", "namespace": "ns-1"}}},
+		}}
+		out, err := Tsv.PrintObj(list)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := "NAMESPACE\tNAME\tKIND\tAPIVERSION\n" +
+			"ns-1\tcm-1\tConfigMap\tv1\n"
+		if out != expected {
+			t.Errorf("Unexpected output:\n%s", out)
+		}
+	})
+}
+
+func TestDelimitedPrintMaps(t *testing.T) {
+	out, err := Csv.PrintMaps([]map[string]any{
+		{"Name": "event-1", "InvolvedObject": map[string]string{"Kind": "Pod", "Name": "a-pod"}, "Message": "a, b"},
+		{"Name": "event-2", "Message": nil},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := "InvolvedObject.Kind,InvolvedObject.Name,Message,Name\n" +
+		"Pod,a-pod,\"a, b\",event-1\n" +
+		",,,event-2\n"
+	if out != expected {
+		t.Errorf("Unexpected output:\n%s", out)
+	}
+}
+
+func TestDelimitedTruncate(t *testing.T) {
+	content := "Name,Message\n" +
+		"event-1,\"line 1\nline 2\"\n" +
+		"event-2,\"a, b\"\n" +
+		"event-3,c\n"
+	t.Run("returns content as is if it fits", func(t *testing.T) {
+		out, notice, err := Csv.Truncate(content, len(content))
+		if err != nil || out != content || notice != "" {
+			t.Errorf("Unexpected output: %q, notice: %q, err: %v", out, notice, err)
+		}
+	})
+	t.Run("keeps the header and whole records", func(t *testing.T) {
+		out, notice, err := Csv.Truncate(content, len(content)-1)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := "Name,Message\n" +
+			"event-1,\"line 1\nline 2\"\n" +
+			"event-2,\"a, b\"\n"
+		if out != expected {
+			t.Errorf("Unexpected output:\n%s", out)
+		}
+		if notice != "Output truncated, 2 of 3 rows shown" {
+			t.Errorf("Unexpected notice: %s", notice)
+		}
+	})
+	t.Run("keeps the header if no record fits", func(t *testing.T) {
+		out, notice, err := Tsv.Truncate("Name\tMessage\nevent-1\tmessage\n", 16)
+		if err != nil || out != "Name\tMessage\n" || notice != "Output truncated, 0 of 1 rows shown" {
+			t.Errorf("Unexpected output: %q, notice: %q, err: %v", out, notice, err)
+		}
+	})
+}
+
+func TestFormatFromString(t *testing.T) {
+	for _, name := range []string{"yaml", "table", "csv", "tsv"} {
+		if o := FormatFromString(name); o == nil || o.GetName() != name {
+			t.Errorf("Expected output %s, got %v", name, o)
+		}
+	}
+	if FormatFromString("json") != nil {
+		t.Error("Expected no output for unknown format")
+	}
+	if FromString("csv") != nil {
+		t.Error("Expected csv not to be available as list output")
+	}
+}
@@ -0,0 +1,102 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// DefaultMaxBytes is the default maximum size of the list tool results
+const DefaultMaxBytes = 256 * 1024
+
+// Truncate limits the provided list content (YAML list or table) to maxBytes (no limit if maxBytes <= 0).
+// Whole items are kept: top-level YAML list entries, or rows in case of table output (leading and trailing comments
+// and the table header are preserved). Items spanning several lines are only supported for YAML, free-form text must
+// be truncated with TruncateLines instead.
+// A footer noting the number of items shown is appended to the truncated content.
+func Truncate(content string, maxBytes int) string {
+	if maxBytes <= 0 || len(content) <= maxBytes {
+		return content
+	}
+	lines := strings.SplitAfter(content, "\n")
+	// Leading and trailing comments (e.g. result description, continue token) are always preserved
+	start, end := 0, len(lines)
+	for start < end && strings.HasPrefix(lines[start], "#") {
+		start++
+	}
+	for end > start && (strings.HasPrefix(lines[end-1], "#") || lines[end-1] == "") {
+		end--
+	}
+	prefix := strings.Join(lines[:start], "")
+	suffix := strings.Join(lines[end:], "")
+	// Group the remaining lines into items
+	var header string
+	var items []string
+	isYaml := start < end && strings.HasPrefix(lines[start], "- ")
+	for i := start; i < end; i++ {
+		switch {
+		case !isYaml && i == start:
+			header = lines[i]
+		case !isYaml, strings.HasPrefix(lines[i], "- "):
+			items = append(items, lines[i])
+		default:
+			items[len(items)-1] += lines[i]
+		}
+	}
+	if len(items) == 0 {
+		return truncateBytes(content, maxBytes)
+	}
+	size := len(prefix) + len(header) + len(suffix)
+	shown := 0
+	for ; shown < len(items); shown++ {
+		footer := fmt.Sprintf("# ... output truncated, %d of %d items shown\n", shown+1, len(items))
+		if size+len(items[shown])+len(footer) > maxBytes {
+			break
+		}
+		size += len(items[shown])
+	}
+	footer := fmt.Sprintf("# ... output truncated, %d of %d items shown\n", shown, len(items))
+	return prefix + header + strings.Join(items[:shown], "") + footer + suffix
+}
+
+// TruncateLines limits the provided free-form text (e.g. logs, descriptions) to maxBytes (no limit if maxBytes <= 0)
+// keeping whole lines: the first ones, or the last ones if tail is true.
+// A notice of the number of lines shown replaces the removed lines.
+func TruncateLines(content string, maxBytes int, tail bool) string {
+	if maxBytes <= 0 || len(content) <= maxBytes {
+		return content
+	}
+	lines := strings.SplitAfter(strings.TrimSuffix(content, "\n"), "\n")
+	notice := func(shown int) string {
+		return fmt.Sprintf("# ... output truncated, %d of %d lines shown\n", shown, len(lines))
+	}
+	size := len(notice(len(lines))) + 1
+	shown := 0
+	for ; shown < len(lines); shown++ {
+		line := lines[shown]
+		if tail {
+			line = lines[len(lines)-1-shown]
+		}
+		if size+len(line) > maxBytes {
+			break
+		}
+		size += len(line)
+	}
+	if shown == 0 {
+		return truncateBytes(content, maxBytes)
+	}
+	if tail {
+		return notice(shown) + strings.Join(lines[len(lines)-shown:], "") + "\n"
+	}
+	return strings.Join(lines[:shown], "") + notice(shown)
+}
+
+// truncateBytes cuts the provided content to maxBytes (at a rune boundary) with a notice of the number of bytes shown
+func truncateBytes(content string, maxBytes int) string {
+	// The notice is accounted for using the largest possible number of bytes shown
+	cut := max(maxBytes-len(fmt.Sprintf("\n# ... output truncated, %d of %d bytes shown\n", len(content), len(content))), 0)
+	for cut > 0 && !utf8.RuneStart(content[cut]) {
+		cut--
+	}
+	return content[:cut] + fmt.Sprintf("\n# ... output truncated, %d of %d bytes shown\n", cut, len(content))
+}
@@ -0,0 +1,119 @@
+package output
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncate(t *testing.T) {
+	t.Run("returns content within limit unchanged", func(t *testing.T) {
+		content := "# The following events (YAML format) were found:\n- Name: a\n- Name: b\n"
+		if out := Truncate(content, len(content)); out != content {
+			t.Errorf("Expected content unchanged, got: %s", out)
+		}
+	})
+	t.Run("returns content unchanged when limit is disabled", func(t *testing.T) {
+		content := strings.Repeat("x", 1024)
+		if out := Truncate(content, 0); out != content {
+			t.Errorf("Expected content unchanged, got %d bytes", len(out))
+		}
+	})
+	t.Run("truncates YAML list keeping whole items", func(t *testing.T) {
+		content := "# The following events (YAML format) were found:\n" +
+			"- Name: event-1\n  Message: " + strings.Repeat("a", 100) + "\n" +
+			"- Name: event-2\n  Message: " + strings.Repeat("b", 100) + "\n" +
+			"- Name: event-3\n  Message: " + strings.Repeat("c", 100) + "\n" +
+			"# More results are available, use the following continue token to retrieve the next page: token\n"
+		out := Truncate(content, 500)
+		if len(out) > 500 {
+			t.Errorf("Expected at most 500 bytes, got %d", len(out))
+		}
+		expected := "# The following events (YAML format) were found:\n" +
+			"- Name: event-1\n  Message: " + strings.Repeat("a", 100) + "\n" +
+			"- Name: event-2\n  Message: " + strings.Repeat("b", 100) + "\n" +
+			"# ... output truncated, 2 of 3 items shown\n" +
+			"# More results are available, use the following continue token to retrieve the next page: token\n"
+		if out != expected {
+			t.Errorf("Unexpected truncated output:\n%s", out)
+		}
+	})
+	t.Run("truncates table keeping header", func(t *testing.T) {
+		content := "NAMESPACE   APIVERSION   KIND   NAME\n" +
+			"ns-1        v1           Pod    pod-1\n" +
+			"ns-1        v1           Pod    pod-2\n" +
+			"ns-1        v1           Pod    pod-3\n" +
+			"ns-1        v1           Pod    pod-4\n"
+		out := Truncate(content, 160)
+		expected := "NAMESPACE   APIVERSION   KIND   NAME\n" +
+			"ns-1        v1           Pod    pod-1\n" +
+			"ns-1        v1           Pod    pod-2\n" +
+			"# ... output truncated, 2 of 4 items shown\n"
+		if out != expected {
+			t.Errorf("Unexpected truncated output:\n%s", out)
+		}
+	})
+	t.Run("truncates unstructured content by bytes", func(t *testing.T) {
+		content := "# header\n" + strings.Repeat("é", 100)
+		out := Truncate(content, 100)
+		if len(out) > 100 {
+			t.Errorf("Expected at most 100 bytes, got %d", len(out))
+		}
+		if !utf8.ValidString(out) {
+			t.Errorf("Expected content cut at rune boundary, got: %q", out)
+		}
+		if !strings.HasSuffix(out, "\n# ... output truncated, 51 of 209 bytes shown\n") {
+			t.Errorf("Expected truncation notice, got: %q", out)
+		}
+	})
+}
+
+func TestTruncateLines(t *testing.T) {
+	content := "Name:         web\n" +
+		"Namespace:    ns-1\n" +
+		"Labels:       app=web\n" +
+		"              tier=frontend\n" +
+		"Events:       <none>\n"
+	t.Run("returns content within limit unchanged", func(t *testing.T) {
+		if out := TruncateLines(content, len(content), false); out != content {
+			t.Errorf("Expected content unchanged, got: %s", out)
+		}
+	})
+	t.Run("keeps the first whole lines", func(t *testing.T) {
+		out := TruncateLines(content, 100, false)
+		if len(out) > 100 {
+			t.Errorf("Expected at most 100 bytes, got %d", len(out))
+		}
+		expected := "Name:         web\n" +
+			"Namespace:    ns-1\n" +
+			"# ... output truncated, 2 of 5 lines shown\n"
+		if out != expected {
+			t.Errorf("Unexpected truncated output:\n%s", out)
+		}
+	})
+	t.Run("keeps the last whole lines with tail", func(t *testing.T) {
+		logs := "line 0 ERROR zeroth\nline 1 ERROR first\nline 2 ERROR second\nline 3 ERROR third\nline 4 ERROR fourth"
+		out := TruncateLines(logs, 85, true)
+		if len(out) > 85 {
+			t.Errorf("Expected at most 85 bytes, got %d", len(out))
+		}
+		expected := "# ... output truncated, 2 of 5 lines shown\n" +
+			"line 3 ERROR third\n" +
+			"line 4 ERROR fourth\n"
+		if out != expected {
+			t.Errorf("Unexpected truncated output:\n%s", out)
+		}
+	})
+	t.Run("does not treat the first line as a header", func(t *testing.T) {
+		out := TruncateLines(content, 70, true)
+		if out != "# ... output truncated, 1 of 5 lines shown\nEvents:       <none>\n" {
+			t.Errorf("Expected only the last line, got:\n%s", out)
+		}
+	})
+	t.Run("truncates a single line by bytes", func(t *testing.T) {
+		out := TruncateLines(strings.Repeat("é", 100), 100, false)
+		if len(out) > 100 || !utf8.ValidString(out) {
+			t.Errorf("Expected at most 100 bytes cut at rune boundary, got: %q", out)
+		}
+	})
+}
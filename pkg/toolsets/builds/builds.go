@@ -0,0 +1,174 @@
+package builds
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	internalk8s "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+func initBuilds() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "buildconfigs_list",
+			Description: "List the OpenShift BuildConfigs (build.openshift.io/v1) in the current cluster from the provided namespace or all namespaces",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace to list the BuildConfigs from. If not provided, will list BuildConfigs from all namespaces",
+					},
+					"labelSelector": {
+						Type:        "string",
+						Description: "Optional Kubernetes label selector (e.g. 'app=myapp,env=prod' or 'app in (myapp,yourapp)'), use this option when you want to filter the BuildConfigs by label",
+						Pattern:     "([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "BuildConfigs: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: buildConfigsList},
+		{Tool: api.Tool{
+			Name:        "builds_start",
+			Description: "Start a new OpenShift Build from the provided BuildConfig in the current or provided namespace (equivalent to `oc start-build`). Returns the name and phase of the created Build",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the BuildConfig (Optional, current namespace if not provided)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the BuildConfig to start a new Build from",
+					},
+					"commit": {
+						Type:        "string",
+						Description: "Optional source Git commit to build (overrides the BuildConfig source revision)",
+					},
+					"env": {
+						Type:                 "object",
+						Description:          "Optional environment variables to add or override in the build strategy (e.g. {\"KEY\": \"value\"})",
+						AdditionalProperties: &jsonschema.Schema{Type: "string"},
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Builds: Start",
+				ReadOnlyHint:    ptr.To(false),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: buildsStart},
+		{Tool: api.Tool{
+			Name:        "builds_log",
+			Description: "Get the logs of an OpenShift Build in the current or provided namespace (equivalent to `oc logs build/<name>`). Useful to diagnose failed builds",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the Build (Optional, current namespace if not provided)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the Build to get the logs from",
+					},
+					"tail_lines": {
+						Type:        "integer",
+						Description: "Number of lines to retrieve from the end of the logs (Optional, all lines if not provided)",
+						Minimum:     ptr.To(float64(0)),
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Builds: Log",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: buildsLog},
+	}
+}
+
+func buildConfigsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	namespace := params.GetArguments()["namespace"]
+	if namespace == nil {
+		namespace = ""
+	}
+	ns, ok := namespace.(string)
+	if !ok {
+		return api.NewToolCallResult("", fmt.Errorf("namespace is not a string")), nil
+	}
+	resourceListOptions := internalk8s.ResourceListOptions{
+		AsTable: params.ListOutput.AsTable(),
+	}
+	if labelSelector, ok := params.GetArguments()["labelSelector"].(string); ok {
+		resourceListOptions.LabelSelector = labelSelector
+	}
+	ret, err := params.BuildConfigsList(params, ns, resourceListOptions)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list build configs: %v", err)), nil
+	}
+	return api.NewToolCallResult(params.ListOutput.PrintObj(ret)), nil
+}
+
+func buildsStart(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	ns, _ := params.GetArguments()["namespace"].(string)
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to start build, missing argument name")), nil
+	}
+	options := internalk8s.BuildsStartOptions{}
+	options.Commit, _ = params.GetArguments()["commit"].(string)
+	if env, ok := params.GetArguments()["env"].(map[string]interface{}); ok {
+		options.Env = make(map[string]string, len(env))
+		for key, value := range env {
+			options.Env[key] = fmt.Sprintf("%v", value)
+		}
+	}
+	build, err := params.BuildsStart(params, ns, name, options)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to start build from build config %s: %v", name, err)), nil
+	}
+	phase, _, _ := unstructured.NestedString(build.Object, "status", "phase")
+	if phase == "" {
+		phase = "New"
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Build %s/%s started from BuildConfig %s, phase: %s",
+		build.GetNamespace(), build.GetName(), name, phase), nil), nil
+}
+
+func buildsLog(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	ns, _ := params.GetArguments()["namespace"].(string)
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to get build log, missing argument name")), nil
+	}
+	tailLines, err := api.IntArgument(params.GetArguments(), "tail_lines")
+	if err != nil {
+		return api.NewToolCallResult("", err), nil
+	}
+	ret, err := params.BuildsLog(params, ns, name, tailLines)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get build %s log: %v", name, err)), nil
+	} else if ret == "" {
+		ret = fmt.Sprintf("The build %s has not logged any message yet", name)
+	}
+	return api.NewToolCallResult(ret, nil), nil
+}
@@ -0,0 +1,74 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+func initAPIResources() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "api_resources",
+			Description: "List the API resources supported by the current cluster (like `kubectl api-resources`), including their apiVersion, kind, whether they are namespaced, and their supported verbs. Use it to find the valid apiVersion and kind values for the resources_* tools",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"group": {
+						Type:        "string",
+						Description: "Optional API group to filter the resources by (e.g. apps, networking.k8s.io, or core for the core API group). If not provided, will list resources from all API groups",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "API Resources: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: apiResourcesList},
+	}
+}
+
+func apiResourcesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	var group *string
+	if g, ok := params.GetArguments()["group"].(string); ok {
+		group = &g
+	}
+	apiResourceLists, failed, err := params.APIResourcesList(params, group)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list api resources: %v", err)), nil
+	}
+	if len(apiResourceLists) == 0 {
+		return api.NewToolCallResult("# No API resources found", nil), nil
+	}
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAME\tSHORTNAMES\tAPIVERSION\tNAMESPACED\tKIND\tVERBS")
+	for _, apiResourceList := range apiResourceLists {
+		for _, apiResource := range apiResourceList.APIResources {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+				apiResource.Name,
+				strings.Join(apiResource.ShortNames, ","),
+				apiResourceList.GroupVersion,
+				strconv.FormatBool(apiResource.Namespaced),
+				apiResource.Kind,
+				strings.Join(apiResource.Verbs, ","),
+			)
+		}
+	}
+	_ = w.Flush()
+	ret := buf.String()
+	if len(failed) > 0 {
+		ret += "# The following API groups could not be discovered: " + strings.Join(failed, "; ") + "\n"
+	}
+	return api.NewToolCallResult(ret, nil), nil
+}
@@ -0,0 +1,55 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+func initAutoscaling() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "hpa_list",
+			Description: "List the Kubernetes HorizontalPodAutoscalers in the current cluster from the provided namespace or all namespaces, including their scale target, min/max replicas, current vs desired replicas, current metric values vs targets, and conditions (AbleToScale, ScalingActive, ScalingLimited). Useful to understand why a workload isn't autoscaling as expected",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace to list the HorizontalPodAutoscalers from. If not provided, will list HorizontalPodAutoscalers from all namespaces",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "HorizontalPodAutoscalers: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: hpaList},
+	}
+}
+
+func hpaList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	ns, ok := params.GetArguments()["namespace"].(string)
+	if !ok && params.GetArguments()["namespace"] != nil {
+		return api.NewToolCallResult("", fmt.Errorf("namespace is not a string")), nil
+	}
+	hpas, err := params.HorizontalPodAutoscalersList(params, ns)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list horizontal pod autoscalers: %v", err)), nil
+	}
+	if len(hpas) == 0 {
+		return api.NewToolCallResult("# No horizontal pod autoscalers found", nil), nil
+	}
+	yamlHPAs, err := output.MarshalYaml(hpas)
+	if err != nil {
+		err = fmt.Errorf("failed to list horizontal pod autoscalers: %v", err)
+	}
+	return params.NewTruncatedToolCallResult(fmt.Sprintf("# The following horizontal pod autoscalers (YAML format) were found:\n%s", yamlHPAs), err), nil
+}
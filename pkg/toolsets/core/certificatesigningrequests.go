@@ -0,0 +1,97 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+func initCertificateSigningRequests() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "csr_list",
+			Description: "List the pending CertificateSigningRequests (CSRs) of the current cluster with their requestor, signer name, age, and the node they were requested for. Nodes can't join the cluster (or serve logs and exec) until their kubelet client and serving CSRs are approved, a frequent cause of stuck node scaling in OpenShift",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "CertificateSigningRequests: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: csrList},
+		{Tool: api.Tool{
+			Name: "csr_approve",
+			Description: "Approve the pending kubelet client and serving CertificateSigningRequests (CSRs) of the current cluster with the provided name and/or requested for the provided node. Only the CSRs of the node bootstrap flow are approved: the kubelet client certificates requested by the node-bootstrapper service account (or the node itself) and the kubelet serving certificates requested by the node itself, a CSR requested by anyone else is refused. " +
+				"The CSRs are only approved if the Node (or its Machine for client certificates) exists, their organization is system:nodes, their key usages are those of the signer, and the subject alternative names of the serving certificates are addresses of the Node. Returns the approved CSRs",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the CSR to approve (Optional if node is provided)",
+					},
+					"node": {
+						Type:        "string",
+						Description: "Name of the node to approve the kubelet client and serving CSRs for (Optional if name is provided)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "CertificateSigningRequests: Approve",
+				ReadOnlyHint:    ptr.To(false),
+				DestructiveHint: ptr.To(true),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: csrApprove},
+	}
+}
+
+func csrList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	csrs, err := params.CertificateSigningRequestsList(params)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list certificate signing requests: %v", err)), nil
+	}
+	if len(csrs) == 0 {
+		return api.NewToolCallResult("# No pending certificate signing requests found", nil), nil
+	}
+	yamlCsrs, err := output.MarshalYaml(csrs)
+	if err != nil {
+		err = fmt.Errorf("failed to list certificate signing requests: %v", err)
+	}
+	return params.NewTruncatedToolCallResult("# The following pending certificate signing requests (YAML format) were found:\n"+yamlCsrs, err), nil
+}
+
+func csrApprove(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok && params.GetArguments()["name"] != nil {
+		return api.NewToolCallResult("", fmt.Errorf("name is not a string")), nil
+	}
+	node, ok := params.GetArguments()["node"].(string)
+	if !ok && params.GetArguments()["node"] != nil {
+		return api.NewToolCallResult("", fmt.Errorf("node is not a string")), nil
+	}
+	if name == "" && node == "" {
+		return api.NewToolCallResult("", errors.New("failed to approve certificate signing requests, missing argument name or node")), nil
+	}
+	approved, err := params.CertificateSigningRequestsApprove(params, name, node)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to approve certificate signing requests: %v", err)), nil
+	}
+	if len(approved) == 0 {
+		return api.NewToolCallResult("# No matching pending certificate signing requests found", nil), nil
+	}
+	yamlApproved, err := output.MarshalYaml(approved)
+	if err != nil {
+		err = fmt.Errorf("failed to approve certificate signing requests: %v", err)
+	}
+	return api.NewToolCallResult("# The following certificate signing requests (YAML format) were approved:\n"+yamlApproved, err), nil
+}
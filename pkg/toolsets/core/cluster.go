@@ -0,0 +1,239 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	internalk8s "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+const defaultCertificatesWarnDays = 30
+
+func initCluster(o internalk8s.Openshift) []api.ServerTool {
+	ret := make([]api.ServerTool, 0)
+	ret = append(ret, api.ServerTool{
+		Tool: api.Tool{
+			Name:        "whoami",
+			Description: "Get the identity (username and groups) the server is operating as in the current cluster and the active kubeconfig context name. Useful to verify the effective identity before performing changes",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Cluster: Who Am I",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: whoAmI,
+	})
+	if o.IsOpenShift(context.Background()) {
+		ret = append(ret, api.ServerTool{
+			Tool: api.Tool{
+				Name:        "cluster_info",
+				Description: "Get an overview of the current OpenShift cluster: web console URL, API server URL, cluster ID, OpenShift version, and infrastructure platform (AWS, Azure, vSphere, etc.)",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Cluster: Info",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			}, Handler: clusterInfo,
+		})
+		ret = append(ret, api.ServerTool{
+			Tool: api.Tool{
+				Name:        "pullsecret_status",
+				Description: "Get the status of the global pull secret of the current OpenShift cluster (openshift-config/pull-secret): the registries with credentials configured (hostnames only, credentials are never returned) and whether the Red Hat registries (registry.redhat.io, registry.connect.redhat.com) are present. Missing credentials are a frequent root cause of operator and must-gather image pull failures",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Cluster: Pull Secret Status",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			}, Handler: pullSecretStatus,
+		})
+		ret = append(ret, api.ServerTool{
+			Tool: api.Tool{
+				Name:        "image_config",
+				Description: "Get the image registries configuration of the current OpenShift cluster: the allowed, blocked, and insecure registries of the cluster Image config (image.config.openshift.io) and the registry mirrors configured by the ImageContentSourcePolicies and ImageDigestMirrorSets. Explains why an operator or must-gather image fails to pull in a restricted or disconnected cluster",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Cluster: Image Config",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			}, Handler: imageConfig,
+		})
+		ret = append(ret, api.ServerTool{
+			Tool: api.Tool{
+				Name:        "clusteroperators_diagnose",
+				Description: "Diagnose the root causes of the degraded ClusterOperators of the current OpenShift cluster (or the provided one). For each degraded operator, reports the full Degraded condition message correlated with the failing Pods (crash-looping, image pull errors, unschedulable, etc.) and the Warning events of the namespaces the operator relates to (derived from the operator's relatedObjects)",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"name": {
+							Type:        "string",
+							Description: "Optional name of the ClusterOperator to diagnose (e.g. authentication, ingress). If not provided, will diagnose all the degraded ClusterOperators",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "ClusterOperators: Diagnose",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(false),
+					OpenWorldHint:   ptr.To(true),
+				},
+			}, Handler: clusterOperatorsDiagnose,
+		})
+		ret = append(ret, api.ServerTool{
+			Tool: api.Tool{
+				Name:        "controlplane_health",
+				Description: "Summarize the health of the control plane of the current OpenShift cluster in one view: the Available, Progressing, and Degraded conditions of the etcd, kube-apiserver, and kube-controller-manager ClusterOperators and the readiness of their static pods. This is the first thing to check when the cluster feels unstable",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Control Plane: Health",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			}, Handler: controlPlaneHealth,
+		})
+		ret = append(ret, api.ServerTool{
+			Tool: api.Tool{
+				Name:        "certificates_expiring",
+				Description: "Inspect the certificates held by the Secrets and ConfigMaps of the well-known OpenShift namespaces (openshift-config, openshift-kube-apiserver, openshift-etcd, openshift-ingress, etc.) of the current cluster and report the serving, client, and CA certificates expiring within warn_days (or already expired) with their subject, issuer, and expiry date. Expired internal certificates cause cluster-wide outages",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"warn_days": {
+							Type:        "integer",
+							Description: fmt.Sprintf("Number of days before expiry to report a certificate (Optional, default: %d)", defaultCertificatesWarnDays),
+							Default:     api.ToRawMessage(defaultCertificatesWarnDays),
+							Minimum:     ptr.To(float64(0)),
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Certificates: Expiring",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(false),
+					OpenWorldHint:   ptr.To(true),
+				},
+			}, Handler: certificatesExpiring,
+		})
+	}
+	return ret
+}
+
+func clusterInfo(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	info, err := output.MarshalYaml(params.ClusterInfo(params))
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get cluster info: %v", err)), nil
+	}
+	return api.NewToolCallResult("# The following cluster information (YAML format) was found:\n"+info, nil), nil
+}
+
+func certificatesExpiring(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	warnDays := int64(defaultCertificatesWarnDays)
+	if _, ok := params.GetArguments()["warn_days"]; ok {
+		var err error
+		if warnDays, err = api.IntArgument(params.GetArguments(), "warn_days"); err != nil {
+			return api.NewToolCallResult("", err), nil
+		}
+	}
+	certificates, skipped, err := params.CertificatesExpiring(params, int(warnDays))
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to inspect certificates: %v", err)), nil
+	}
+	ret := fmt.Sprintf("# No certificates expiring within %d days found", warnDays)
+	if len(certificates) > 0 {
+		yamlCertificates, err := output.MarshalYaml(certificates)
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to inspect certificates: %v", err)), nil
+		}
+		ret = fmt.Sprintf("# The following certificates (YAML format) expiring within %d days were found:\n%s", warnDays, yamlCertificates)
+	}
+	return api.NewToolCallResult(withScanWarnings(ret, skipped), nil), nil
+}
+
+func clusterOperatorsDiagnose(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	name, _ := params.GetArguments()["name"].(string)
+	diagnosis, err := params.ClusterOperatorsDiagnose(params, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to diagnose cluster operators: %v", err)), nil
+	}
+	if len(diagnosis) == 0 {
+		return api.NewToolCallResult("# No degraded cluster operators found", nil), nil
+	}
+	yamlDiagnosis, err := output.MarshalYaml(diagnosis)
+	if err != nil {
+		err = fmt.Errorf("failed to diagnose cluster operators: %v", err)
+	}
+	return params.NewTruncatedToolCallResult("# The following degraded cluster operators (YAML format) were found:\n"+yamlDiagnosis, err), nil
+}
+
+func controlPlaneHealth(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	health, err := params.ControlPlaneHealth(params)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get control plane health: %v", err)), nil
+	}
+	yamlHealth, err := output.MarshalYaml(health)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get control plane health: %v", err)), nil
+	}
+	return api.NewToolCallResult("# The following control plane health (YAML format) was found:\n"+yamlHealth, nil), nil
+}
+
+func imageConfig(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	config, err := output.MarshalYaml(params.ClusterImageConfig(params))
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get image config: %v", err)), nil
+	}
+	return api.NewToolCallResult("# The following image registries configuration (YAML format) was found:\n"+config, nil), nil
+}
+
+func pullSecretStatus(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	status, err := params.ClusterPullSecretStatus(params)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get pull secret status: %v", err)), nil
+	}
+	yamlStatus, err := output.MarshalYaml(status)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get pull secret status: %v", err)), nil
+	}
+	return api.NewToolCallResult("# The following pull secret status (YAML format) was found:\n"+yamlStatus, nil), nil
+}
+
+func whoAmI(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	identity, err := params.WhoAmI(params)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get current identity: %v", err)), nil
+	}
+	yamlIdentity, err := output.MarshalYaml(identity)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get current identity: %v", err)), nil
+	}
+	return api.NewToolCallResult("# The server is operating with the following identity (YAML format):\n"+yamlIdentity, nil), nil
+}
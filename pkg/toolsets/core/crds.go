@@ -0,0 +1,52 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+func initCRDs() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "crds_list",
+			Description: "List the CustomResourceDefinitions (CRDs) installed in the current cluster, including their group, kind, served versions, scope (Namespaced or Cluster), and short names. Use it to discover the custom resources provided by operators before querying them with the resources_* tools",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"group": {
+						Type:        "string",
+						Description: "Optional substring of the API group to filter the CRDs by (e.g. openshift.io, cert-manager). If not provided, will list all the CRDs",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "CRDs: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: crdsList},
+	}
+}
+
+func crdsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	group, _ := params.GetArguments()["group"].(string)
+	crds, err := params.CRDsList(params, group)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list crds: %v", err)), nil
+	}
+	if len(crds) == 0 {
+		return api.NewToolCallResult("# No CRDs found", nil), nil
+	}
+	yamlCRDs, err := output.MarshalYaml(crds)
+	if err != nil {
+		err = fmt.Errorf("failed to list crds: %v", err)
+	}
+	return params.NewTruncatedToolCallResult("# The following CRDs (YAML format) were found:\n"+yamlCRDs, err), nil
+}
@@ -1,12 +1,14 @@
 package core
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/google/jsonschema-go/jsonschema"
 	"k8s.io/utils/ptr"
 
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	internalk8s "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
 	"github.com/containers/kubernetes-mcp-server/pkg/output"
 )
 
@@ -17,12 +19,12 @@ func initEvents() []api.ServerTool {
 			Description: "List all the Kubernetes events in the current cluster from all namespaces",
 			InputSchema: &jsonschema.Schema{
 				Type: "object",
-				Properties: map[string]*jsonschema.Schema{
+				Properties: withOutputFormat(withPagination(map[string]*jsonschema.Schema{
 					"namespace": {
 						Type:        "string",
 						Description: "Optional Namespace to retrieve the events from. If not provided, will list events from all namespaces",
 					},
-				},
+				})),
 			},
 			Annotations: api.ToolAnnotations{
 				Title:           "Events: List",
@@ -32,6 +34,29 @@ func initEvents() []api.ServerTool {
 				OpenWorldHint:   ptr.To(true),
 			},
 		}, Handler: eventsList},
+		{Tool: api.Tool{
+			Name:        "events_warnings",
+			Description: "Aggregate the Kubernetes Warning events in the current cluster from the provided namespaces (or all namespaces), grouped by namespace and sorted by number of occurrences. Namespaces that can't be accessed are skipped",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespaces": {
+						Type:        "array",
+						Description: "Optional list of Namespaces to aggregate the Warning events from. If not provided, will aggregate Warning events from all namespaces",
+						Items: &jsonschema.Schema{
+							Type: "string",
+						},
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Events: Warnings",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: eventsWarnings},
 	}
 }
 
@@ -40,16 +65,59 @@ func eventsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	if namespace == nil {
 		namespace = ""
 	}
-	eventMap, err := params.EventsList(params, namespace.(string))
+	listOutput, err := parseOutputFormat(params.GetArguments(), output.Yaml)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list events, %s", err)), nil
+	}
+	resourceListOptions := internalk8s.ResourceListOptions{}
+	if err := parsePagination(params.GetArguments(), &resourceListOptions); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list events, %s", err)), nil
+	}
+	eventMap, continueToken, err := params.EventsList(params, namespace.(string), resourceListOptions)
 	if err != nil {
 		return api.NewToolCallResult("", fmt.Errorf("failed to list events in all namespaces: %v", err)), nil
 	}
 	if len(eventMap) == 0 {
-		return api.NewToolCallResult("# No events found", nil), nil
+		return api.NewToolCallResult(withContinueToken("# No events found", continueToken), nil), nil
+	}
+	if delimited, ok := listOutput.(*output.Delimited); ok {
+		delimitedEvents, err := delimited.PrintMaps(eventMap)
+		if err != nil {
+			err = fmt.Errorf("failed to list events in all namespaces: %v", err)
+		}
+		return newPageToolCallResult(params, delimited, delimitedEvents, continueToken, err), nil
 	}
 	yamlEvents, err := output.MarshalYaml(eventMap)
 	if err != nil {
 		err = fmt.Errorf("failed to list events in all namespaces: %v", err)
 	}
-	return api.NewToolCallResult(fmt.Sprintf("# The following events (YAML format) were found:\n%s", yamlEvents), err), nil
+	return params.NewTruncatedToolCallResult(withContinueToken(fmt.Sprintf("# The following events (YAML format) were found:\n%s", yamlEvents), continueToken), err), nil
+}
+
+func eventsWarnings(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	namespaces := make([]string, 0)
+	if namespacesArg, ok := params.GetArguments()["namespaces"]; ok && namespacesArg != nil {
+		items, ok := namespacesArg.([]interface{})
+		if !ok {
+			return api.NewToolCallResult("", errors.New("failed to aggregate warning events, namespaces is not an array")), nil
+		}
+		for _, item := range items {
+			if ns, ok := item.(string); ok && ns != "" {
+				namespaces = append(namespaces, ns)
+			}
+		}
+	}
+	warnings, skipped, err := params.EventsWarnings(params, namespaces)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to aggregate warning events: %v", err)), nil
+	}
+	ret := "# No warning events found"
+	if len(warnings) > 0 {
+		yamlWarnings, err := output.MarshalYaml(warnings)
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to aggregate warning events: %v", err)), nil
+		}
+		ret = "# The following warning events (YAML format) were found, grouped by namespace:\n" + yamlWarnings
+	}
+	return api.NewToolCallResult(withScanWarnings(ret, skipped), nil), nil
 }
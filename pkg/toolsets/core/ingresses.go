@@ -0,0 +1,55 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+func initIngresses() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "ingress_list",
+			Description: "List the Kubernetes Ingresses in the current cluster from the provided namespace or all namespaces, including their ingress class, host rules with the backend services they route to, TLS secrets, and load balancer addresses. Complements the OpenShift Routes on clusters exposing applications with both",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace to list the Ingresses from. If not provided, will list Ingresses from all namespaces",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Ingresses: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: ingressList},
+	}
+}
+
+func ingressList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	ns, ok := params.GetArguments()["namespace"].(string)
+	if !ok && params.GetArguments()["namespace"] != nil {
+		return api.NewToolCallResult("", fmt.Errorf("namespace is not a string")), nil
+	}
+	ingresses, err := params.IngressesList(params, ns)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list ingresses: %v", err)), nil
+	}
+	if len(ingresses) == 0 {
+		return api.NewToolCallResult("# No ingresses found", nil), nil
+	}
+	yamlIngresses, err := output.MarshalYaml(ingresses)
+	if err != nil {
+		err = fmt.Errorf("failed to list ingresses: %v", err)
+	}
+	return params.NewTruncatedToolCallResult(fmt.Sprintf("# The following ingresses (YAML format) were found:\n%s", yamlIngresses), err), nil
+}
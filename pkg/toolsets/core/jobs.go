@@ -0,0 +1,102 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+func initJobs() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "jobs_list",
+			Description: "List the Kubernetes Jobs in the current cluster from the provided namespace or all namespaces, including their status (Complete, Failed, Suspended, Running), completions, active/succeeded/failed Pod counts, and start and completion times",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace to list the Jobs from. If not provided, will list Jobs from all namespaces",
+					},
+					"failed_only": {
+						Type:        "boolean",
+						Description: "If true, only list the Jobs that have failed (Optional, false if not provided)",
+						Default:     api.ToRawMessage(false),
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Jobs: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: jobsList},
+		{Tool: api.Tool{
+			Name:        "cronjobs_list",
+			Description: "List the Kubernetes CronJobs in the current cluster from the provided namespace or all namespaces, including their schedule, suspend state, last schedule and last successful times, and active Jobs",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace to list the CronJobs from. If not provided, will list CronJobs from all namespaces",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "CronJobs: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: cronJobsList},
+	}
+}
+
+func jobsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	ns, ok := params.GetArguments()["namespace"].(string)
+	if !ok && params.GetArguments()["namespace"] != nil {
+		return api.NewToolCallResult("", fmt.Errorf("namespace is not a string")), nil
+	}
+	failedOnly, _ := params.GetArguments()["failed_only"].(bool)
+	jobMap, skipped, err := params.JobsList(params, ns, failedOnly)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list jobs: %v", err)), nil
+	}
+	if len(jobMap) == 0 && failedOnly {
+		return api.NewToolCallResult(withScanWarnings("# No failed jobs found", skipped), nil), nil
+	} else if len(jobMap) == 0 {
+		return api.NewToolCallResult(withScanWarnings("# No jobs found", skipped), nil), nil
+	}
+	yamlJobs, err := output.MarshalYaml(jobMap)
+	if err != nil {
+		err = fmt.Errorf("failed to list jobs: %v", err)
+	}
+	return params.NewTruncatedToolCallResult(withScanWarnings(fmt.Sprintf("# The following jobs (YAML format) were found:\n%s", yamlJobs), skipped), err), nil
+}
+
+func cronJobsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	ns, ok := params.GetArguments()["namespace"].(string)
+	if !ok && params.GetArguments()["namespace"] != nil {
+		return api.NewToolCallResult("", fmt.Errorf("namespace is not a string")), nil
+	}
+	cronJobMap, skipped, err := params.CronJobsList(params, ns)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list cronjobs: %v", err)), nil
+	}
+	if len(cronJobMap) == 0 {
+		return api.NewToolCallResult(withScanWarnings("# No cronjobs found", skipped), nil), nil
+	}
+	yamlCronJobs, err := output.MarshalYaml(cronJobMap)
+	if err != nil {
+		err = fmt.Errorf("failed to list cronjobs: %v", err)
+	}
+	return params.NewTruncatedToolCallResult(withScanWarnings(fmt.Sprintf("# The following cronjobs (YAML format) were found:\n%s", yamlCronJobs), skipped), err), nil
+}
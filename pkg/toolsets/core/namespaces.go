@@ -2,13 +2,18 @@ package core
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/google/jsonschema-go/jsonschema"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/ptr"
 
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
 	internalk8s "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
 )
 
 func initNamespaces(o internalk8s.Openshift) []api.ServerTool {
@@ -29,6 +34,147 @@ func initNamespaces(o internalk8s.Openshift) []api.ServerTool {
 			},
 		}, Handler: namespacesList,
 	})
+	ret = append(ret, api.ServerTool{
+		Tool: api.Tool{
+			Name:        "namespaces_inventory",
+			Description: "Summarize the contents of a Kubernetes namespace in the current cluster: the number of objects of each kind (Pods, Deployments, Services, ConfigMaps, Secrets, PersistentVolumeClaims, etc.) along with notable statuses (failing Pods, pending PersistentVolumeClaims). Useful to get an overview of a namespace before inspecting specific resources",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to summarize (Optional, current namespace if not provided)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Namespaces: Inventory",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: namespacesInventory,
+	})
+	ret = append(ret, api.ServerTool{
+		Tool: api.Tool{
+			Name:        "namespaces_stuck",
+			Description: "Report the Kubernetes namespaces stuck in Terminating phase in the current cluster, including their remaining finalizers, the resources blocking the deletion, and the commands to clear the finalizers manually (finalizers are never removed by this tool)",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Namespaces: Stuck",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: namespacesStuck,
+	})
+	ret = append(ret, api.ServerTool{
+		Tool: api.Tool{
+			Name:        "namespaces_compare",
+			Description: "Compare the objects of a set of kinds in two Kubernetes namespaces in the current cluster (e.g. staging and production) and report the drift: the objects that exist in only one of the namespaces and the objects whose specs differ (as a unified diff). Server-managed and namespace-specific fields are normalized out before comparing, Secret values are compared by a keyed hash (with a random key for each comparison) and never returned",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace_a": {
+						Type:        "string",
+						Description: "First namespace to compare (e.g. staging)",
+					},
+					"namespace_b": {
+						Type:        "string",
+						Description: "Second namespace to compare (e.g. production)",
+					},
+					"kinds": {
+						Type:        "array",
+						Description: "Optional list of kinds to compare (e.g. [{\"apiVersion\": \"v1\", \"kind\": \"ConfigMap\"}]). If not provided, will compare ConfigMaps, Secrets, Services, ServiceAccounts, PersistentVolumeClaims, Deployments, StatefulSets, DaemonSets, CronJobs, Ingresses, NetworkPolicies, Roles, and RoleBindings",
+						Items: &jsonschema.Schema{
+							Type: "object",
+							Properties: map[string]*jsonschema.Schema{
+								"apiVersion": {Type: "string", Description: "apiVersion of the kind (e.g. v1, apps/v1)"},
+								"kind":       {Type: "string", Description: "Kind to compare (e.g. ConfigMap, Deployment)"},
+							},
+							Required: []string{"apiVersion", "kind"},
+						},
+					},
+				},
+				Required: []string{"namespace_a", "namespace_b"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Namespaces: Compare",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: namespacesCompare,
+	})
+	ret = append(ret, api.ServerTool{
+		Tool: api.Tool{
+			Name:        "namespaces_create",
+			Description: "Create a new Kubernetes namespace in the current cluster, optionally along with a ResourceQuota and a LimitRange with container defaults. Returns the names of all the created objects",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the namespace to create",
+					},
+					"labels": {
+						Type:                 "object",
+						Description:          "Optional labels to add to the namespace (e.g. {\"team\": \"payments\"})",
+						AdditionalProperties: &jsonschema.Schema{Type: "string"},
+					},
+					"annotations": {
+						Type:                 "object",
+						Description:          "Optional annotations to add to the namespace",
+						AdditionalProperties: &jsonschema.Schema{Type: "string"},
+					},
+					"resource_quota": {
+						Type:        "object",
+						Description: "Optional hard limits of a ResourceQuota to create in the namespace",
+						Properties: map[string]*jsonschema.Schema{
+							"cpu":    {Type: "string", Description: "Total CPU requests limit (e.g. 4, 500m)"},
+							"memory": {Type: "string", Description: "Total memory requests limit (e.g. 8Gi)"},
+							"pods":   {Type: "string", Description: "Maximum number of pods (e.g. 20)"},
+						},
+					},
+					"limit_range": {
+						Type:        "object",
+						Description: "Optional container defaults of a LimitRange to create in the namespace",
+						Properties: map[string]*jsonschema.Schema{
+							"default": {
+								Type:        "object",
+								Description: "Default limits for containers that don't specify them",
+								Properties: map[string]*jsonschema.Schema{
+									"cpu":    {Type: "string", Description: "Default CPU limit (e.g. 500m)"},
+									"memory": {Type: "string", Description: "Default memory limit (e.g. 512Mi)"},
+								},
+							},
+							"default_request": {
+								Type:        "object",
+								Description: "Default requests for containers that don't specify them",
+								Properties: map[string]*jsonschema.Schema{
+									"cpu":    {Type: "string", Description: "Default CPU request (e.g. 100m)"},
+									"memory": {Type: "string", Description: "Default memory request (e.g. 128Mi)"},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Namespaces: Create",
+				ReadOnlyHint:    ptr.To(false),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: namespacesCreate,
+	})
 	if o.IsOpenShift(context.Background()) {
 		ret = append(ret, api.ServerTool{
 			Tool: api.Tool{
@@ -58,6 +204,128 @@ func namespacesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	return api.NewToolCallResult(params.ListOutput.PrintObj(ret)), nil
 }
 
+func namespacesCreate(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to create namespace, missing argument name")), nil
+	}
+	options := internalk8s.NamespacesCreateOptions{
+		Labels:        stringMap(params.GetArguments()["labels"]),
+		Annotations:   stringMap(params.GetArguments()["annotations"]),
+		ResourceQuota: resourceNameMap(params.GetArguments()["resource_quota"]),
+	}
+	if limitRange, ok := params.GetArguments()["limit_range"].(map[string]interface{}); ok {
+		options.LimitRange = &internalk8s.NamespacesCreateLimitRange{
+			Default:        resourceNameMap(limitRange["default"]),
+			DefaultRequest: resourceNameMap(limitRange["default_request"]),
+		}
+	}
+	created, err := params.NamespacesCreate(params, name, options)
+	if len(created) == 0 && err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create namespace %s: %v", name, err)), nil
+	}
+	if err != nil {
+		err = fmt.Errorf("failed to create namespace %s resources: %v", name, err)
+	}
+	return api.NewToolCallResult("# The following objects were created:\n"+strings.Join(created, "\n"), err), nil
+}
+
+// stringMap converts a JSON object argument into a map of strings (nil if not an object)
+func stringMap(arg interface{}) map[string]string {
+	obj, ok := arg.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	ret := make(map[string]string, len(obj))
+	for key, value := range obj {
+		ret[key] = fmt.Sprintf("%v", value)
+	}
+	return ret
+}
+
+// resourceNameMap converts a JSON object argument into a map of resource quantities (nil if not an object)
+func resourceNameMap(arg interface{}) map[v1.ResourceName]string {
+	obj := stringMap(arg)
+	if obj == nil {
+		return nil
+	}
+	ret := make(map[v1.ResourceName]string, len(obj))
+	for key, value := range obj {
+		ret[v1.ResourceName(key)] = value
+	}
+	return ret
+}
+
+func namespacesInventory(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	ns, _ := params.GetArguments()["namespace"].(string)
+	inventory, err := params.NamespacesInventory(params, ns)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get namespace inventory: %v", err)), nil
+	}
+	yamlInventory, err := output.MarshalYaml(inventory)
+	if err != nil {
+		err = fmt.Errorf("failed to get namespace inventory: %v", err)
+	}
+	return api.NewToolCallResult("# The following namespace inventory (YAML format) was found:\n"+yamlInventory, err), nil
+}
+
+func namespacesStuck(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	stuck, err := params.NamespacesStuck(params)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list stuck namespaces: %v", err)), nil
+	}
+	if len(stuck) == 0 {
+		return api.NewToolCallResult("# No namespaces stuck in Terminating phase found", nil), nil
+	}
+	yamlStuck, err := output.MarshalYaml(stuck)
+	if err != nil {
+		err = fmt.Errorf("failed to list stuck namespaces: %v", err)
+	}
+	return api.NewToolCallResult(fmt.Sprintf("# The following namespaces (YAML format) are stuck in Terminating phase.\n"+
+		"# Removing finalizers skips the cleanup performed by their controllers, make sure the blocking resources can be safely abandoned before running the clear commands:\n%s", yamlStuck), err), nil
+}
+
+func namespacesCompare(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	namespaceA, ok := params.GetArguments()["namespace_a"].(string)
+	if !ok || namespaceA == "" {
+		return api.NewToolCallResult("", errors.New("failed to compare namespaces, missing argument namespace_a")), nil
+	}
+	namespaceB, ok := params.GetArguments()["namespace_b"].(string)
+	if !ok || namespaceB == "" {
+		return api.NewToolCallResult("", errors.New("failed to compare namespaces, missing argument namespace_b")), nil
+	}
+	var gvks []schema.GroupVersionKind
+	if kindsArg, ok := params.GetArguments()["kinds"]; ok && kindsArg != nil {
+		items, ok := kindsArg.([]interface{})
+		if !ok {
+			return api.NewToolCallResult("", errors.New("failed to compare namespaces, kinds is not an array")), nil
+		}
+		for _, item := range items {
+			kind, ok := item.(map[string]interface{})
+			if !ok {
+				return api.NewToolCallResult("", errors.New("failed to compare namespaces, kinds items must be objects")), nil
+			}
+			gvk, err := parseGroupVersionKind(kind)
+			if err != nil {
+				return api.NewToolCallResult("", fmt.Errorf("failed to compare namespaces, %v", err)), nil
+			}
+			gvks = append(gvks, *gvk)
+		}
+	}
+	drift, err := params.NamespacesCompare(params, namespaceA, namespaceB, gvks)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to compare namespaces %s and %s: %v", namespaceA, namespaceB, err)), nil
+	}
+	if len(drift) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("# No drift found between namespaces %s and %s", namespaceA, namespaceB), nil), nil
+	}
+	yamlDrift, err := output.MarshalYaml(drift)
+	if err != nil {
+		err = fmt.Errorf("failed to compare namespaces %s and %s: %v", namespaceA, namespaceB, err)
+	}
+	return params.NewTruncatedToolCallResult(fmt.Sprintf("# The following drift (YAML format) was found between namespaces %s and %s:\n%s", namespaceA, namespaceB, yamlDrift), err), nil
+}
+
 func projectsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	ret, err := params.ProjectsList(params, internalk8s.ResourceListOptions{AsTable: params.ListOutput.AsTable()})
 	if err != nil {
@@ -0,0 +1,72 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+func initNetworkPolicies() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "networkpolicies_for_pod",
+			Description: "List the Kubernetes NetworkPolicies that apply to a Pod (selected by name or by labels) in the current or provided namespace, summarizing their ingress and egress rules. Reports whether the Pod is isolated for ingress and egress (only the traffic allowed by the rules of the matching policies is permitted). Useful to understand what traffic is allowed or denied for a Pod",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the Pod (Optional, current namespace if not provided)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the Pod (Optional, either name or labels must be provided)",
+					},
+					"labels": {
+						Type:        "string",
+						Description: "Labels of the Pod, useful for Pods that don't exist yet (e.g. 'app=myapp,tier=frontend') (Optional, only applicable when name is not provided)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "NetworkPolicies: For Pod",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: networkPoliciesForPod},
+	}
+}
+
+func networkPoliciesForPod(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	ns, _ := params.GetArguments()["namespace"].(string)
+	name, _ := params.GetArguments()["name"].(string)
+	labelsArg, _ := params.GetArguments()["labels"].(string)
+	if name == "" && labelsArg == "" {
+		return api.NewToolCallResult("", errors.New("failed to get network policies for pod, missing argument name or labels")), nil
+	}
+	var podLabels map[string]string
+	if name == "" {
+		l, err := labels.ConvertSelectorToLabelsMap(labelsArg)
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to get network policies for pod, invalid labels %s: %v", labelsArg, err)), nil
+		}
+		podLabels = l
+	}
+	ret, err := params.NetworkPoliciesForPod(params, ns, name, podLabels)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get network policies for pod: %v", err)), nil
+	}
+	yamlPolicies, err := output.MarshalYaml(ret)
+	if err != nil {
+		err = fmt.Errorf("failed to get network policies for pod: %v", err)
+	}
+	return api.NewToolCallResult("# The following network policies (YAML format) apply to the pod:\n"+yamlPolicies, err), nil
+}
@@ -3,15 +3,119 @@ package core
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/jsonschema-go/jsonschema"
 	"k8s.io/utils/ptr"
 
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
 )
 
 func initNodes() []api.ServerTool {
+	sortBy := make([]any, 0, len(kubernetes.NodesListSortBy))
+	for _, value := range kubernetes.NodesListSortBy {
+		sortBy = append(sortBy, value)
+	}
+	roles := make([]any, 0, len(kubernetes.NodesListRoles))
+	for _, role := range kubernetes.NodesListRoles {
+		roles = append(roles, role)
+	}
+	workloadKinds := make([]any, 0, len(kubernetes.WorkloadKinds))
+	for _, kind := range kubernetes.WorkloadKinds {
+		workloadKinds = append(workloadKinds, kind)
+	}
 	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "nodes_list",
+			Description: "List the Kubernetes nodes in the current cluster with their roles (derived from the node-role.kubernetes.io labels), ready condition, kubelet version, OS image, allocatable CPU and memory, and age. Nodes can be filtered by role and sorted by name, allocatable CPU or memory (largest first), or age (oldest first)",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"sort_by": {
+						Type:        "string",
+						Description: "Sort order of the nodes (Optional, name if not provided)",
+						Enum:        sortBy,
+					},
+					"role": {
+						Type:        "string",
+						Description: "Only list the nodes with the provided role (Optional, all nodes if not provided). Nodes without a control-plane role are considered workers",
+						Enum:        roles,
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Node: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: nodesList},
+		{Tool: api.Tool{
+			Name:        "nodes_taint_report",
+			Description: "List the taints of all the Kubernetes nodes in the current cluster and report the Pods of the current or provided namespace (or the Pods of the provided workload) that don't tolerate them, with the nodes each Pod is excluded from and the untolerated NoSchedule/NoExecute taints. Useful to explain why Pods never land on some nodes",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the Pods or workload to check (Optional, current namespace if not provided)",
+					},
+					"kind": {
+						Type:        "string",
+						Description: "Kind of the workload to check instead of the Pods of the namespace (Optional, requires name)",
+						Enum:        workloadKinds,
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the workload to check (Optional, requires kind)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Node: Taint Report",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: nodesTaintReport},
+		{Tool: api.Tool{
+			Name:        "nodes_debug",
+			Description: "Generate (or create if live is true) a privileged debug Pod pinned to a Kubernetes node, the equivalent of `oc debug node/<name>`. The Pod shares the host network, PID, and IPC namespaces, tolerates all the taints, and mounts the host root filesystem at /host. Returns the Pod manifest and the command to run commands on the host (chroot /host). By default only the manifest is generated for review, nothing is created",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the node to debug",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to create the debug Pod in (Optional, current namespace if not provided). The namespace must allow privileged Pods",
+					},
+					"image": {
+						Type:        "string",
+						Description: "Image of the debug Pod (Optional, " + kubernetes.DefaultNodesDebugImage + " if not provided)",
+					},
+					"live": {
+						Type:        "boolean",
+						Description: "Create the debug Pod in the cluster (Optional, false if not provided: only the manifest is generated)",
+						Default:     api.ToRawMessage(false),
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Node: Debug",
+				ReadOnlyHint:    ptr.To(false),
+				DestructiveHint: ptr.To(true),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: nodesDebug},
 		{Tool: api.Tool{
 			Name:        "nodes_log",
 			Description: "Get logs from a Kubernetes node (kubelet, kube-proxy, or other system logs). This accesses node logs through the Kubernetes API proxy to the kubelet",
@@ -64,7 +168,103 @@ func initNodes() []api.ServerTool {
 				OpenWorldHint:   ptr.To(true),
 			},
 		}, Handler: nodesStatsSummary},
+		{Tool: api.Tool{
+			Name:        "nodes_maintenance",
+			Description: "Cordon and drain, one node at a time, the Kubernetes nodes matching a label selector to prepare them for maintenance. Pods are evicted honoring PodDisruptionBudgets (DaemonSet-managed and mirror pods are skipped) and the next node is only processed once the evicted pods of the previous one have terminated. Stops at the first node that fails to drain, reporting the progress of every node. Refuses to start if more than max_unavailable nodes of the cluster would be unavailable (not ready or unschedulable) once the matching nodes are cordoned",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"labelSelector": {
+						Type:        "string",
+						Description: "Kubernetes label selector of the nodes to put in maintenance (e.g. 'node-role.kubernetes.io/worker=,zone=a')",
+						Pattern:     "([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]",
+					},
+					"max_unavailable": {
+						Type:        "integer",
+						Description: "Maximum number of nodes of the cluster that can be unavailable once the matching nodes are cordoned, including the nodes that are already not ready or unschedulable (Optional, 1 if not provided)",
+						Default:     api.ToRawMessage(1),
+						Minimum:     ptr.To(float64(1)),
+					},
+					"timeout_seconds": {
+						Type:        "integer",
+						Description: "Maximum time in seconds to wait for the evicted pods of each node to terminate (Optional, 300 if not provided)",
+						Minimum:     ptr.To(float64(1)),
+					},
+				},
+				Required: []string{"labelSelector"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Node: Maintenance",
+				ReadOnlyHint:    ptr.To(false),
+				DestructiveHint: ptr.To(true),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: nodesMaintenance},
+	}
+}
+
+func nodesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	options := kubernetes.NodesListOptions{}
+	options.SortBy, _ = params.GetArguments()["sort_by"].(string)
+	options.Role, _ = params.GetArguments()["role"].(string)
+	nodes, err := params.NodesList(params, options)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list nodes: %v", err)), nil
+	}
+	if len(nodes) == 0 {
+		return api.NewToolCallResult("# No nodes found", nil), nil
+	}
+	yamlNodes, err := output.MarshalYaml(nodes)
+	if err != nil {
+		err = fmt.Errorf("failed to list nodes: %v", err)
+	}
+	return params.NewTruncatedToolCallResult("# The following nodes (YAML format) were found:\n"+yamlNodes, err), nil
+}
+
+func nodesTaintReport(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	ns, _ := params.GetArguments()["namespace"].(string)
+	kind, _ := params.GetArguments()["kind"].(string)
+	name, _ := params.GetArguments()["name"].(string)
+	if (kind == "") != (name == "") {
+		return api.NewToolCallResult("", errors.New("failed to get node taint report, kind and name must be provided together")), nil
+	}
+	report, err := params.NodesTaintReport(params, ns, kind, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get node taint report: %v", err)), nil
 	}
+	yamlReport, err := output.MarshalYaml(report)
+	if err != nil {
+		err = fmt.Errorf("failed to get node taint report: %v", err)
+	}
+	// The report is a YAML map (not a list), truncated on line boundaries
+	return params.NewTruncatedTextToolCallResult("# The following node taint report (YAML format) was found:\n"+yamlReport, false, err), nil
+}
+
+func nodesDebug(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to debug node, missing argument name")), nil
+	}
+	ns, _ := params.GetArguments()["namespace"].(string)
+	image, _ := params.GetArguments()["image"].(string)
+	live, _ := params.GetArguments()["live"].(bool)
+	pod, err := params.NodesDebug(params, ns, name, image, live)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to debug node %s: %v", name, err)), nil
+	}
+	yamlPod, err := output.MarshalYaml(pod)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to debug node %s: %v", name, err)), nil
+	}
+	if !live {
+		return api.NewToolCallResult("# The following node debug Pod (YAML format) was generated, nothing was created.\n"+
+			"# Call nodes_debug with live=true to create it:\n"+yamlPod, nil), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("# The following node debug Pod (YAML format) was created.\n"+
+		"# Once running, run commands on the host with pods_exec (namespace: %[1]s, name: %[2]s, command: [\"chroot\", \"/host\", ...])\n"+
+		"# or interactively with: oc exec -it -n %[1]s %[2]s -- chroot /host\n"+
+		"# Delete the Pod with pods_delete once done:\n%[3]s", pod.GetNamespace(), pod.GetName(), yamlPod), nil), nil
 }
 
 func nodesLog(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
@@ -110,3 +310,33 @@ func nodesStatsSummary(params api.ToolHandlerParams) (*api.ToolCallResult, error
 	}
 	return api.NewToolCallResult(ret, nil), nil
 }
+
+func nodesMaintenance(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	labelSelector, ok := params.GetArguments()["labelSelector"].(string)
+	if !ok || labelSelector == "" {
+		return api.NewToolCallResult("", errors.New("failed to put nodes in maintenance, missing argument labelSelector")), nil
+	}
+	maxUnavailable, err := api.IntArgument(params.GetArguments(), "max_unavailable")
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to put nodes in maintenance, %v", err)), nil
+	} else if maxUnavailable <= 0 {
+		maxUnavailable = 1
+	}
+	timeout, err := api.IntArgument(params.GetArguments(), "timeout_seconds")
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to put nodes in maintenance, %v", err)), nil
+	}
+	ret, completed, err := params.NodesMaintenance(params, labelSelector, int(maxUnavailable), time.Duration(timeout)*time.Second)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to put nodes in maintenance: %v", err)), nil
+	}
+	header := "# The following nodes (YAML format) were cordoned and drained:\n"
+	if !completed {
+		header = "# Node maintenance stopped after a failure, the following is the progress (YAML format) of the nodes:\n"
+	}
+	yamlProgress, err := output.MarshalYaml(ret)
+	if err != nil {
+		err = fmt.Errorf("failed to put nodes in maintenance: %v", err)
+	}
+	return api.NewToolCallResult(header+yamlProgress, err), nil
+}
@@ -0,0 +1,64 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	internalk8s "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+func initOLM(o internalk8s.Openshift) []api.ServerTool {
+	if !o.IsOpenShift(context.Background()) {
+		return []api.ServerTool{}
+	}
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "csv_get",
+			Description: "Get a summary of an OLM operator ClusterServiceVersion (operators.coreos.com/v1alpha1) in the current cluster: its phase, version, supported install modes, the CRDs and APIServices it owns and requires, and the Deployments it manages. Useful to understand what an installed operator actually provides",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace where the operator is installed (Optional, current namespace if not provided)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the ClusterServiceVersion (e.g. etcdoperator.v0.9.4) or display name of the operator (e.g. etcd)",
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "ClusterServiceVersion: Get",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: csvGet},
+	}
+}
+
+func csvGet(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	ns, _ := params.GetArguments()["namespace"].(string)
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to get cluster service version, missing argument name")), nil
+	}
+	csv, err := params.ClusterServiceVersionGet(params, ns, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get cluster service version %s: %v", name, err)), nil
+	}
+	yamlCSV, err := output.MarshalYaml(csv)
+	if err != nil {
+		err = fmt.Errorf("failed to get cluster service version %s: %v", name, err)
+	}
+	return api.NewToolCallResult("# The following cluster service version (YAML format) was found:\n"+yamlCSV, err), nil
+}
@@ -0,0 +1,93 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+func initPersistentVolumes() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "pvc_list",
+			Description: "List the Kubernetes PersistentVolumeClaims in the current cluster from the provided namespace or all namespaces, including their phase, requested and bound capacity, storage class, access modes, and bound PersistentVolume name",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace to list the PersistentVolumeClaims from. If not provided, will list PersistentVolumeClaims from all namespaces",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "PersistentVolumeClaims: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: pvcList},
+		{Tool: api.Tool{
+			Name:        "pv_get",
+			Description: "Get a Kubernetes PersistentVolume in the current cluster by name, including its capacity, reclaim policy, bound claim, and underlying volume source",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the PersistentVolume (e.g. the VolumeName reported by pvc_list)",
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "PersistentVolumes: Get",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: pvGet},
+	}
+}
+
+func pvcList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	namespace := params.GetArguments()["namespace"]
+	if namespace == nil {
+		namespace = ""
+	}
+	ns, ok := namespace.(string)
+	if !ok {
+		return api.NewToolCallResult("", fmt.Errorf("namespace is not a string")), nil
+	}
+	pvcMap, err := params.PersistentVolumeClaimsList(params, ns)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list persistent volume claims: %v", err)), nil
+	}
+	if len(pvcMap) == 0 {
+		return api.NewToolCallResult("# No persistent volume claims found", nil), nil
+	}
+	yamlPvcs, err := output.MarshalYaml(pvcMap)
+	if err != nil {
+		err = fmt.Errorf("failed to list persistent volume claims: %v", err)
+	}
+	return api.NewToolCallResult(fmt.Sprintf("# The following persistent volume claims (YAML format) were found:\n%s", yamlPvcs), err), nil
+}
+
+func pvGet(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to get persistent volume, missing argument name")), nil
+	}
+	pv, err := params.PersistentVolumesGet(params, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get persistent volume %s: %v", name, err)), nil
+	}
+	return api.NewToolCallResult(output.MarshalYaml(pv)), nil
+}
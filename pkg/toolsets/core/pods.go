@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/jsonschema-go/jsonschema"
 	"k8s.io/kubectl/pkg/metricsutil"
@@ -14,6 +15,8 @@ import (
 	"github.com/containers/kubernetes-mcp-server/pkg/output"
 )
 
+const defaultPodsResourcePressureThresholdPercent = 150
+
 func initPods() []api.ServerTool {
 	return []api.ServerTool{
 		{Tool: api.Tool{
@@ -21,13 +24,13 @@ func initPods() []api.ServerTool {
 			Description: "List all the Kubernetes pods in the current cluster from all namespaces",
 			InputSchema: &jsonschema.Schema{
 				Type: "object",
-				Properties: map[string]*jsonschema.Schema{
+				Properties: withOutputFormat(withPagination(map[string]*jsonschema.Schema{
 					"labelSelector": {
 						Type:        "string",
 						Description: "Optional Kubernetes label selector (e.g. 'app=myapp,env=prod' or 'app in (myapp,yourapp)'), use this option when you want to filter the pods by label",
 						Pattern:     "([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]",
 					},
-				},
+				})),
 			},
 			Annotations: api.ToolAnnotations{
 				Title:           "Pods: List",
@@ -42,7 +45,7 @@ func initPods() []api.ServerTool {
 			Description: "List all the Kubernetes pods in the specified namespace in the current cluster",
 			InputSchema: &jsonschema.Schema{
 				Type: "object",
-				Properties: map[string]*jsonschema.Schema{
+				Properties: withOutputFormat(withPagination(map[string]*jsonschema.Schema{
 					"namespace": {
 						Type:        "string",
 						Description: "Namespace to list pods from",
@@ -52,7 +55,7 @@ func initPods() []api.ServerTool {
 						Description: "Optional Kubernetes label selector (e.g. 'app=myapp,env=prod' or 'app in (myapp,yourapp)'), use this option when you want to filter the pods by label",
 						Pattern:     "([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]",
 					},
-				},
+				})),
 				Required: []string{"namespace"},
 			},
 			Annotations: api.ToolAnnotations{
@@ -113,6 +116,31 @@ func initPods() []api.ServerTool {
 				OpenWorldHint:   ptr.To(true),
 			},
 		}, Handler: podsDelete},
+		{Tool: api.Tool{
+			Name:        "pods_evict",
+			Description: "Evict a Kubernetes Pod in the current or provided namespace with the provided name using the Eviction API. Unlike pods_delete, the eviction honors the PodDisruptionBudgets of the Pod and is rejected if it would violate them (the blocking PodDisruptionBudgets are reported). This is the safe way to remove a Pod from a Node",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to evict the Pod from",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the Pod to evict",
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Pods: Evict",
+				ReadOnlyHint:    ptr.To(false),
+				DestructiveHint: ptr.To(true),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: podsEvict},
 		{Tool: api.Tool{
 			Name:        "pods_top",
 			Description: "List the resource consumption (CPU and memory) as recorded by the Kubernetes Metrics Server for the specified Kubernetes Pods in the all namespaces, the provided namespace, or the current namespace",
@@ -147,6 +175,147 @@ func initPods() []api.ServerTool {
 				OpenWorldHint:   ptr.To(true),
 			},
 		}, Handler: podsTop},
+		{Tool: api.Tool{
+			Name:        "pods_resource_pressure",
+			Description: "List the containers of the Kubernetes Pods in all namespaces or the provided namespace whose live CPU or memory usage (as recorded by the Kubernetes Metrics Server) is well above their requests (at least threshold_percent of the request) or near their limits (at least 90% of the limit), sorted by the highest usage percentage. Useful to find under-provisioned, CPU throttled, or about to be OOM killed workloads",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to inspect the Pods from (Optional, all namespaces if not provided)",
+					},
+					"threshold_percent": {
+						Type:        "integer",
+						Description: fmt.Sprintf("Usage percentage of the request above which a container is reported (Optional, default: %d)", defaultPodsResourcePressureThresholdPercent),
+						Default:     api.ToRawMessage(defaultPodsResourcePressureThresholdPercent),
+						Minimum:     ptr.To(float64(1)),
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Pods: Resource Pressure",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: podsResourcePressure},
+		{Tool: api.Tool{
+			Name:        "pods_restarts",
+			Description: "List the Kubernetes Pods whose containers have restarted in all namespaces or the provided namespace, sorted by total restart count, including the last termination reason of each container (e.g. OOMKilled, Error). Useful to find crash-looping workloads",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to list the restarted Pods from (Optional, all namespaces if not provided)",
+					},
+					"min_restarts": {
+						Type:        "integer",
+						Description: "Minimum total number of container restarts for a Pod to be reported (Optional, 1 if not provided)",
+						Minimum:     ptr.To(float64(1)),
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Pods: Restarts",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: podsRestarts},
+		{Tool: api.Tool{
+			Name:        "pods_diagnose",
+			Description: "Diagnose a failing Kubernetes Pod in the current or provided namespace. Returns a consolidated view with the Pod phase, the state and last state of each container (including exit codes and reasons such as OOMKilled or CrashLoopBackOff), the readiness, liveness, and startup probe configuration, and the most recent Warning events of the Pod",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the Pod to diagnose (Optional, current namespace if not provided)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the Pod to diagnose",
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Pods: Diagnose",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: podsDiagnose},
+		{Tool: api.Tool{
+			Name:        "pods_why_pending",
+			Description: "Explain why a Pending Kubernetes Pod in the current or provided namespace can't be scheduled. Cross-references the scheduler's reasons (PodScheduled condition and FailedScheduling events) with the Nodes capacity, taints, and labels to report, for each Node, the constraints blocking the Pod (untolerated taints, node selector, required node affinity, insufficient cpu/memory, cordoned or not ready Node) and which constraint to relax",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the Pending Pod (Optional, current namespace if not provided)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the Pending Pod",
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Pods: Why Pending",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: podsWhyPending},
+		{Tool: api.Tool{
+			Name:        "pods_imagepull_errors",
+			Description: "List the container images that fail to be pulled (ImagePullBackOff, ErrImagePull) by the Kubernetes Pods in all namespaces or the provided namespace. Results are grouped by image so that a broken image used by many Pods is reported once, including the registry, the waiting reason and message, whether the registry requires authentication, and the affected containers",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to scan for image pull errors (Optional, all namespaces if not provided)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Pods: Image Pull Errors",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: podsImagePullErrors},
+		{Tool: api.Tool{
+			Name:        "pods_not_ready",
+			Description: "List the Kubernetes Pods in all namespaces or the provided namespace that are Running but not Ready (not serving traffic), including for how long they've been not ready, the containers that are not ready with their readiness probes, and the readiness gates that are not satisfied",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to scan for not ready Pods (Optional, all namespaces if not provided)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Pods: Not Ready",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: podsNotReady},
 		{Tool: api.Tool{
 			Name:        "pods_exec",
 			Description: "Execute a command in a Kubernetes Pod in the current or provided namespace with the provided name and command",
@@ -183,6 +352,60 @@ func initPods() []api.ServerTool {
 				OpenWorldHint:   ptr.To(true),
 			},
 		}, Handler: podsExec},
+		{Tool: api.Tool{
+			Name:        "pods_probe",
+			Description: "Check the network connectivity from a Kubernetes Pod in the current or provided namespace to the provided host and port (TCP connection or HTTP request using the Pod network). Returns whether the target is reachable and the latency. Useful to diagnose in-cluster connectivity issues (Services, NetworkPolicies, DNS) without port-forwarding",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the Pod to run the check from (Optional, current namespace if not provided)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the Pod to run the check from",
+					},
+					"container": {
+						Type:        "string",
+						Description: "Name of the Pod container to run the check from (Optional, first container if not provided)",
+					},
+					"host": {
+						Type:        "string",
+						Description: "Target host name or IP address to check (e.g. my-service.my-namespace.svc, 10.0.0.12)",
+					},
+					"port": {
+						Type:        "integer",
+						Description: "Target port to check",
+						Minimum:     ptr.To(float64(1)),
+						Maximum:     ptr.To(float64(65535)),
+					},
+					"protocol": {
+						Type:        "string",
+						Description: "Protocol of the check: tcp to open a connection, http to perform a GET request (Optional, tcp if not provided)",
+						Enum:        []any{"tcp", "http"},
+					},
+					"path": {
+						Type:        "string",
+						Description: "Path of the http request (Optional, / if not provided, only applicable to http protocol)",
+					},
+					"timeout_seconds": {
+						Type:        "integer",
+						Description: "Maximum time in seconds to wait for the target to respond (Optional, 5 if not provided)",
+						Minimum:     ptr.To(float64(1)),
+						Maximum:     ptr.To(float64(60)),
+					},
+				},
+				Required: []string{"name", "host", "port"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Pods: Probe",
+				ReadOnlyHint:    ptr.To(false), // Runs the check with pods/exec, must not be available in read-only mode
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: podsProbe},
 		{Tool: api.Tool{
 			Name:        "pods_log",
 			Description: "Get the logs of a Kubernetes Pod in the current or provided namespace with the provided name",
@@ -260,17 +483,24 @@ func initPods() []api.ServerTool {
 
 func podsListInAllNamespaces(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	labelSelector := params.GetArguments()["labelSelector"]
+	listOutput, err := parseOutputFormat(params.GetArguments(), params.ListOutput)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list pods, %s", err)), nil
+	}
 	resourceListOptions := kubernetes.ResourceListOptions{
-		AsTable: params.ListOutput.AsTable(),
+		AsTable: listOutput.AsTable(),
 	}
 	if labelSelector != nil {
 		resourceListOptions.LabelSelector = labelSelector.(string)
 	}
+	if err := parsePagination(params.GetArguments(), &resourceListOptions); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list pods, %s", err)), nil
+	}
 	ret, err := params.PodsListInAllNamespaces(params, resourceListOptions)
 	if err != nil {
 		return api.NewToolCallResult("", fmt.Errorf("failed to list pods in all namespaces: %v", err)), nil
 	}
-	return api.NewToolCallResult(params.ListOutput.PrintObj(ret)), nil
+	return printPage(params, listOutput, ret), nil
 }
 
 func podsListInNamespace(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
@@ -278,18 +508,25 @@ func podsListInNamespace(params api.ToolHandlerParams) (*api.ToolCallResult, err
 	if ns == nil {
 		return api.NewToolCallResult("", errors.New("failed to list pods in namespace, missing argument namespace")), nil
 	}
+	listOutput, err := parseOutputFormat(params.GetArguments(), params.ListOutput)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list pods, %s", err)), nil
+	}
 	resourceListOptions := kubernetes.ResourceListOptions{
-		AsTable: params.ListOutput.AsTable(),
+		AsTable: listOutput.AsTable(),
 	}
 	labelSelector := params.GetArguments()["labelSelector"]
 	if labelSelector != nil {
 		resourceListOptions.LabelSelector = labelSelector.(string)
 	}
+	if err := parsePagination(params.GetArguments(), &resourceListOptions); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list pods, %s", err)), nil
+	}
 	ret, err := params.PodsListInNamespace(params, ns.(string), resourceListOptions)
 	if err != nil {
 		return api.NewToolCallResult("", fmt.Errorf("failed to list pods in namespace %s: %v", ns, err)), nil
 	}
-	return api.NewToolCallResult(params.ListOutput.PrintObj(ret)), nil
+	return printPage(params, listOutput, ret), nil
 }
 
 func podsGet(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
@@ -324,6 +561,26 @@ func podsDelete(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	return api.NewToolCallResult(ret, err), nil
 }
 
+func podsEvict(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	ns, _ := params.GetArguments()["namespace"].(string)
+	name, _ := params.GetArguments()["name"].(string)
+	if name == "" {
+		return api.NewToolCallResult("", errors.New("failed to evict pod, missing argument name")), nil
+	}
+	ret, err := params.PodsEvict(params, ns, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to evict pod %s in namespace %s: %v", name, ns, err)), nil
+	}
+	yamlEviction, err := output.MarshalYaml(ret)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to evict pod %s in namespace %s: %v", name, ns, err)), nil
+	}
+	if evicted, _ := ret["Evicted"].(bool); evicted {
+		return api.NewToolCallResult("# The pod was evicted (YAML format):\n"+yamlEviction, nil), nil
+	}
+	return api.NewToolCallResult("# The pod eviction was blocked by a PodDisruptionBudget (YAML format):\n"+yamlEviction, nil), nil
+}
+
 func podsTop(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	podsTopOptions := kubernetes.PodsTopOptions{AllNamespaces: true}
 	if v, ok := params.GetArguments()["namespace"].(string); ok {
@@ -351,6 +608,118 @@ func podsTop(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	return api.NewToolCallResult(buf.String(), nil), nil
 }
 
+func podsResourcePressure(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	ns, _ := params.GetArguments()["namespace"].(string)
+	thresholdPercent := int64(defaultPodsResourcePressureThresholdPercent)
+	if _, ok := params.GetArguments()["threshold_percent"]; ok {
+		var err error
+		if thresholdPercent, err = api.IntArgument(params.GetArguments(), "threshold_percent"); err != nil {
+			return api.NewToolCallResult("", err), nil
+		}
+	}
+	ret, skipped, err := params.PodsResourcePressure(params, ns, int(thresholdPercent))
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get pods resource pressure: %v", err)), nil
+	}
+	if len(ret) == 0 {
+		return api.NewToolCallResult(withScanWarnings(fmt.Sprintf("# No containers using at least %d%% of their requests or near their limits found", thresholdPercent), skipped), nil), nil
+	}
+	yamlPressure, err := output.MarshalYaml(ret)
+	if err != nil {
+		err = fmt.Errorf("failed to get pods resource pressure: %v", err)
+	}
+	return params.NewTruncatedToolCallResult(withScanWarnings(fmt.Sprintf("# The following containers (YAML format) are using at least %d%% of their requests or near their limits:\n%s", thresholdPercent, yamlPressure), skipped), err), nil
+}
+
+func podsRestarts(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	ns, _ := params.GetArguments()["namespace"].(string)
+	minRestarts := int64(1)
+	if _, ok := params.GetArguments()["min_restarts"]; ok {
+		var err error
+		if minRestarts, err = api.IntArgument(params.GetArguments(), "min_restarts"); err != nil {
+			return api.NewToolCallResult("", err), nil
+		}
+	}
+	ret, skipped, err := params.PodsRestarts(params, ns, int32(minRestarts))
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list restarted pods: %v", err)), nil
+	}
+	if len(ret) == 0 {
+		return api.NewToolCallResult(withScanWarnings(fmt.Sprintf("# No pods with at least %d container restarts found", minRestarts), skipped), nil), nil
+	}
+	yamlRestarts, err := output.MarshalYaml(ret)
+	if err != nil {
+		err = fmt.Errorf("failed to list restarted pods: %v", err)
+	}
+	return api.NewToolCallResult(withScanWarnings(fmt.Sprintf("# The following pods (YAML format) have restarted containers, sorted by total restart count:\n%s", yamlRestarts), skipped), err), nil
+}
+
+func podsDiagnose(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	ns, _ := params.GetArguments()["namespace"].(string)
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to diagnose pod, missing argument name")), nil
+	}
+	ret, err := params.PodsDiagnose(params, ns, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to diagnose pod %s in namespace %s: %v", name, ns, err)), nil
+	}
+	yamlDiagnosis, err := output.MarshalYaml(ret)
+	if err != nil {
+		err = fmt.Errorf("failed to diagnose pod %s in namespace %s: %v", name, ns, err)
+	}
+	return api.NewToolCallResult("# The following Pod diagnosis (YAML format) was obtained:\n"+yamlDiagnosis, err), nil
+}
+
+func podsWhyPending(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	ns, _ := params.GetArguments()["namespace"].(string)
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to explain pending pod, missing argument name")), nil
+	}
+	ret, err := params.PodsWhyPending(params, ns, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to explain pending pod %s in namespace %s: %v", name, ns, err)), nil
+	}
+	yamlExplanation, err := output.MarshalYaml(ret)
+	if err != nil {
+		err = fmt.Errorf("failed to explain pending pod %s in namespace %s: %v", name, ns, err)
+	}
+	return api.NewToolCallResult("# The following scheduling explanation (YAML format) was obtained:\n"+yamlExplanation, err), nil
+}
+
+func podsImagePullErrors(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	ns, _ := params.GetArguments()["namespace"].(string)
+	ret, skipped, err := params.PodsImagePullErrors(params, ns)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list image pull errors: %v", err)), nil
+	}
+	if len(ret) == 0 {
+		return api.NewToolCallResult(withScanWarnings("# No image pull errors found", skipped), nil), nil
+	}
+	yamlErrors, err := output.MarshalYaml(ret)
+	if err != nil {
+		err = fmt.Errorf("failed to list image pull errors: %v", err)
+	}
+	return api.NewToolCallResult(withScanWarnings("# The following image pull errors (YAML format) were found:\n"+yamlErrors, skipped), err), nil
+}
+
+func podsNotReady(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	ns, _ := params.GetArguments()["namespace"].(string)
+	ret, skipped, err := params.PodsNotReady(params, ns)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list not ready pods: %v", err)), nil
+	}
+	if len(ret) == 0 {
+		return api.NewToolCallResult(withScanWarnings("# No running but not ready pods found", skipped), nil), nil
+	}
+	yamlPods, err := output.MarshalYaml(ret)
+	if err != nil {
+		err = fmt.Errorf("failed to list not ready pods: %v", err)
+	}
+	return params.NewTruncatedToolCallResult(withScanWarnings("# The following running but not ready pods (YAML format) were found:\n"+yamlPods, skipped), err), nil
+}
+
 func podsExec(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	ns := params.GetArguments()["namespace"]
 	if ns == nil {
@@ -384,6 +753,38 @@ func podsExec(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	return api.NewToolCallResult(ret, err), nil
 }
 
+func podsProbe(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	ns, _ := params.GetArguments()["namespace"].(string)
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to probe from pod, missing argument name")), nil
+	}
+	options := kubernetes.PodsProbeOptions{}
+	options.Host, _ = params.GetArguments()["host"].(string)
+	options.Container, _ = params.GetArguments()["container"].(string)
+	options.Protocol, _ = params.GetArguments()["protocol"].(string)
+	options.Path, _ = params.GetArguments()["path"].(string)
+	port, err := api.IntArgument(params.GetArguments(), "port")
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to probe from pod, %v", err)), nil
+	}
+	options.Port = int(port)
+	timeout, err := api.IntArgument(params.GetArguments(), "timeout_seconds")
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to probe from pod, %v", err)), nil
+	}
+	options.Timeout = time.Duration(timeout) * time.Second
+	ret, err := params.PodsProbe(params, ns, name, options)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to probe from pod %s in namespace %s: %v", name, ns, err)), nil
+	}
+	yamlProbe, err := output.MarshalYaml(ret)
+	if err != nil {
+		err = fmt.Errorf("failed to probe from pod %s in namespace %s: %v", name, ns, err)
+	}
+	return api.NewToolCallResult("# The following connectivity check result (YAML format) was obtained:\n"+yamlProbe, err), nil
+}
+
 func podsLog(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	ns := params.GetArguments()["namespace"]
 	if ns == nil {
@@ -0,0 +1,106 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	internalk8s "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+func initRBAC() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "rbac_can_i",
+			Description: "Check whether an action (verb) on a Kubernetes resource is allowed for the current identity, or for the provided user and/or groups (requires permission to create SubjectAccessReviews). Useful to verify that an action can be performed before attempting it, avoiding Forbidden errors",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"verb": {
+						Type:        "string",
+						Description: "Verb of the action to check (e.g. get, list, watch, create, update, patch, delete)",
+					},
+					"apiVersion": {
+						Type:        "string",
+						Description: "apiVersion of the resource (examples of valid apiVersion are: v1, apps/v1, networking.k8s.io/v1)",
+					},
+					"kind": {
+						Type:        "string",
+						Description: "kind of the resource (examples of valid kind are: Pod, Service, Deployment, Ingress)",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace to check the action in. If not provided, will check the action in all namespaces (or for cluster-scoped resources)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Optional name of the resource to check the action for. If not provided, will check the action for all resources of the provided kind",
+					},
+					"subresource": {
+						Type:        "string",
+						Description: "Optional subresource to check the action for (e.g. log, exec, status, scale)",
+					},
+					"user": {
+						Type:        "string",
+						Description: "Optional user to check the action for (e.g. jane or system:serviceaccount:my-namespace:my-sa). If neither user nor groups are provided, will check the action for the current identity",
+					},
+					"groups": {
+						Type:        "array",
+						Description: "Optional list of groups to check the action for (e.g. [\"system:authenticated\"])",
+						Items: &jsonschema.Schema{
+							Type: "string",
+						},
+					},
+				},
+				Required: []string{"verb", "apiVersion", "kind"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "RBAC: Can I",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: rbacCanI},
+	}
+}
+
+func rbacCanI(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	options := internalk8s.CanIOptions{}
+	options.Verb, _ = params.GetArguments()["verb"].(string)
+	if options.Verb == "" {
+		return api.NewToolCallResult("", errors.New("failed to check access, missing argument verb")), nil
+	}
+	gvk, err := parseGroupVersionKind(params.GetArguments())
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to check access, %s", err)), nil
+	}
+	options.Namespace, _ = params.GetArguments()["namespace"].(string)
+	options.Name, _ = params.GetArguments()["name"].(string)
+	options.Subresource, _ = params.GetArguments()["subresource"].(string)
+	options.User, _ = params.GetArguments()["user"].(string)
+	if groupsArg, ok := params.GetArguments()["groups"]; ok && groupsArg != nil {
+		items, ok := groupsArg.([]interface{})
+		if !ok {
+			return api.NewToolCallResult("", errors.New("failed to check access, groups is not an array")), nil
+		}
+		for _, item := range items {
+			if group, ok := item.(string); ok && group != "" {
+				options.Groups = append(options.Groups, group)
+			}
+		}
+	}
+	ret, err := params.CanI(params, gvk, options)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to check access: %v", err)), nil
+	}
+	yamlResult, err := output.MarshalYaml(ret)
+	if err != nil {
+		err = fmt.Errorf("failed to check access: %v", err)
+	}
+	return api.NewToolCallResult("# The following is the result (YAML format) of the access review:\n"+yamlResult, err), nil
+}
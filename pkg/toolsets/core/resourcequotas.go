@@ -0,0 +1,52 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+func initResourceQuotas() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "resourcequotas_list",
+			Description: "List the Kubernetes ResourceQuotas of the current or provided namespace, including for each resource the used and hard values and the percentage consumed. Resources at or over 90% of their hard limit are flagged with NearLimit. Useful to explain \"exceeded quota\" errors when creating Pods or other resources",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to list the ResourceQuotas from (Optional, current namespace if not provided)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "ResourceQuotas: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: resourceQuotasList},
+	}
+}
+
+func resourceQuotasList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	ns, _ := params.GetArguments()["namespace"].(string)
+	quotas, err := params.ResourceQuotasList(params, ns)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list resource quotas: %v", err)), nil
+	}
+	if len(quotas) == 0 {
+		return api.NewToolCallResult("# No resource quotas found", nil), nil
+	}
+	yamlQuotas, err := output.MarshalYaml(quotas)
+	if err != nil {
+		err = fmt.Errorf("failed to list resource quotas: %v", err)
+	}
+	return api.NewToolCallResult(fmt.Sprintf("# The following resource quotas (YAML format) were found:\n%s", yamlQuotas), err), nil
+}
@@ -4,9 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
@@ -14,6 +19,12 @@ import (
 	"github.com/containers/kubernetes-mcp-server/pkg/output"
 )
 
+var patchTypes = map[string]types.PatchType{
+	"strategic": types.StrategicMergePatchType,
+	"merge":     types.MergePatchType,
+	"json":      types.JSONPatchType,
+}
+
 func initResources(o internalk8s.Openshift) []api.ServerTool {
 	commonApiVersion := "v1 Pod, v1 Service, v1 Node, apps/v1 Deployment, networking.k8s.io/v1 Ingress"
 	if o.IsOpenShift(context.Background()) {
@@ -26,7 +37,7 @@ func initResources(o internalk8s.Openshift) []api.ServerTool {
 			Description: "List Kubernetes resources and objects in the current cluster by providing their apiVersion and kind and optionally the namespace and label selector\n" + commonApiVersion,
 			InputSchema: &jsonschema.Schema{
 				Type: "object",
-				Properties: map[string]*jsonschema.Schema{
+				Properties: withOutputFormat(withPagination(map[string]*jsonschema.Schema{
 					"apiVersion": {
 						Type:        "string",
 						Description: "apiVersion of the resources (examples of valid apiVersion are: v1, apps/v1, networking.k8s.io/v1)",
@@ -41,10 +52,14 @@ func initResources(o internalk8s.Openshift) []api.ServerTool {
 					},
 					"labelSelector": {
 						Type:        "string",
-						Description: "Optional Kubernetes label selector (e.g. 'app=myapp,env=prod' or 'app in (myapp,yourapp)'), use this option when you want to filter the pods by label",
+						Description: "Optional Kubernetes label selector (e.g. 'app=myapp,env=prod' or 'app in (myapp,yourapp)'), use this option when you want to filter the resources by label. Can be combined with an empty namespace to find the matching resources across all namespaces",
 						Pattern:     "([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]",
 					},
-				},
+					"columns": {
+						Type:        "string",
+						Description: "Optional comma-separated list of JSONPath expressions to project (like `kubectl -o custom-columns`), each one optionally prefixed with a header (e.g. '.metadata.name,.status.phase' or 'NAME:.metadata.name,NODE:.spec.nodeName'). Use this option to get a compact table with only the requested fields instead of the full resources",
+					},
+				})),
 				Required: []string{"apiVersion", "kind"},
 			},
 			Annotations: api.ToolAnnotations{
@@ -88,6 +103,192 @@ func initResources(o internalk8s.Openshift) []api.ServerTool {
 				OpenWorldHint:   ptr.To(true),
 			},
 		}, Handler: resourcesGet},
+		{Tool: api.Tool{
+			Name:        "resources_describe",
+			Description: "Describe a Kubernetes resource in the current cluster (like `kubectl describe`) by providing its apiVersion, kind, optionally the namespace, and its name. Returns a human readable summary of its metadata, spec, status conditions, and its most recent events. Often more useful than the raw YAML to understand the state of a resource. Secret values are never returned\n" + commonApiVersion,
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"apiVersion": {
+						Type:        "string",
+						Description: "apiVersion of the resource (examples of valid apiVersion are: v1, apps/v1, networking.k8s.io/v1)",
+					},
+					"kind": {
+						Type:        "string",
+						Description: "kind of the resource (examples of valid kind are: Pod, Service, Deployment, Ingress)",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace to retrieve the namespaced resource from (ignored in case of cluster scoped resources). If not provided, will get resource from configured namespace",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the resource",
+					},
+				},
+				Required: []string{"apiVersion", "kind", "name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Resources: Describe",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: resourcesDescribe},
+		{Tool: api.Tool{
+			Name:        "resources_export",
+			Description: "Export a Kubernetes resource in the current cluster as a clean manifest that can be re-applied elsewhere (like the former `kubectl get -o yaml --export`) by providing its apiVersion, kind, optionally the namespace, and its name. Server-managed fields (status, uid, resourceVersion, managedFields, creationTimestamp, etc.) and fields set by the cluster (e.g. Pod service account token volumes, Service cluster IPs) are removed\n" + commonApiVersion,
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"apiVersion": {
+						Type:        "string",
+						Description: "apiVersion of the resource (examples of valid apiVersion are: v1, apps/v1, networking.k8s.io/v1)",
+					},
+					"kind": {
+						Type:        "string",
+						Description: "kind of the resource (examples of valid kind are: Pod, Service, Deployment, Ingress)",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace to retrieve the namespaced resource from (ignored in case of cluster scoped resources). If not provided, will get resource from configured namespace",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the resource",
+					},
+				},
+				Required: []string{"apiVersion", "kind", "name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Resources: Export",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: resourcesExport},
+		{Tool: api.Tool{
+			Name:        "resources_owners",
+			Description: "Get the ownership chain of a Kubernetes resource in the current cluster by providing its apiVersion, kind, optionally the namespace, and its name. Walks the ownerReferences of the resource up to its root owner (e.g. Pod -> ReplicaSet -> Deployment), owners that can't be retrieved are reported as missing. Optionally lists the workloads and Pods controlled by the resource. Useful to understand what controls a resource before changing or deleting it\n" + commonApiVersion,
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"apiVersion": {
+						Type:        "string",
+						Description: "apiVersion of the resource (examples of valid apiVersion are: v1, apps/v1, networking.k8s.io/v1)",
+					},
+					"kind": {
+						Type:        "string",
+						Description: "kind of the resource (examples of valid kind are: Pod, Service, Deployment, Ingress)",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace to retrieve the namespaced resource from (ignored in case of cluster scoped resources). If not provided, will get resource from configured namespace",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the resource",
+					},
+					"children": {
+						Type:        "boolean",
+						Description: "List the workloads and Pods controlled by the resource, recursively (Optional, false if not provided)",
+						Default:     api.ToRawMessage(false),
+					},
+				},
+				Required: []string{"apiVersion", "kind", "name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Resources: Owners",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: resourcesOwners},
+		{Tool: api.Tool{
+			Name:        "resources_watch",
+			Description: "Watch Kubernetes resources in the current cluster for a bounded duration by providing their apiVersion, kind, and optionally the namespace, name, and label selector. Returns the sequence of Added/Modified/Deleted events observed after the call, useful to confirm that an action took effect. Optionally stops as soon as a condition is met\n" + commonApiVersion,
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"apiVersion": {
+						Type:        "string",
+						Description: "apiVersion of the resources (examples of valid apiVersion are: v1, apps/v1, networking.k8s.io/v1)",
+					},
+					"kind": {
+						Type:        "string",
+						Description: "kind of the resources (examples of valid kind are: Pod, Service, Deployment, Ingress)",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace to watch the namespaced resources from (ignored in case of cluster scoped resources). If not provided, will watch resources from all namespaces (or the configured namespace if name is provided)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Optional name of the resource to watch. If not provided, will watch all the resources of the kind",
+					},
+					"labelSelector": {
+						Type:        "string",
+						Description: "Optional Kubernetes label selector (e.g. 'app=myapp,env=prod' or 'app in (myapp,yourapp)'), use this option when you want to filter the watched resources by label",
+						Pattern:     "([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]",
+					},
+					"until": {
+						Type:        "string",
+						Description: "Optional condition to stop watching as soon as a watched resource meets it, same syntax as `kubectl wait --for`: delete, create, condition=<type>[=<status>] (e.g. condition=Available), or jsonpath={<expression>}[=<value>] (e.g. jsonpath={.status.phase}=Running)",
+					},
+					"timeout_seconds": {
+						Type:        "integer",
+						Description: "Maximum time in seconds to watch the resources (Optional, 30 if not provided)",
+						Minimum:     ptr.To(float64(1)),
+						Maximum:     ptr.To(float64(300)),
+					},
+					"max_events": {
+						Type:        "integer",
+						Description: "Maximum number of events to return, the watch stops once it's reached (Optional, 50 if not provided)",
+						Minimum:     ptr.To(float64(1)),
+					},
+				},
+				Required: []string{"apiVersion", "kind"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Resources: Watch",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: resourcesWatch},
+		{Tool: api.Tool{
+			Name:        "resources_explain",
+			Description: "Describe a Kubernetes resource kind or one of its fields (like `kubectl explain`) by providing its apiVersion, kind, and optionally a field path. Returns the documentation and the fields of the type from the cluster OpenAPI schema, useful to build valid resources for resources_create_or_update\n" + commonApiVersion,
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"apiVersion": {
+						Type:        "string",
+						Description: "apiVersion of the resource (examples of valid apiVersion are: v1, apps/v1, networking.k8s.io/v1)",
+					},
+					"kind": {
+						Type:        "string",
+						Description: "kind of the resource (examples of valid kind are: Pod, Service, Deployment, Ingress)",
+					},
+					"field": {
+						Type:        "string",
+						Description: "Optional dot-separated path of the field to describe (e.g. spec.containers or spec.containers.resources). If not provided, will describe the resource kind",
+					},
+				},
+				Required: []string{"apiVersion", "kind"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Resources: Explain",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: resourcesExplain},
 		{Tool: api.Tool{
 			Name:        "resources_create_or_update",
 			Description: "Create or update a Kubernetes resource in the current cluster by providing a YAML or JSON representation of the resource\n" + commonApiVersion,
@@ -98,6 +299,10 @@ func initResources(o internalk8s.Openshift) []api.ServerTool {
 						Type:        "string",
 						Description: "A JSON or YAML containing a representation of the Kubernetes resource. Should include top-level fields such as apiVersion,kind,metadata, and spec",
 					},
+					"resource_version": {
+						Type:        "string",
+						Description: "Optional resourceVersion the live resource must have to be updated (optimistic concurrency, only for a single resource). If the resource has been modified since, the update is rejected with a Conflict error",
+					},
 				},
 				Required: []string{"resource"},
 			},
@@ -109,6 +314,166 @@ func initResources(o internalk8s.Openshift) []api.ServerTool {
 				OpenWorldHint:   ptr.To(true),
 			},
 		}, Handler: resourcesCreateOrUpdate},
+		{Tool: api.Tool{
+			Name:        "resources_diff",
+			Description: "Preview the changes that creating or updating a Kubernetes resource would make in the current cluster (like `kubectl diff`) by providing a YAML or JSON representation of the resource. Returns a unified diff between the live and the projected resource (computed with a server-side dry-run), \"no differences\", or \"would create\"\n" + commonApiVersion,
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"resource": {
+						Type:        "string",
+						Description: "A JSON or YAML containing a representation of the Kubernetes resource. Should include top-level fields such as apiVersion,kind,metadata, and spec",
+					},
+				},
+				Required: []string{"resource"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Resources: Diff",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: resourcesDiff},
+		{Tool: api.Tool{
+			Name:        "resources_patch",
+			Description: "Patch a Kubernetes resource in the current cluster by providing its apiVersion, kind, optionally the namespace, its name, the patch type, and the patch body\n" + commonApiVersion,
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"apiVersion": {
+						Type:        "string",
+						Description: "apiVersion of the resource (examples of valid apiVersion are: v1, apps/v1, networking.k8s.io/v1)",
+					},
+					"kind": {
+						Type:        "string",
+						Description: "kind of the resource (examples of valid kind are: Pod, Service, Deployment, Ingress)",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace to patch the namespaced resource from (ignored in case of cluster scoped resources). If not provided, will patch resource from configured namespace",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the resource",
+					},
+					"patch_type": {
+						Type:        "string",
+						Description: "Type of the patch: strategic (strategic merge patch, only for built-in resources), merge (JSON merge patch, RFC 7386), or json (JSON patch, RFC 6902)",
+						Enum:        []any{"strategic", "merge", "json"},
+						Default:     api.ToRawMessage("strategic"),
+					},
+					"patch": {
+						Type:        "string",
+						Description: "The patch body in JSON format. For strategic and merge patches an object (e.g. {\"spec\":{\"replicas\":3}}), for json patches a list of operations (e.g. [{\"op\":\"replace\",\"path\":\"/spec/replicas\",\"value\":3}])",
+					},
+					"resource_version": {
+						Type:        "string",
+						Description: "Optional resourceVersion the live resource must have to be patched (optimistic concurrency). If the resource has been modified since, the patch is rejected with a Conflict error",
+					},
+				},
+				Required: []string{"apiVersion", "kind", "name", "patch"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Resources: Patch",
+				ReadOnlyHint:    ptr.To(false),
+				DestructiveHint: ptr.To(true),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: resourcesPatch},
+		{Tool: api.Tool{
+			Name:        "resources_label",
+			Description: "Add, update, or remove a label of a Kubernetes resource in the current cluster by providing its apiVersion, kind, optionally the namespace, its name, and the label key and value. Returns the resulting metadata of the resource\n" + commonApiVersion,
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"apiVersion": {
+						Type:        "string",
+						Description: "apiVersion of the resource (examples of valid apiVersion are: v1, apps/v1, networking.k8s.io/v1)",
+					},
+					"kind": {
+						Type:        "string",
+						Description: "kind of the resource (examples of valid kind are: Pod, Service, Deployment, Ingress)",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace of the namespaced resource (ignored in case of cluster scoped resources). If not provided, will use the configured namespace",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the resource",
+					},
+					"key": {
+						Type:        "string",
+						Description: "Key of the label (e.g. app.kubernetes.io/part-of). A key ending with a dash (e.g. app.kubernetes.io/part-of-) removes the label",
+					},
+					"value": {
+						Type:        "string",
+						Description: "Value of the label, an existing value is overwritten (Optional, empty if not provided, ignored when removing)",
+					},
+					"remove": {
+						Type:        "boolean",
+						Description: "Remove the label instead of adding or updating it (Optional, false if not provided)",
+						Default:     api.ToRawMessage(false),
+					},
+				},
+				Required: []string{"apiVersion", "kind", "name", "key"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Resources: Label",
+				ReadOnlyHint:    ptr.To(false),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: resourcesSetMetadata("labels")},
+		{Tool: api.Tool{
+			Name:        "resources_annotate",
+			Description: "Add, update, or remove an annotation of a Kubernetes resource in the current cluster by providing its apiVersion, kind, optionally the namespace, its name, and the annotation key and value. Returns the resulting metadata of the resource\n" + commonApiVersion,
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"apiVersion": {
+						Type:        "string",
+						Description: "apiVersion of the resource (examples of valid apiVersion are: v1, apps/v1, networking.k8s.io/v1)",
+					},
+					"kind": {
+						Type:        "string",
+						Description: "kind of the resource (examples of valid kind are: Pod, Service, Deployment, Ingress)",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace of the namespaced resource (ignored in case of cluster scoped resources). If not provided, will use the configured namespace",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the resource",
+					},
+					"key": {
+						Type:        "string",
+						Description: "Key of the annotation (e.g. example.com/owner). A key ending with a dash (e.g. example.com/owner-) removes the annotation",
+					},
+					"value": {
+						Type:        "string",
+						Description: "Value of the annotation, an existing value is overwritten (Optional, empty if not provided, ignored when removing)",
+					},
+					"remove": {
+						Type:        "boolean",
+						Description: "Remove the annotation instead of adding or updating it (Optional, false if not provided)",
+						Default:     api.ToRawMessage(false),
+					},
+				},
+				Required: []string{"apiVersion", "kind", "name", "key"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Resources: Annotate",
+				ReadOnlyHint:    ptr.To(false),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: resourcesSetMetadata("annotations")},
 		{Tool: api.Tool{
 			Name:        "resources_delete",
 			Description: "Delete a Kubernetes resource in the current cluster by providing its apiVersion, kind, optionally the namespace, and its name\n" + commonApiVersion,
@@ -151,8 +516,12 @@ func resourcesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 		namespace = ""
 	}
 	labelSelector := params.GetArguments()["labelSelector"]
+	listOutput, err := parseOutputFormat(params.GetArguments(), params.ListOutput)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list resources, %s", err)), nil
+	}
 	resourceListOptions := internalk8s.ResourceListOptions{
-		AsTable: params.ListOutput.AsTable(),
+		AsTable: listOutput.AsTable(),
 	}
 
 	if labelSelector != nil {
@@ -172,11 +541,26 @@ func resourcesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 		return api.NewToolCallResult("", fmt.Errorf("namespace is not a string")), nil
 	}
 
+	if err = parsePagination(params.GetArguments(), &resourceListOptions); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list resources, %s", err)), nil
+	}
+
+	var columns []output.Column
+	if columnsArg, ok := params.GetArguments()["columns"].(string); ok && columnsArg != "" {
+		if columns, err = output.ParseColumns(columnsArg); err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to list resources, %s", err)), nil
+		}
+		resourceListOptions.AsTable = false
+	}
+
 	ret, err := params.ResourcesList(params, gvk, ns, resourceListOptions)
 	if err != nil {
 		return api.NewToolCallResult("", fmt.Errorf("failed to list resources: %v", err)), nil
 	}
-	return api.NewToolCallResult(params.ListOutput.PrintObj(ret)), nil
+	if len(columns) > 0 {
+		return printColumnsPage(params, listOutput, ret, columns), nil
+	}
+	return printPage(params, listOutput, ret), nil
 }
 
 func resourcesGet(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
@@ -210,6 +594,110 @@ func resourcesGet(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	return api.NewToolCallResult(output.MarshalYaml(ret)), nil
 }
 
+func resourcesDescribe(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	gvk, err := parseGroupVersionKind(params.GetArguments())
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to describe resource, %s", err)), nil
+	}
+	ns, _ := params.GetArguments()["namespace"].(string)
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to describe resource, missing argument name")), nil
+	}
+	ret, err := params.ResourcesDescribe(params, gvk, ns, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to describe resource: %v", err)), nil
+	}
+	return params.NewTruncatedTextToolCallResult(ret, false, nil), nil
+}
+
+func resourcesExport(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	gvk, err := parseGroupVersionKind(params.GetArguments())
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to export resource, %s", err)), nil
+	}
+	ns, _ := params.GetArguments()["namespace"].(string)
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to export resource, missing argument name")), nil
+	}
+	ret, err := params.ResourcesExport(params, gvk, ns, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to export resource: %v", err)), nil
+	}
+	marshalledYaml, err := output.MarshalYaml(ret)
+	if err != nil {
+		err = fmt.Errorf("failed to export resource: %v", err)
+	}
+	return api.NewToolCallResult("# The following manifest (YAML) can be re-applied with resources_create_or_update\n"+marshalledYaml, err), nil
+}
+
+func resourcesOwners(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	gvk, err := parseGroupVersionKind(params.GetArguments())
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get resource owners, %s", err)), nil
+	}
+	ns, _ := params.GetArguments()["namespace"].(string)
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to get resource owners, missing argument name")), nil
+	}
+	children, _ := params.GetArguments()["children"].(bool)
+	ret, err := params.ResourcesOwners(params, gvk, ns, name, children)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get resource owners: %v", err)), nil
+	}
+	marshalledYaml, err := output.MarshalYaml(ret)
+	if err != nil {
+		err = fmt.Errorf("failed to get resource owners: %v", err)
+	}
+	return api.NewToolCallResult("# The following ownership chain (YAML format) was found, from the resource to its root owner:\n"+marshalledYaml, err), nil
+}
+
+func resourcesWatch(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	gvk, err := parseGroupVersionKind(params.GetArguments())
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to watch resources, %s", err)), nil
+	}
+	ns, _ := params.GetArguments()["namespace"].(string)
+	options := internalk8s.ResourcesWatchOptions{}
+	options.Name, _ = params.GetArguments()["name"].(string)
+	options.LabelSelector, _ = params.GetArguments()["labelSelector"].(string)
+	options.Until, _ = params.GetArguments()["until"].(string)
+	timeout, err := api.IntArgument(params.GetArguments(), "timeout_seconds")
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to watch resources, %v", err)), nil
+	}
+	options.Timeout = time.Duration(timeout) * time.Second
+	maxEvents, err := api.IntArgument(params.GetArguments(), "max_events")
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to watch resources, %v", err)), nil
+	}
+	options.MaxEvents = int(maxEvents)
+	ret, err := params.ResourcesWatch(params, gvk, ns, options)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to watch resources: %v", err)), nil
+	}
+	yamlWatch, err := output.MarshalYaml(ret)
+	if err != nil {
+		err = fmt.Errorf("failed to watch resources: %v", err)
+	}
+	return api.NewToolCallResult("# The following events (YAML format) were observed while watching the resources:\n"+yamlWatch, err), nil
+}
+
+func resourcesExplain(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	gvk, err := parseGroupVersionKind(params.GetArguments())
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to explain resource, %s", err)), nil
+	}
+	field, _ := params.GetArguments()["field"].(string)
+	ret, err := params.ResourcesExplain(params, gvk, field)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to explain resource: %v", err)), nil
+	}
+	return api.NewToolCallResult(ret, nil), nil
+}
+
 func resourcesCreateOrUpdate(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	resource := params.GetArguments()["resource"]
 	if resource == nil || resource == "" {
@@ -221,7 +709,8 @@ func resourcesCreateOrUpdate(params api.ToolHandlerParams) (*api.ToolCallResult,
 		return api.NewToolCallResult("", fmt.Errorf("resource is not a string")), nil
 	}
 
-	resources, err := params.ResourcesCreateOrUpdate(params, r)
+	resourceVersion, _ := params.GetArguments()["resource_version"].(string)
+	resources, err := params.ResourcesCreateOrUpdate(params, r, resourceVersion)
 	if err != nil {
 		return api.NewToolCallResult("", fmt.Errorf("failed to create or update resources: %v", err)), nil
 	}
@@ -232,6 +721,91 @@ func resourcesCreateOrUpdate(params api.ToolHandlerParams) (*api.ToolCallResult,
 	return api.NewToolCallResult("# The following resources (YAML) have been created or updated successfully\n"+marshalledYaml, err), nil
 }
 
+func resourcesDiff(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	resource, ok := params.GetArguments()["resource"].(string)
+	if !ok || resource == "" {
+		return api.NewToolCallResult("", errors.New("failed to diff resources, missing argument resource")), nil
+	}
+	diff, err := params.ResourcesDiff(params, resource)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to diff resources: %v", err)), nil
+	}
+	return api.NewToolCallResult(diff, nil), nil
+}
+
+func resourcesPatch(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	gvk, err := parseGroupVersionKind(params.GetArguments())
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to patch resource, %s", err)), nil
+	}
+	ns, _ := params.GetArguments()["namespace"].(string)
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to patch resource, missing argument name")), nil
+	}
+	patch, ok := params.GetArguments()["patch"].(string)
+	if !ok || patch == "" {
+		return api.NewToolCallResult("", errors.New("failed to patch resource, missing argument patch")), nil
+	}
+	patchTypeArg, ok := params.GetArguments()["patch_type"].(string)
+	if !ok || patchTypeArg == "" {
+		patchTypeArg = "strategic"
+	}
+	patchType, ok := patchTypes[patchTypeArg]
+	if !ok {
+		return api.NewToolCallResult("", fmt.Errorf("failed to patch resource, invalid patch_type %s (valid values are strategic, merge, json)", patchTypeArg)), nil
+	}
+
+	resourceVersion, _ := params.GetArguments()["resource_version"].(string)
+	ret, err := params.ResourcesPatch(params, gvk, ns, name, patchType, []byte(patch), resourceVersion)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to patch resource: %v", err)), nil
+	}
+	marshalledYaml, err := output.MarshalYaml(ret)
+	if err != nil {
+		err = fmt.Errorf("failed to patch resource: %v", err)
+	}
+	return api.NewToolCallResult("# The following resource (YAML) has been patched successfully\n"+marshalledYaml, err), nil
+}
+
+// resourcesSetMetadata returns the handler of the tools adding, updating, or removing a key of the provided metadata
+// field (labels or annotations) of a resource
+func resourcesSetMetadata(field string) api.ToolHandlerFunc {
+	return func(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+		gvk, err := parseGroupVersionKind(params.GetArguments())
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to update resource %s, %s", field, err)), nil
+		}
+		ns, _ := params.GetArguments()["namespace"].(string)
+		name, ok := params.GetArguments()["name"].(string)
+		if !ok || name == "" {
+			return api.NewToolCallResult("", fmt.Errorf("failed to update resource %s, missing argument name", field)), nil
+		}
+		key, ok := params.GetArguments()["key"].(string)
+		if !ok || key == "" {
+			return api.NewToolCallResult("", fmt.Errorf("failed to update resource %s, missing argument key", field)), nil
+		}
+		remove, _ := params.GetArguments()["remove"].(bool)
+		if trimmed, ok := strings.CutSuffix(key, "-"); ok {
+			key, remove = trimmed, true
+		}
+		var value *string
+		if !remove {
+			v, _ := params.GetArguments()["value"].(string)
+			value = &v
+		}
+		metadata, err := params.ResourcesSetMetadata(params, gvk, ns, name, field, key, value)
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to update resource %s: %v", field, err)), nil
+		}
+		marshalledYaml, err := output.MarshalYaml(metadata)
+		if err != nil {
+			err = fmt.Errorf("failed to update resource %s: %v", field, err)
+		}
+		return api.NewToolCallResult(fmt.Sprintf("# The %s of the resource have been updated successfully, the following is the resulting metadata (YAML):\n%s", field, marshalledYaml), err), nil
+	}
+}
+
 func resourcesDelete(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	namespace := params.GetArguments()["namespace"]
 	if namespace == nil {
@@ -284,3 +858,110 @@ func parseGroupVersionKind(arguments map[string]interface{}) (*schema.GroupVersi
 	}
 	return &schema.GroupVersionKind{Group: gv.Group, Version: gv.Version, Kind: kind.(string)}, nil
 }
+
+// withPagination adds the limit and continue pagination properties to the provided list tool input schema properties
+func withPagination(properties map[string]*jsonschema.Schema) map[string]*jsonschema.Schema {
+	properties["limit"] = &jsonschema.Schema{
+		Type:        "integer",
+		Description: "Optional maximum number of items to return. If more items are available, the result includes a continue token to retrieve the next page",
+		Minimum:     ptr.To(float64(1)),
+	}
+	properties["continue"] = &jsonschema.Schema{
+		Type:        "string",
+		Description: "Optional continue token returned by a previous call to retrieve the next page of results (the rest of the arguments must remain the same)",
+	}
+	return properties
+}
+
+// parsePagination sets the Limit and Continue list options from the limit and continue arguments
+func parsePagination(arguments map[string]interface{}, options *internalk8s.ResourceListOptions) error {
+	limit, err := api.IntArgument(arguments, "limit")
+	if err != nil {
+		return err
+	}
+	options.Limit = limit
+	if continueToken := arguments["continue"]; continueToken != nil {
+		c, ok := continueToken.(string)
+		if !ok {
+			return errors.New("continue is not a string")
+		}
+		options.Continue = c
+	}
+	return nil
+}
+
+func withOutputFormat(properties map[string]*jsonschema.Schema) map[string]*jsonschema.Schema {
+	formats := make([]any, 0)
+	for _, name := range output.FormatNames() {
+		formats = append(formats, name)
+	}
+	properties["output_format"] = &jsonschema.Schema{
+		Type:        "string",
+		Description: "Optional format of the results, overrides the default output of the tool. Use csv or tsv to get delimited rows with a header line, for example to export the results to a spreadsheet or a script",
+		Enum:        formats,
+	}
+	return properties
+}
+
+// parseOutputFormat returns the output requested by the output_format argument, or the provided output if not provided
+func parseOutputFormat(arguments map[string]interface{}, defaultOutput output.Output) (output.Output, error) {
+	format, ok := arguments["output_format"].(string)
+	if !ok || format == "" {
+		return defaultOutput, nil
+	}
+	if o := output.FormatFromString(format); o != nil {
+		return o, nil
+	}
+	return nil, fmt.Errorf("invalid output_format %s, valid formats are: %s", format, strings.Join(output.FormatNames(), ", "))
+}
+
+// printPage prints the provided list with the provided output as the result of a page of a list tool (see
+// newPageToolCallResult)
+func printPage(params api.ToolHandlerParams, o output.Output, list runtime.Unstructured) *api.ToolCallResult {
+	ret, err := o.PrintObj(list)
+	continueToken, _, _ := unstructured.NestedString(list.UnstructuredContent(), "metadata", "continue")
+	return newPageToolCallResult(params, o, ret, continueToken, err)
+}
+
+func printColumnsPage(params api.ToolHandlerParams, o output.Output, list runtime.Unstructured, columns []output.Column) *api.ToolCallResult {
+	var ret string
+	var err error
+	if delimited, ok := o.(*output.Delimited); ok {
+		ret, err = delimited.PrintColumns(list, columns)
+	} else {
+		ret, err = output.PrintColumns(list, columns)
+	}
+	continueToken, _, _ := unstructured.NestedString(list.UnstructuredContent(), "metadata", "continue")
+	return newPageToolCallResult(params, o, ret, continueToken, err)
+}
+
+// newPageToolCallResult creates the result of a page of a list tool printed with the provided output, with the continue
+// token (if any) so that the next page can be retrieved.
+// Delimited content is truncated on record boundaries, the truncation notice and continue token are returned apart
+// from the content so that it remains parseable.
+func newPageToolCallResult(params api.ToolHandlerParams, o output.Output, content, continueToken string, err error) *api.ToolCallResult {
+	delimited, ok := o.(*output.Delimited)
+	if !ok || err != nil {
+		return params.NewTruncatedToolCallResult(withContinueToken(content, continueToken), err)
+	}
+	content, notice, err := delimited.Truncate(content, params.MaxOutputBytes)
+	result := api.NewToolCallResult(content, err)
+	if notice != "" {
+		result.Notices = append(result.Notices, notice)
+	}
+	if continueToken != "" {
+		result.Notices = append(result.Notices, continueTokenNotice(continueToken))
+	}
+	return result
+}
+
+func withContinueToken(ret, continueToken string) string {
+	if continueToken == "" {
+		return ret
+	}
+	return strings.TrimSuffix(ret, "\n") + "\n# " + continueTokenNotice(continueToken) + "\n"
+}
+
+func continueTokenNotice(continueToken string) string {
+	return "More results are available, use the following continue token to retrieve the next page: " + continueToken
+}
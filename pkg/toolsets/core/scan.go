@@ -0,0 +1,20 @@
+package core
+
+import (
+	"strings"
+
+	internalk8s "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+// withScanWarnings appends a warnings section listing the namespaces skipped by a scan across multiple namespaces
+// (and why) to the provided tool output. Warnings are rendered as comments so that the output remains valid YAML.
+func withScanWarnings(ret string, warnings []internalk8s.ScanWarning) string {
+	if len(warnings) == 0 {
+		return ret
+	}
+	ret = strings.TrimSuffix(ret, "\n") + "\n# Warnings, the following namespaces were skipped (partial results):"
+	for _, warning := range warnings {
+		ret += "\n# - " + warning.Namespace + ": " + warning.Reason
+	}
+	return ret
+}
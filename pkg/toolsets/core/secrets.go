@@ -0,0 +1,63 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+func initSecrets() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "secrets_list",
+			Description: "List the Kubernetes Secrets in the current cluster from the provided namespace or all namespaces, including their type and the names of their keys. Secret values are never returned. Useful to locate, for example, all the TLS secrets or all the image pull secrets",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace to list the Secrets from. If not provided, will list Secrets from all namespaces",
+					},
+					"type": {
+						Type:        "string",
+						Description: "Optional type of the Secrets to list (e.g. Opaque, kubernetes.io/tls, kubernetes.io/dockerconfigjson, kubernetes.io/service-account-token). If not provided, will list Secrets of all types",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Secrets: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: secretsList},
+	}
+}
+
+func secretsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	ns, ok := params.GetArguments()["namespace"].(string)
+	if !ok && params.GetArguments()["namespace"] != nil {
+		return api.NewToolCallResult("", fmt.Errorf("namespace is not a string")), nil
+	}
+	secretType, ok := params.GetArguments()["type"].(string)
+	if !ok && params.GetArguments()["type"] != nil {
+		return api.NewToolCallResult("", fmt.Errorf("type is not a string")), nil
+	}
+	secrets, err := params.SecretsList(params, ns, secretType)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list secrets: %v", err)), nil
+	}
+	if len(secrets) == 0 {
+		return api.NewToolCallResult("# No secrets found", nil), nil
+	}
+	yamlSecrets, err := output.MarshalYaml(secrets)
+	if err != nil {
+		err = fmt.Errorf("failed to list secrets: %v", err)
+	}
+	return params.NewTruncatedToolCallResult(fmt.Sprintf("# The following secrets (YAML format) were found:\n%s", yamlSecrets), err), nil
+}
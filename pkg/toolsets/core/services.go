@@ -0,0 +1,55 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+func initServices() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "services_list",
+			Description: "List the Kubernetes Services in the current cluster from the provided namespace or all namespaces, including their type, clusterIP, ports, selector, and the number of ready vs total backing endpoints (from EndpointSlices). Services with no ready endpoints are flagged with NoReadyEndpoints, a common cause of \"connection refused\" errors",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace to list the Services from. If not provided, will list Services from all namespaces",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Services: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: servicesList},
+	}
+}
+
+func servicesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	ns, ok := params.GetArguments()["namespace"].(string)
+	if !ok && params.GetArguments()["namespace"] != nil {
+		return api.NewToolCallResult("", fmt.Errorf("namespace is not a string")), nil
+	}
+	serviceMap, skipped, err := params.ServicesList(params, ns)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list services: %v", err)), nil
+	}
+	if len(serviceMap) == 0 {
+		return api.NewToolCallResult(withScanWarnings("# No services found", skipped), nil), nil
+	}
+	yamlServices, err := output.MarshalYaml(serviceMap)
+	if err != nil {
+		err = fmt.Errorf("failed to list services: %v", err)
+	}
+	return params.NewTruncatedToolCallResult(withScanWarnings(fmt.Sprintf("# The following services (YAML format) were found:\n%s", yamlServices), skipped), err), nil
+}
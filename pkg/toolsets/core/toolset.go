@@ -22,11 +22,27 @@ func (t *Toolset) GetDescription() string {
 
 func (t *Toolset) GetTools(o internalk8s.Openshift) []api.ServerTool {
 	return slices.Concat(
+		initAPIResources(),
+		initAutoscaling(),
+		initCertificateSigningRequests(),
+		initCluster(o),
+		initCRDs(),
 		initEvents(),
+		initIngresses(),
+		initJobs(),
 		initNamespaces(o),
+		initNetworkPolicies(),
 		initNodes(),
+		initOLM(o),
+		initPersistentVolumes(),
 		initPods(),
+		initRBAC(),
+		initResourceQuotas(),
 		initResources(o),
+		initSecrets(),
+		initServices(),
+		initWebhooks(),
+		initWorkloads(),
 	)
 }
 
@@ -0,0 +1,52 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+func initWebhooks() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "webhooks_list",
+			Description: "List the admission webhooks of the ValidatingWebhookConfigurations and MutatingWebhookConfigurations in the current cluster, including their rules (operations and resources), failure policy, and target service. Webhooks with a Fail failure policy and an unavailable backend block every matching operation, use this tool to find the webhooks that may be rejecting or blocking requests for a resource",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"resource": {
+						Type:        "string",
+						Description: "Optional resource (plural name) to list the matching webhooks for (e.g. pods, deployments, or pods/exec for a subresource). If not provided, will list all the webhooks",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Webhooks: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: webhooksList},
+	}
+}
+
+func webhooksList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	resource, _ := params.GetArguments()["resource"].(string)
+	webhooks, err := params.WebhooksList(params, resource)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list webhooks: %v", err)), nil
+	}
+	if len(webhooks) == 0 {
+		return api.NewToolCallResult("# No admission webhooks found", nil), nil
+	}
+	yamlWebhooks, err := output.MarshalYaml(webhooks)
+	if err != nil {
+		err = fmt.Errorf("failed to list webhooks: %v", err)
+	}
+	return params.NewTruncatedToolCallResult("# The following admission webhooks (YAML format) were found:\n"+yamlWebhooks, err), nil
+}
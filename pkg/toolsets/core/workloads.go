@@ -0,0 +1,191 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+func initWorkloads() []api.ServerTool {
+	kinds := make([]any, 0, len(kubernetes.WorkloadKinds))
+	for _, kind := range kubernetes.WorkloadKinds {
+		kinds = append(kinds, kind)
+	}
+	pdbKinds := make([]any, 0, len(kubernetes.PodDisruptionBudgetWorkloadKinds))
+	for _, kind := range kubernetes.PodDisruptionBudgetWorkloadKinds {
+		pdbKinds = append(pdbKinds, kind)
+	}
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "workloads_logs",
+			Description: "Get the recent logs of all the Pods of a Kubernetes workload (Deployment, StatefulSet, DaemonSet, or Job) in the current or provided namespace at once. The Pods are resolved with the workload's selector and each log line is prefixed with the Pod name",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"kind": {
+						Type:        "string",
+						Description: "Kind of the workload",
+						Enum:        kinds,
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the workload to get the logs from",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the workload (Optional, current namespace if not provided)",
+					},
+					"container": {
+						Type:        "string",
+						Description: "Name of the Pod container to get the logs from (Optional, required for Pods with multiple containers and no default container)",
+					},
+					"since": {
+						Type:        "string",
+						Description: "Only return the logs newer than the provided relative duration (e.g. 30s, 5m, 1h) (Optional, all the logs if not provided)",
+					},
+					"tail_lines": {
+						Type:        "integer",
+						Description: "Number of lines to retrieve from the end of the logs of each Pod (Optional, default: 100)",
+						Default:     api.ToRawMessage(kubernetes.DefaultTailLines),
+						Minimum:     ptr.To(float64(0)),
+					},
+				},
+				Required: []string{"kind", "name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Workloads: Logs",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: workloadsLogs},
+		{Tool: api.Tool{
+			Name:        "pdb_for_workload",
+			Description: "Get the Kubernetes PodDisruptionBudgets covering the Pods of a Deployment or StatefulSet in the current or provided namespace (matched with their selectors), including their minAvailable/maxUnavailable, current healthy Pods, and disruptions allowed. Reports whether evictions of the workload Pods (e.g. node drains) are currently blocked",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"kind": {
+						Type:        "string",
+						Description: "Kind of the workload",
+						Enum:        pdbKinds,
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the workload",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the workload (Optional, current namespace if not provided)",
+					},
+				},
+				Required: []string{"kind", "name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Workloads: PodDisruptionBudgets",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: pdbForWorkload},
+		{Tool: api.Tool{
+			Name:        "workloads_resource_audit",
+			Description: "Audit the container resource requests and limits of the Kubernetes workloads (Deployments, StatefulSets, DaemonSets, and CronJobs) in the current or provided namespace. Reports, per container, the misconfigurations that commonly cause OOM kills, evictions, and poor scheduling: no requests nor limits, missing CPU or memory requests, missing memory limit, memory limit without request, and requests exceeding limits",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the workloads to audit (Optional, current namespace if not provided)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Workloads: Resource Audit",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: workloadsResourceAudit},
+	}
+}
+
+func workloadsLogs(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	kind, _ := params.GetArguments()["kind"].(string)
+	if kind == "" {
+		return api.NewToolCallResult("", errors.New("failed to get workload logs, missing argument kind")), nil
+	}
+	name, _ := params.GetArguments()["name"].(string)
+	if name == "" {
+		return api.NewToolCallResult("", errors.New("failed to get workload logs, missing argument name")), nil
+	}
+	ns, _ := params.GetArguments()["namespace"].(string)
+	options := kubernetes.WorkloadsLogsOptions{}
+	options.Container, _ = params.GetArguments()["container"].(string)
+	if since, _ := params.GetArguments()["since"].(string); since != "" {
+		duration, err := time.ParseDuration(since)
+		if err != nil || duration < 0 {
+			return api.NewToolCallResult("", fmt.Errorf("failed to get workload logs, invalid since %s, expected a duration such as 30s, 5m or 1h", since)), nil
+		}
+		options.Since = duration
+	}
+	tailLines, err := api.IntArgument(params.GetArguments(), "tail_lines")
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get workload logs, %v", err)), nil
+	}
+	options.TailLines = tailLines
+	ret, err := params.WorkloadsLogs(params, kind, ns, name, options)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get %s %s logs: %v", kind, name, err)), nil
+	} else if ret == "" {
+		ret = fmt.Sprintf("The pods of %s %s have not logged any message yet", kind, name)
+	}
+	return params.NewTruncatedTextToolCallResult(ret, true, nil), nil
+}
+
+func pdbForWorkload(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	kind, _ := params.GetArguments()["kind"].(string)
+	if kind == "" {
+		return api.NewToolCallResult("", errors.New("failed to get workload pod disruption budgets, missing argument kind")), nil
+	}
+	name, _ := params.GetArguments()["name"].(string)
+	if name == "" {
+		return api.NewToolCallResult("", errors.New("failed to get workload pod disruption budgets, missing argument name")), nil
+	}
+	ns, _ := params.GetArguments()["namespace"].(string)
+	ret, err := params.WorkloadsPodDisruptionBudgets(params, kind, ns, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get %s %s pod disruption budgets: %v", kind, name, err)), nil
+	}
+	yamlRet, err := output.MarshalYaml(ret)
+	if err != nil {
+		err = fmt.Errorf("failed to get %s %s pod disruption budgets: %v", kind, name, err)
+	}
+	return api.NewToolCallResult("# The following pod disruption budget coverage (YAML format) was found:\n"+yamlRet, err), nil
+}
+
+func workloadsResourceAudit(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	ns, _ := params.GetArguments()["namespace"].(string)
+	advisories, skipped, err := params.WorkloadsResourceAudit(params, ns)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to audit workload resources: %v", err)), nil
+	}
+	if len(advisories) == 0 {
+		return api.NewToolCallResult(withScanWarnings("# No workload resource misconfigurations found", skipped), nil), nil
+	}
+	yamlAdvisories, err := output.MarshalYaml(advisories)
+	if err != nil {
+		err = fmt.Errorf("failed to audit workload resources: %v", err)
+	}
+	return params.NewTruncatedToolCallResult(withScanWarnings("# The following workload containers (YAML format) have resource misconfigurations:\n"+yamlAdvisories, skipped), err), nil
+}
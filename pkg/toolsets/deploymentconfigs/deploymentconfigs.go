@@ -0,0 +1,105 @@
+package deploymentconfigs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	internalk8s "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+func initDeploymentConfigs() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "deploymentconfigs_list",
+			Description: "List the OpenShift DeploymentConfigs (apps.openshift.io/v1) in the current cluster from the provided namespace or all namespaces",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace to list the DeploymentConfigs from. If not provided, will list DeploymentConfigs from all namespaces",
+					},
+					"labelSelector": {
+						Type:        "string",
+						Description: "Optional Kubernetes label selector (e.g. 'app=myapp,env=prod' or 'app in (myapp,yourapp)'), use this option when you want to filter the DeploymentConfigs by label",
+						Pattern:     "([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "DeploymentConfigs: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: deploymentConfigsList},
+		{Tool: api.Tool{
+			Name:        "deploymentconfigs_rollout",
+			Description: "Trigger a new rollout of the latest template of an OpenShift DeploymentConfig in the current or provided namespace (equivalent to `oc rollout latest`). Returns the new rollout version",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the DeploymentConfig (Optional, current namespace if not provided)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the DeploymentConfig to roll out",
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "DeploymentConfigs: Rollout Latest",
+				ReadOnlyHint:    ptr.To(false),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: deploymentConfigsRollout},
+	}
+}
+
+func deploymentConfigsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	namespace := params.GetArguments()["namespace"]
+	if namespace == nil {
+		namespace = ""
+	}
+	ns, ok := namespace.(string)
+	if !ok {
+		return api.NewToolCallResult("", fmt.Errorf("namespace is not a string")), nil
+	}
+	resourceListOptions := internalk8s.ResourceListOptions{
+		AsTable: params.ListOutput.AsTable(),
+	}
+	if labelSelector, ok := params.GetArguments()["labelSelector"].(string); ok {
+		resourceListOptions.LabelSelector = labelSelector
+	}
+	ret, err := params.DeploymentConfigsList(params, ns, resourceListOptions)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list deployment configs: %v", err)), nil
+	}
+	return api.NewToolCallResult(params.ListOutput.PrintObj(ret)), nil
+}
+
+func deploymentConfigsRollout(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	ns, _ := params.GetArguments()["namespace"].(string)
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to roll out deployment config, missing argument name")), nil
+	}
+	deploymentConfig, err := params.DeploymentConfigsRolloutLatest(params, ns, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to roll out deployment config %s: %v", name, err)), nil
+	}
+	latestVersion, _, _ := unstructured.NestedInt64(deploymentConfig.Object, "status", "latestVersion")
+	return api.NewToolCallResult(fmt.Sprintf("DeploymentConfig %s/%s rolled out, new version: %d",
+		deploymentConfig.GetNamespace(), deploymentConfig.GetName(), latestVersion), nil), nil
+}
@@ -0,0 +1,42 @@
+package deploymentconfigs
+
+import (
+	"context"
+	"slices"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	internalk8s "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets"
+)
+
+type Toolset struct{}
+
+var _ api.Toolset = (*Toolset)(nil)
+
+func (t *Toolset) GetName() string {
+	return "deploymentconfigs"
+}
+
+func (t *Toolset) GetDescription() string {
+	return "Tools for managing legacy OpenShift DeploymentConfig workloads (OpenShift only)"
+}
+
+func (t *Toolset) GetTools(o internalk8s.Openshift) []api.ServerTool {
+	if !o.IsOpenShift(context.Background()) {
+		return []api.ServerTool{}
+	}
+	tools := slices.Concat(
+		initDeploymentConfigs(),
+	)
+	// DeploymentConfigs are deprecated since OpenShift 4.14, steer new workloads to Deployments
+	if minor, ok := o.OpenShiftMinorVersion(context.Background()); ok && minor >= 14 {
+		for i := range tools {
+			tools[i].Tool.Description += ". Note: DeploymentConfigs are deprecated since OpenShift 4.14, prefer apps/v1 Deployments for new workloads"
+		}
+	}
+	return tools
+}
+
+func init() {
+	toolsets.Register(&Toolset{})
+}
@@ -0,0 +1,225 @@
+package mustgather
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/mustgather"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+const defaultLogsGrepMaxMatches = 100
+
+var pathProperty = &jsonschema.Schema{
+	Type:        "string",
+	Description: "Path to the unpacked must-gather directory, relative to the must_gather_dir configured in the MCP server or absolute within it (the top-level must-gather.local.* directory or the directory of a single gather image)",
+}
+
+func initMustGather() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "mustgather_namespaces_list",
+			Description: "List the namespaces collected in an unpacked must-gather directory with their phase and number of collected Pods",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"path": pathProperty,
+				},
+				Required: []string{"path"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Must-gather: Namespaces List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(false),
+			},
+		}, ClusterAware: ptr.To(false), Handler: mustGatherNamespacesList},
+		{Tool: api.Tool{
+			Name: "mustgather_cluster_operators",
+			Description: "Summarize the ClusterOperators collected in an unpacked must-gather directory: their version and Available, Progressing, and Degraded conditions. " +
+				"The ClusterOperators reporting a problem are listed first with the reason and message of the problematic conditions",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"path": pathProperty,
+				},
+				Required: []string{"path"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Must-gather: ClusterOperators",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(false),
+			},
+		}, ClusterAware: ptr.To(false), Handler: mustGatherClusterOperators},
+		{Tool: api.Tool{
+			Name:        "mustgather_events_list",
+			Description: "List the events collected in an unpacked must-gather directory (oldest first), in all namespaces or in the provided namespace",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"path": pathProperty,
+					"namespace": {
+						Type:        "string",
+						Description: "Optional namespace to list the events from, all namespaces if not provided",
+					},
+					"warnings_only": {
+						Type:        "boolean",
+						Description: "If true, only the Warning events are listed (Optional, default false)",
+					},
+				},
+				Required: []string{"path"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Must-gather: Events List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(false),
+			},
+		}, ClusterAware: ptr.To(false), Handler: mustGatherEventsList},
+		{Tool: api.Tool{
+			Name: "mustgather_logs_grep",
+			Description: "Search the container logs (current and previous) collected in an unpacked must-gather directory for the lines matching a regular expression. " +
+				"Each matching line is prefixed with its namespace/pod/container and line number",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"path": pathProperty,
+					"pattern": {
+						Type:        "string",
+						Description: "Regular expression (Go RE2 syntax) to search for, for example: (?i)error|failed",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Optional namespace to search the logs in, for example: openshift-etcd (all namespaces if not provided)",
+					},
+					"pod": {
+						Type:        "string",
+						Description: "Optional prefix of the names of the Pods to search the logs of, for example: etcd-",
+					},
+					"max_matches": {
+						Type:        "integer",
+						Description: fmt.Sprintf("Maximum number of matching lines to return (Optional, default %d)", defaultLogsGrepMaxMatches),
+						Minimum:     ptr.To(float64(1)),
+					},
+				},
+				Required: []string{"path", "pattern"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Must-gather: Logs Grep",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(false),
+			},
+		}, ClusterAware: ptr.To(false), Handler: mustGatherLogsGrep},
+	}
+}
+
+func mustGatherNamespacesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	bundle, err := openBundle(params)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list must-gather namespaces, %v", err)), nil
+	}
+	namespaces := bundle.Namespaces()
+	if len(namespaces) == 0 {
+		return api.NewToolCallResult("# No namespaces collected in the must-gather", nil), nil
+	}
+	yamlNamespaces, err := output.MarshalYaml(namespaces)
+	if err != nil {
+		err = fmt.Errorf("failed to list must-gather namespaces: %v", err)
+	}
+	return params.NewTruncatedToolCallResult("# The following namespaces (YAML format) were collected:\n"+yamlNamespaces, err), nil
+}
+
+func mustGatherClusterOperators(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	bundle, err := openBundle(params)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to summarize must-gather cluster operators, %v", err)), nil
+	}
+	operators, err := bundle.ClusterOperators()
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to summarize must-gather cluster operators: %v", err)), nil
+	}
+	yamlOperators, err := output.MarshalYaml(operators)
+	if err != nil {
+		err = fmt.Errorf("failed to summarize must-gather cluster operators: %v", err)
+	}
+	return params.NewTruncatedToolCallResult("# The following cluster operators (YAML format) were collected:\n"+yamlOperators, err), nil
+}
+
+func mustGatherEventsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	bundle, err := openBundle(params)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list must-gather events, %v", err)), nil
+	}
+	namespace, _ := params.GetArguments()["namespace"].(string)
+	warningsOnly, _ := params.GetArguments()["warnings_only"].(bool)
+	events, err := bundle.Events(namespace, warningsOnly)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list must-gather events: %v", err)), nil
+	}
+	if len(events) == 0 {
+		return api.NewToolCallResult("# No events collected in the must-gather", nil), nil
+	}
+	yamlEvents, err := output.MarshalYaml(events)
+	if err != nil {
+		err = fmt.Errorf("failed to list must-gather events: %v", err)
+	}
+	return params.NewTruncatedToolCallResult("# The following events (YAML format) were collected:\n"+yamlEvents, err), nil
+}
+
+func mustGatherLogsGrep(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	pattern, ok := params.GetArguments()["pattern"].(string)
+	if !ok || pattern == "" {
+		return api.NewToolCallResult("", errors.New("failed to search must-gather logs, missing argument pattern")), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to search must-gather logs, invalid pattern: %v", err)), nil
+	}
+	maxMatches := defaultLogsGrepMaxMatches
+	if v, ok := params.GetArguments()["max_matches"].(float64); ok && v >= 1 {
+		maxMatches = int(v)
+	}
+	bundle, err := openBundle(params)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to search must-gather logs, %v", err)), nil
+	}
+	namespace, _ := params.GetArguments()["namespace"].(string)
+	pod, _ := params.GetArguments()["pod"].(string)
+	matches, truncated, err := bundle.GrepLogs(re, namespace, pod, maxMatches)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to search must-gather logs: %v", err)), nil
+	}
+	if len(matches) == 0 {
+		return api.NewToolCallResult("# No matching log lines found", nil), nil
+	}
+	ret := fmt.Sprintf("# The following %d log lines match %s:\n", len(matches), pattern)
+	for _, match := range matches {
+		ret += match + "\n"
+	}
+	if truncated {
+		ret += fmt.Sprintf("# More lines match, only the first %d are shown (narrow the search or increase max_matches)\n", maxMatches)
+	}
+	return params.NewTruncatedTextToolCallResult(ret, false, nil), nil
+}
+
+func openBundle(params api.ToolHandlerParams) (*mustgather.Bundle, error) {
+	path, ok := params.GetArguments()["path"].(string)
+	if !ok || path == "" {
+		return nil, errors.New("missing argument path")
+	}
+	if params.MustGatherDir == "" {
+		return nil, errors.New("must_gather_dir is not configured")
+	}
+	return mustgather.Open(params.MustGatherDir, path)
+}
@@ -0,0 +1,35 @@
+package mustgather
+
+import (
+	"context"
+	"slices"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	internalk8s "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets"
+)
+
+type Toolset struct{}
+
+var _ api.Toolset = (*Toolset)(nil)
+
+func (t *Toolset) GetName() string {
+	return "mustgather"
+}
+
+func (t *Toolset) GetDescription() string {
+	return "Tools for analyzing the unpacked must-gather directories stored in the configured must_gather_dir (OpenShift only)"
+}
+
+func (t *Toolset) GetTools(o internalk8s.Openshift) []api.ServerTool {
+	if !o.IsOpenShift(context.Background()) {
+		return []api.ServerTool{}
+	}
+	return slices.Concat(
+		initMustGather(),
+	)
+}
+
+func init() {
+	toolsets.Register(&Toolset{})
+}
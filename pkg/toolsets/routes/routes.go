@@ -0,0 +1,67 @@
+package routes
+
+import (
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+const defaultWarnDays = 30
+
+func initRoutes() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "routes_tls",
+			Description: "Inspect the TLS certificates of the OpenShift edge and reencrypt Routes in the current cluster from the provided namespace or all namespaces, reporting the subject, SANs, issuer and expiry date of embedded certificates. Routes whose certificates expire within warn_days are flagged as Expiring",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace to inspect the Routes from. If not provided, will inspect Routes from all namespaces",
+					},
+					"warn_days": {
+						Type:        "integer",
+						Description: fmt.Sprintf("Number of days before expiry to flag a certificate as Expiring (Optional, default: %d)", defaultWarnDays),
+						Default:     api.ToRawMessage(defaultWarnDays),
+						Minimum:     ptr.To(float64(0)),
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Routes: TLS",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: routesTLS},
+	}
+}
+
+func routesTLS(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	ns, _ := params.GetArguments()["namespace"].(string)
+	warnDays := int64(defaultWarnDays)
+	if _, ok := params.GetArguments()["warn_days"]; ok {
+		var err error
+		if warnDays, err = api.IntArgument(params.GetArguments(), "warn_days"); err != nil {
+			return api.NewToolCallResult("", err), nil
+		}
+	}
+	routes, err := params.RoutesTLS(params, ns, int(warnDays))
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to inspect route certificates: %v", err)), nil
+	}
+	if len(routes) == 0 {
+		return api.NewToolCallResult("# No edge or reencrypt routes found", nil), nil
+	}
+	yamlRoutes, err := output.MarshalYaml(routes)
+	if err != nil {
+		err = fmt.Errorf("failed to inspect route certificates: %v", err)
+	}
+	return api.NewToolCallResult(fmt.Sprintf("# The following edge and reencrypt routes (YAML format) were found:\n%s", yamlRoutes), err), nil
+}
@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"context"
+	"slices"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	internalk8s "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets"
+)
+
+type Toolset struct{}
+
+var _ api.Toolset = (*Toolset)(nil)
+
+func (t *Toolset) GetName() string {
+	return "routes"
+}
+
+func (t *Toolset) GetDescription() string {
+	return "Tools for inspecting OpenShift Routes (OpenShift only)"
+}
+
+func (t *Toolset) GetTools(o internalk8s.Openshift) []api.ServerTool {
+	if !o.IsOpenShift(context.Background()) {
+		return []api.ServerTool{}
+	}
+	return slices.Concat(
+		initRoutes(),
+	)
+}
+
+func init() {
+	toolsets.Register(&Toolset{})
+}